@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest/metric/mcis"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest/metric/mck8s"
@@ -15,7 +16,9 @@ import (
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest/agent"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest/alert"
 	restconfig "github.com/cloud-barista/cb-dragonfly/pkg/api/rest/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest/credential"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest/healthcheck"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest/ingest"
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -44,8 +47,18 @@ func (apiServer *APIServer) StartAPIServer(wg *sync.WaitGroup) error {
 	// 모니터링 API 라우팅 룰 설정
 	apiServer.SetRoutingRule(apiServer.echo)
 
-	// 모니터링 API 서버 실행
-	return apiServer.echo.Start(fmt.Sprintf(":%d", config.GetInstance().Dragonfly.Port))
+	// 모니터링 API 서버 실행 (Shutdown 에 의한 종료는 정상 종료로 처리)
+	err := apiServer.echo.Start(fmt.Sprintf(":%d", config.GetInstance().Dragonfly.Port))
+	if err != nil && err != http.ErrServerClosed {
+		util.GetLogger().Error(fmt.Sprintf("failed to run api server, error=%s", err))
+		return err
+	}
+	return nil
+}
+
+// Shutdown 신규 요청 차단 후 처리 중인 요청 완료 시 API 서버 종료
+func (apiServer *APIServer) Shutdown(ctx context.Context) error {
+	return apiServer.echo.Shutdown(ctx)
 }
 
 func (apiServer *APIServer) SetRoutingRule(e *echo.Echo) {
@@ -59,6 +72,8 @@ func (apiServer *APIServer) SetRoutingRule(e *echo.Echo) {
 
 	// 헬스체크
 	dragonfly.GET("/healthcheck", healthcheck.Ping)
+	dragonfly.GET("/healthcheck/leader", healthcheck.GetLeaderStatus)
+	dragonfly.GET("/healthcheck/storage", healthcheck.GetStorageStatus)
 
 	// 멀티 클라우드 모니터링 정책 설정
 	dragonfly.PUT("/config", restconfig.SetMonConfig)
@@ -66,17 +81,36 @@ func (apiServer *APIServer) SetRoutingRule(e *echo.Echo) {
 	dragonfly.PUT("/config/reset", restconfig.ResetMonConfig)
 
 	// 멀티 클라우드 인프라 서비스 모니터링/실시간 모니터링 정보 조회
-	//dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/info", metric.GetMCISMonInfo)
-	//dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/rt-info", metric.GetMCISRealtimeMonInfo)
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/info", mcis.GetMCISMonInfo)
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/rt-info", mcis.GetMCISRealtimeMonInfo)
+	// 멀티 클라우드 인프라 서비스 스펙 추천 조회
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/rightsizing", mcis.GetMCISRightsizing)
+	// 멀티 클라우드 인프라 서비스 VM 간 지연 시간 매트릭스 측정/이력 조회
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/latency-matrix", mcis.GetMCISLatencyMatrix)
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/latency-matrix/history", mcis.GetMCISLatencyHistory)
 
 	// MCIS 모니터링 (Milkyway)
 	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/vm/:vm_id/agent_ip/:agent_ip/mcis_metric/:metric_name/mcis-monitoring-info", mcis.GetMCISMetric)
+	// MCIS 벤치마크 캠페인 (Milkyway)
+	dragonfly.GET("/benchmark/campaigns", mcis.ListBenchmarkCampaign)
+	dragonfly.GET("/benchmark/campaign/:campaign_id", mcis.GetBenchmarkCampaign)
+	dragonfly.PUT("/benchmark/campaign/:campaign_id", mcis.PutBenchmarkCampaign)
+	dragonfly.DELETE("/benchmark/campaign/:campaign_id", mcis.DeleteBenchmarkCampaign)
+	dragonfly.POST("/benchmark/campaign/:campaign_id/run", mcis.RunBenchmarkCampaign)
+	dragonfly.GET("/benchmark/history", mcis.GetBenchmarkHistory)
+	dragonfly.GET("/benchmark/comparison", mcis.GetBenchmarkComparison)
 	// 멀티클라우드 인프라 VM 온디멘드 모니터링
 	dragonfly.GET("/ns/:ns/mcis/:mcis_id/vm/:vm_id/agent_ip/:agent_ip/metric/:metric_name/ondemand-monitoring-info", mcis.GetVMOnDemandMetric)
 	// 멀티클라우드 인프라 네트워크 패킷 모니터링
 	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/vm/:vm_id/watchtime/:watch_time/mcis-networkpacket-info", mcis.GetMCISOnDemandPacket)
+	// 멀티클라우드 인프라 VM 간 패킷 흐름 캡처/흐름 지도 조회
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/packet-flow", mcis.CaptureMCISPacketFlow)
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/packet-flow/map", mcis.GetMCISFlowMap)
 	// 멀티클라우드 인프라 VM Process 모니터링
 	dragonfly.GET("/agentip/:agent_ip/mcis-process-info", mcis.GetMCISOnDemandProcess)
+	// 멀티클라우드 인프라 VM 프로세스 사용량 이력/상위 프로세스 조회
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/vm/:vm_id/process-history", mcis.GetVMProcessHistory)
+	dragonfly.GET("/ns/:ns_id/mcis/:mcis_id/vm/:vm_id/process-top", mcis.GetVMTopProcess)
 	// 멀티클라우드 인프라 VM Spec 모니터링
 	dragonfly.GET("/ns/:ns/mcis/:mcis_id/mcis-spec-info", mcis.GetMCISSpec)
 	// 멀티 클라우드 인프라 VM 모니터링/실시간 모니터링 정보 조회
@@ -106,6 +140,39 @@ func (apiServer *APIServer) SetRoutingRule(e *echo.Echo) {
 	dragonfly.POST("/windows/agent/metadata", agent.CreateWindowAgentMetadata)
 	dragonfly.DELETE("/windows/agent/metadata", agent.DeleteWindowAgentMetadata)
 
+	// 에이전트 자격증명 조회, 등록, 삭제
+	dragonfly.GET("/credentials", credential.ListCredential)
+	dragonfly.GET("/credential/:credential_id", credential.GetCredential)
+	dragonfly.POST("/credential", credential.RegisterCredential)
+	dragonfly.DELETE("/credential/:credential_id", credential.DeleteCredential)
+
+	// 에이전트 수집 프로파일 조회, 생성, 삭제, 적용
+	dragonfly.GET("/profiles", agent.ListProfile)
+	dragonfly.GET("/profile/:profile_name", agent.GetProfile)
+	dragonfly.PUT("/profile/:profile_name", agent.PutProfile)
+	dragonfly.DELETE("/profile/:profile_name", agent.DeleteProfile)
+	dragonfly.PUT("/ns/:ns_id/mcis/:mcis_id/profile", agent.PutMCISProfile)
+	dragonfly.PUT("/agent/profile", agent.ApplyAgentProfile)
+
+	// 원본 샘플(raw) 저장 설정 조회, 설정, 해제
+	dragonfly.GET("/rawmodes", agent.ListRawMode)
+	dragonfly.PUT("/ns/:ns_id/rawmode", agent.PutRawMode)
+	dragonfly.DELETE("/ns/:ns_id/rawmode", agent.DeleteRawMode)
+	dragonfly.PUT("/ns/:ns_id/mcis/:mcis_id/rawmode", agent.PutRawMode)
+	dragonfly.DELETE("/ns/:ns_id/mcis/:mcis_id/rawmode", agent.DeleteRawMode)
+
+	// 에이전트 거부 메세지(dead letter) 조회, 삭제
+	dragonfly.GET("/deadletters", agent.ListDeadLetter)
+	dragonfly.GET("/deadletters/stats", agent.ListDeadLetterStat)
+	dragonfly.DELETE("/deadletters", agent.DeleteDeadLetter)
+
+	// Tumblebug 인벤토리 기반 에이전트 정합성 점검 실행, 결과 조회
+	dragonfly.POST("/agent/reconcile", agent.ReconcileAgent)
+	dragonfly.GET("/agent/reconcile", agent.GetReconcileResult)
+
+	// 에이전트 메트릭 수신 (HTTP 전송 방식)
+	dragonfly.POST("/ingest", ingest.IngestMetric)
+
 	// 삭제할 토픽 큐 등록 ( deployment collector 로 부터 삭제가 필요한 topic 들을 받기 위한 api )
 	dragonfly.GET("/topic/delete/:topic", topic.AddDeleteTopicToQueue)
 
@@ -128,6 +195,4 @@ func (apiServer *APIServer) SetRoutingRule(e *echo.Echo) {
 	// 알람 이벤트 로그 조회, 생성
 	dragonfly.GET("/alert/task/:task_id/events", alert.ListEventLog)
 	dragonfly.POST("/alert/event", alert.CreateEventLog)
-
-	e.Logger.Fatal(e.Start(":9090"))
 }
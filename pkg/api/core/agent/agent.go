@@ -6,6 +6,7 @@ import (
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"net/http"
+	"strings"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/mcis"
@@ -14,6 +15,9 @@ import (
 )
 
 func InstallAgent(info common.AgentInstallInfo) (int, error) {
+	if err := common.ApplyCredential(&info); err != nil {
+		return http.StatusBadRequest, err
+	}
 	switch config.GetInstance().Monitoring.DeployType {
 	case types.Dev, types.Compose:
 		if agentMetadata, _ := common.GetAgent(info); agentMetadata != nil {
@@ -41,6 +45,9 @@ func InstallAgent(info common.AgentInstallInfo) (int, error) {
 
 // UninstallAgent 전체 에이전트 삭제 테스트용 코드
 func UninstallAgent(info common.AgentInstallInfo) (int, error) {
+	if err := common.ApplyCredential(&info); err != nil {
+		return http.StatusBadRequest, err
+	}
 	switch config.GetInstance().Monitoring.DeployType {
 	case types.Dev, types.Compose:
 		if agentMetadata, _ := common.GetAgent(info); agentMetadata == nil {
@@ -66,5 +73,45 @@ func UninstallAgent(info common.AgentInstallInfo) (int, error) {
 }
 
 func RegisterSnapshotAgent(info common.SnapshotAgentInstallInfo) (int, error) {
+	if err := common.ApplyCredential(&info.BaseAgent); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if err := common.ApplyCredential(&info.NewAgent); err != nil {
+		return http.StatusBadRequest, err
+	}
 	return mcis.ConfigureSnapshotAgent(info)
 }
+
+// ApplyAgentProfile 에이전트 수집 프로파일 변경
+func ApplyAgentProfile(info common.AgentInstallInfo) (int, error) {
+	if !util.CheckMCISType(info.ServiceType) {
+		return http.StatusBadRequest, errors.New(fmt.Sprintf("collection profile is not supported, service_type: %s", info.ServiceType))
+	}
+	if err := common.ApplyCredential(&info); err != nil {
+		return http.StatusBadRequest, err
+	}
+	return mcis.ApplyAgentProfile(info)
+}
+
+// ChangeAgentType 에이전트 수집 방식 (push, pull) 변경
+func ChangeAgentType(info common.AgentInstallInfo) (int, error) {
+	if !util.CheckMCISType(info.ServiceType) {
+		return http.StatusBadRequest, errors.New(fmt.Sprintf("changing agent type is not supported, service_type: %s", info.ServiceType))
+	}
+	if err := common.ApplyCredential(&info); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if statusCode, err := mcis.ChangeAgentType(info); err != nil {
+		return statusCode, err
+	}
+
+	// PUSH 콜렉터 스케줄러 토픽 등록, 삭제 (PULL 콜러는 메타데이터 수집 방식 기준으로 에이전트 처리)
+	topicPolicy := types.TopicDel
+	if strings.EqualFold(info.AgentType, types.PushPolicy) {
+		topicPolicy = types.TopicAdd
+	}
+	if err := util.RingQueuePut(topicPolicy, common.MakeAgentUUID(info)); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to put topic to ring queue, error=%s", err))
+	}
+	return http.StatusOK, nil
+}
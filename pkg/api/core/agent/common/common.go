@@ -41,6 +41,11 @@ type AgentInstallInfo struct {
 	ClientToken   string
 	PrivateDomain bool
 	IP            *string
+	Profile       string
+	AgentType     string
+	Transport     string
+	CredentialId  string
+	Region        string
 }
 
 type SnapshotAgentInstallInfo struct {
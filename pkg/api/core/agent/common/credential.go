@@ -0,0 +1,44 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/credential"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// ApplyCredential 자격증명 참조 값 기반 에이전트 접속 정보 설정 (요청에 포함된 값 우선 적용)
+func ApplyCredential(info *AgentInstallInfo) error {
+	if info.CredentialId == "" {
+		return nil
+	}
+	credentialType, secret, err := credential.ResolveSecret(info.CredentialId)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to resolve credential, error=%s", err))
+	}
+
+	if util.CheckMCK8SType(info.ServiceType) {
+		if credentialType != credential.MCK8SCredential {
+			return errors.New(fmt.Sprintf("credential %s is not %s credential", info.CredentialId, credential.MCK8SCredential))
+		}
+		setIfEmpty(&info.ServerCA, secret[credential.SecretServerCA])
+		setIfEmpty(&info.ClientCA, secret[credential.SecretClientCA])
+		setIfEmpty(&info.ClientKey, secret[credential.SecretClientKey])
+		setIfEmpty(&info.ClientToken, secret[credential.SecretClientToken])
+		return nil
+	}
+
+	if credentialType != credential.SSHCredential {
+		return errors.New(fmt.Sprintf("credential %s is not %s credential", info.CredentialId, credential.SSHCredential))
+	}
+	setIfEmpty(&info.UserName, secret[credential.SecretUserName])
+	setIfEmpty(&info.SshKey, secret[credential.SecretSshKey])
+	return nil
+}
+
+func setIfEmpty(target *string, val string) {
+	if *target == "" {
+		*target = val
+	}
+}
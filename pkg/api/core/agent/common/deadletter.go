@@ -0,0 +1,265 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common/deadletter"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// RejectReason 에이전트 메세지 거부 사유
+type RejectReason = deadletter.Reason
+
+const (
+	InvalidFormat      = deadletter.InvalidFormat
+	UnknownMeasurement = deadletter.UnknownMeasurement
+	InvalidTag         = deadletter.InvalidTag
+	UnknownAgent       = deadletter.UnknownAgent
+)
+
+const (
+	deadLetterRecentSize  = 20   // 에이전트 별 최근 거부 메세지 보관 건수
+	deadLetterPayloadSize = 1024 // 거부 메세지 원본 보관 크기 (byte)
+)
+
+// DeadLetter 거부된 에이전트 메세지 (사유, 원본 일부)
+type DeadLetter struct {
+	AgentUUID  string       `json:"agent_uuid"`
+	Transport  string       `json:"transport"`
+	Reason     RejectReason `json:"reason"`
+	Detail     string       `json:"detail"`
+	Payload    string       `json:"payload"`
+	RejectedAt int64        `json:"rejected_at"`
+}
+
+// DeadLetterStat 에이전트 별 거부 메세지 통계, 최근 거부 메세지 (메타데이터가 없는 에이전트는 unknown 으로 통합 집계)
+type DeadLetterStat struct {
+	AgentUUID      string                 `json:"agent_uuid"`
+	RejectedCnt    int64                  `json:"rejected_cnt"`
+	ReasonCnt      map[RejectReason]int64 `json:"reason_cnt"`
+	LastRejectedAt int64                  `json:"last_rejected_at"`
+	Recent         []DeadLetter           `json:"recent,omitempty"`
+}
+
+// deadLetterLock 동일 인스턴스 내 에이전트 별 거부 통계 갱신 직렬화
+var deadLetterLock sync.Mutex
+
+// DeadLetterBuffer 집계 주기 단위 거부 메세지 버퍼 (통계 키 별로 모아 Flush 시 한 번에 저장)
+type DeadLetterBuffer struct {
+	letters  map[string][]DeadLetter
+	logState deadletter.LogState
+}
+
+// Add 거부 메세지 추가 (메타데이터가 없는 에이전트는 공용 통계 키로 집계, 거부 메세지에는 수신 UUID 기록)
+func (b *DeadLetterBuffer) Add(agentUUID string, transport string, reason RejectReason, detail string, payload []byte) {
+	if b.letters == nil {
+		b.letters = map[string][]DeadLetter{}
+	}
+	if len(payload) > deadLetterPayloadSize {
+		payload = payload[:deadLetterPayloadSize]
+	}
+	bucket := deadletter.BucketKey(agentUUID, reason)
+	b.letters[bucket] = append(b.letters[bucket], DeadLetter{
+		AgentUUID:  agentUUID,
+		Transport:  transport,
+		Reason:     reason,
+		Detail:     detail,
+		Payload:    string(payload),
+		RejectedAt: time.Now().Unix(),
+	})
+}
+
+// Flush 버퍼의 거부 메세지를 통계 키 별 거부 통계에 반영 후 버퍼 초기화
+//   - 통계 키 별 거부 건수가 직전 Flush 와 달라진 경우에만 로그를 기록합니다.
+func (b *DeadLetterBuffer) Flush() {
+	b.flush(true)
+}
+
+func (b *DeadLetterBuffer) flush(complete bool) {
+	counts := map[string]int{}
+	for bucket, deadLetters := range b.letters {
+		if err := putDeadLetters(bucket, deadLetters); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to put dead letter, agent=%s, error=%s", bucket, err))
+		}
+		counts[bucket] = len(deadLetters)
+	}
+	for bucket, cnt := range b.logState.Changed(counts, complete) {
+		if cnt == 0 {
+			continue
+		}
+		deadLetters := b.letters[bucket]
+		util.GetLogger().Warn(fmt.Sprintf("rejected agent messages, agent=%s, count=%d, last reason=%s", bucket, cnt, deadLetters[len(deadLetters)-1].Reason))
+	}
+	b.letters = nil
+}
+
+// recordBuffer 요청 단위 거부 메세지 기록 버퍼 (로그 기록 여부 판단을 위해 인스턴스 내 공유)
+var recordBuffer DeadLetterBuffer
+var recordLock sync.Mutex
+
+// RecordDeadLetter 거부 메세지 즉시 기록 (HTTP, NATS 수신 요청 단위 처리)
+func RecordDeadLetter(agentUUID string, transport string, reason RejectReason, detail string, payload []byte) {
+	recordLock.Lock()
+	defer recordLock.Unlock()
+	recordBuffer.Add(agentUUID, transport, reason, detail, payload)
+	recordBuffer.flush(false)
+}
+
+func putDeadLetters(agentUUID string, deadLetters []DeadLetter) error {
+	deadLetterLock.Lock()
+	defer deadLetterLock.Unlock()
+
+	stat, err := GetDeadLetterStat(agentUUID)
+	if err != nil {
+		return err
+	}
+	if stat == nil {
+		stat = &DeadLetterStat{AgentUUID: agentUUID, ReasonCnt: map[RejectReason]int64{}}
+	}
+	for _, deadLetter := range deadLetters {
+		stat.RejectedCnt++
+		stat.ReasonCnt[deadLetter.Reason]++
+		stat.LastRejectedAt = deadLetter.RejectedAt
+	}
+	stat.Recent = append(stat.Recent, deadLetters...)
+	if len(stat.Recent) > deadLetterRecentSize {
+		stat.Recent = stat.Recent[len(stat.Recent)-deadLetterRecentSize:]
+	}
+
+	statBytes, err := json.Marshal(stat)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to convert dead letter format to json, error=%s", err))
+	}
+	return cbstore.GetInstance().StorePut(types.DeadLetter+agentUUID, string(statBytes))
+}
+
+// GetDeadLetterStat 에이전트 거부 메세지 통계 조회 (거부 이력이 없을 경우 nil 반환)
+func GetDeadLetterStat(agentUUID string) (*DeadLetterStat, error) {
+	statStr, err := cbstore.GetInstance().StoreGet(types.DeadLetter + agentUUID)
+	if err != nil {
+		return nil, err
+	}
+	if statStr == nil || *statStr == "" {
+		return nil, nil
+	}
+	stat := DeadLetterStat{}
+	if err = json.Unmarshal([]byte(*statStr), &stat); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert dead letter, error=%s", err))
+	}
+	if stat.ReasonCnt == nil {
+		stat.ReasonCnt = map[RejectReason]int64{}
+	}
+	return &stat, nil
+}
+
+// ListDeadLetterStat 에이전트 별 거부 메세지 통계 목록 조회 (최근 거부 메세지 제외, 거부 건수 내림차순)
+func ListDeadLetterStat() ([]DeadLetterStat, error) {
+	statListMap, err := cbstore.GetInstance().StoreGetListMap(types.DeadLetter, true)
+	if err != nil {
+		return nil, err
+	}
+	statList := make([]DeadLetterStat, 0, len(statListMap))
+	for _, statStr := range statListMap {
+		stat := DeadLetterStat{}
+		if err := json.Unmarshal([]byte(statStr), &stat); err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to convert dead letter list, error=%s", err))
+		}
+		stat.Recent = nil
+		statList = append(statList, stat)
+	}
+	sort.Slice(statList, func(i, j int) bool {
+		if statList[i].RejectedCnt == statList[j].RejectedCnt {
+			return statList[i].AgentUUID < statList[j].AgentUUID
+		}
+		return statList[i].RejectedCnt > statList[j].RejectedCnt
+	})
+	return statList, nil
+}
+
+// ListDeadLetter 최근 거부 메세지 목록 조회 (거부 시각 내림차순)
+//   - agentUUID, reason 이 비어있을 경우 전체 에이전트, 전체 사유를 조회합니다.
+//   - limit 이 0 이하일 경우 보관 중인 전체 거부 메세지를 조회합니다.
+func ListDeadLetter(agentUUID string, reason RejectReason, limit int) ([]DeadLetter, error) {
+	var statList []DeadLetterStat
+	if agentUUID != "" {
+		stat, err := GetDeadLetterStat(agentUUID)
+		if err != nil {
+			return nil, err
+		}
+		if stat != nil {
+			statList = append(statList, *stat)
+		}
+	} else {
+		statListMap, err := cbstore.GetInstance().StoreGetListMap(types.DeadLetter, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, statStr := range statListMap {
+			stat := DeadLetterStat{}
+			if err := json.Unmarshal([]byte(statStr), &stat); err != nil {
+				return nil, errors.New(fmt.Sprintf("failed to convert dead letter list, error=%s", err))
+			}
+			statList = append(statList, stat)
+		}
+	}
+
+	deadLetterList := []DeadLetter{}
+	for _, stat := range statList {
+		for _, deadLetter := range stat.Recent {
+			if reason != "" && deadLetter.Reason != reason {
+				continue
+			}
+			deadLetterList = append(deadLetterList, deadLetter)
+		}
+	}
+	sort.SliceStable(deadLetterList, func(i, j int) bool {
+		return deadLetterList[i].RejectedAt > deadLetterList[j].RejectedAt
+	})
+	if limit > 0 && len(deadLetterList) > limit {
+		deadLetterList = deadLetterList[:limit]
+	}
+	return deadLetterList, nil
+}
+
+// DeleteDeadLetter 에이전트 거부 메세지 통계, 이력 삭제 (agentUUID 가 비어있을 경우 전체 삭제)
+func DeleteDeadLetter(agentUUID string) error {
+	deadLetterLock.Lock()
+	defer deadLetterLock.Unlock()
+	if agentUUID == "" {
+		return cbstore.GetInstance().StoreDelList(types.DeadLetter)
+	}
+	statStr, err := cbstore.GetInstance().StoreGet(types.DeadLetter + agentUUID)
+	if err != nil {
+		return err
+	}
+	if statStr == nil {
+		return nil
+	}
+	return cbstore.GetInstance().StoreDelete(types.DeadLetter + agentUUID)
+}
+
+// IsValidRejectReason 거부 사유 값 체크
+func IsValidRejectReason(reason RejectReason) bool {
+	return deadletter.IsValidReason(reason)
+}
+
+// IsKnownMeasurement 수집 대상 메트릭 이름 여부 (집계 메트릭, 수집 프로파일 입력 플러그인 메트릭)
+func IsKnownMeasurement(name string) bool {
+	if types.GetMetricType(name) != types.None {
+		return true
+	}
+	for _, input := range SupportedProfileInputs {
+		if name == input {
+			return true
+		}
+	}
+	// procstat 플러그인은 프로세스 조회 결과를 procstat_lookup 메트릭으로 함께 전송
+	return name == "procstat_lookup"
+}
@@ -0,0 +1,60 @@
+package deadletter
+
+// Reason 에이전트 메세지 거부 사유
+type Reason string
+
+const (
+	InvalidFormat      Reason = "invalid_format"      // 메세지 파싱 실패 (json, line protocol)
+	UnknownMeasurement Reason = "unknown_measurement" // 수집 대상이 아닌 메트릭 이름
+	InvalidTag         Reason = "invalid_tag"         // 에이전트 식별 태그 누락, 에이전트 메타데이터 불일치
+	UnknownAgent       Reason = "unknown_agent"       // 에이전트 메타데이터 없음
+)
+
+// UnknownBucket 메타데이터가 없거나 UUID 를 알 수 없는 에이전트의 공용 거부 통계 키
+const UnknownBucket = "unknown"
+
+// IsValidReason 거부 사유 값 체크
+func IsValidReason(reason Reason) bool {
+	switch reason {
+	case InvalidFormat, UnknownMeasurement, InvalidTag, UnknownAgent:
+		return true
+	}
+	return false
+}
+
+// BucketKey 거부 통계 저장 키 조회
+//   - 메타데이터가 없는 에이전트는 임의 UUID 로 저장소 키가 늘어나지 않도록 공용 키(unknown)로 집계합니다.
+func BucketKey(agentUUID string, reason Reason) string {
+	if agentUUID == "" || reason == UnknownAgent {
+		return UnknownBucket
+	}
+	return agentUUID
+}
+
+// LogState 통계 키 별 직전 Flush 거부 건수 (건수가 바뀐 경우에만 로그 기록)
+type LogState struct {
+	counts map[string]int
+}
+
+// Changed 통계 키 별 거부 건수 반영 후 직전 건수와 달라진 키의 건수 반환
+//   - complete 가 true 일 경우 counts 에 없는 키는 거부가 해소된 것으로 보고 직전 건수를 제거합니다.
+func (s *LogState) Changed(counts map[string]int, complete bool) map[string]int {
+	if s.counts == nil {
+		s.counts = map[string]int{}
+	}
+	changed := map[string]int{}
+	for key, cnt := range counts {
+		if prevCnt, ok := s.counts[key]; !ok || prevCnt != cnt {
+			changed[key] = cnt
+		}
+		s.counts[key] = cnt
+	}
+	if complete {
+		for key := range s.counts {
+			if _, ok := counts[key]; !ok {
+				delete(s.counts, key)
+			}
+		}
+	}
+	return changed
+}
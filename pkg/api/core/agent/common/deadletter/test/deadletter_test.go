@@ -0,0 +1,71 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common/deadletter"
+)
+
+func TestBucketKey(t *testing.T) {
+	testCases := []struct {
+		name      string
+		agentUUID string
+		reason    deadletter.Reason
+		expected  string
+	}{
+		{name: "known agent", agentUUID: "ns_mcis_mcis_vm_aws", reason: deadletter.InvalidFormat, expected: "ns_mcis_mcis_vm_aws"},
+		{name: "known agent invalid tag", agentUUID: "ns_mcis_mcis_vm_aws", reason: deadletter.InvalidTag, expected: "ns_mcis_mcis_vm_aws"},
+		{name: "unknown agent", agentUUID: "ns_mcis_mcis_deleted_aws", reason: deadletter.UnknownAgent, expected: deadletter.UnknownBucket},
+		{name: "other unknown agent", agentUUID: "random-uuid", reason: deadletter.UnknownAgent, expected: deadletter.UnknownBucket},
+		{name: "empty uuid", agentUUID: "", reason: deadletter.InvalidFormat, expected: deadletter.UnknownBucket},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if key := deadletter.BucketKey(tc.agentUUID, tc.reason); key != tc.expected {
+				t.Errorf("expected bucket %s, got %s", tc.expected, key)
+			}
+		})
+	}
+}
+
+func TestIsValidReason(t *testing.T) {
+	for reason, expected := range map[deadletter.Reason]bool{
+		deadletter.InvalidFormat:      true,
+		deadletter.UnknownMeasurement: true,
+		deadletter.InvalidTag:         true,
+		deadletter.UnknownAgent:       true,
+		"":                            false,
+		"unknown":                     false,
+	} {
+		if valid := deadletter.IsValidReason(reason); valid != expected {
+			t.Errorf("expected valid %t of %q, got %t", expected, reason, valid)
+		}
+	}
+}
+
+func TestLogStateChanged(t *testing.T) {
+	testCases := []struct {
+		name     string
+		counts   map[string]int
+		complete bool
+		expected map[string]int
+	}{
+		{name: "first flush", counts: map[string]int{"a": 3, "unknown": 10}, complete: true, expected: map[string]int{"a": 3, "unknown": 10}},
+		{name: "same counts", counts: map[string]int{"a": 3, "unknown": 10}, complete: true, expected: map[string]int{}},
+		{name: "count changed", counts: map[string]int{"a": 3, "unknown": 12}, complete: true, expected: map[string]int{"unknown": 12}},
+		{name: "resolved bucket forgotten", counts: map[string]int{"unknown": 12}, complete: true, expected: map[string]int{}},
+		{name: "resolved bucket logged again", counts: map[string]int{"a": 3, "unknown": 12}, complete: true, expected: map[string]int{"a": 3}},
+		{name: "partial flush", counts: map[string]int{"b": 1}, complete: false, expected: map[string]int{"b": 1}},
+		{name: "partial flush keeps other buckets", counts: map[string]int{"a": 3}, complete: false, expected: map[string]int{}},
+		{name: "partial flush same count", counts: map[string]int{"b": 1}, complete: false, expected: map[string]int{}},
+	}
+	state := deadletter.LogState{}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if changed := state.Changed(tc.counts, tc.complete); !reflect.DeepEqual(changed, tc.expected) {
+				t.Errorf("expected changed %v, got %v", tc.expected, changed)
+			}
+		})
+	}
+}
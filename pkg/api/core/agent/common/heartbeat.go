@@ -0,0 +1,180 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common/liveness"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// AgentLiveness 에이전트 하트비트 기반 생존 상태 (정상, 지연, 응답없음)
+type AgentLiveness = liveness.State
+
+const (
+	LivenessHealthy     = liveness.Healthy
+	LivenessDegraded    = liveness.Degraded
+	LivenessUnreachable = liveness.Unreachable
+)
+
+// LivenessMeasurement 에이전트 상태 전이 이벤트 저장 measurement (알람 태스크 구독 대상)
+const LivenessMeasurement = "heartbeat"
+
+// LivenessEvent 에이전트 상태 전이 이벤트
+type LivenessEvent struct {
+	AgentUUID string        `json:"agent_uuid"`
+	Agent     AgentInfo     `json:"agent"`
+	PrevState AgentLiveness `json:"prev_state"`
+	CurState  AgentLiveness `json:"cur_state"`
+	LastSeen  int64         `json:"last_seen"`
+	Time      int64         `json:"time"`
+}
+
+// 하트비트 갱신, 상태 점검, 인벤토리 정합성 점검, 메타데이터 수정이 동시에 메타데이터를 덮어쓰지 않도록 직렬화
+var livenessLock sync.Mutex
+
+// lastSeenWritten 에이전트 별 마지막 하트비트 저장 시각 (livenessLock 으로 보호)
+var lastSeenWritten = map[string]int64{}
+
+// GetLivenessThreshold degraded, unreachable 판단 기준 시간 조회 (s)
+func GetLivenessThreshold() (int64, int64) {
+	return liveness.Threshold(config.GetInstance().Monitoring.HeartbeatDegradedThreshold, config.GetInstance().Monitoring.HeartbeatUnreachableThreshold)
+}
+
+// EvaluateAgentLiveness 마지막 수신 시각 기준 에이전트 생존 상태 판단
+func EvaluateAgentLiveness(lastSeen int64, now time.Time) AgentLiveness {
+	degraded, unreachable := GetLivenessThreshold()
+	return liveness.Evaluate(lastSeen, now, degraded, unreachable)
+}
+
+// GetLastSeenWriteInterval 하트비트 최소 저장 간격 (degraded 판단 기준 시간의 1/4)
+func GetLastSeenWriteInterval() int64 {
+	degraded, _ := GetLivenessThreshold()
+	return liveness.WriteInterval(degraded)
+}
+
+// UpdateAgentLastSeen 에이전트 하트비트 수신 시각 갱신 (push, pull 수집 경로 공통)
+//   - 마지막 저장 이후 최소 저장 간격이 지나지 않은 경우 저장을 생략합니다.
+//   - 최소 저장 간격이 degraded 판단 기준 시간보다 짧으므로 저장을 생략해도 상태가 잘못 판단되지 않습니다.
+func UpdateAgentLastSeen(agentUUID string, seenAt time.Time) error {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+
+	if writtenAt, ok := lastSeenWritten[agentUUID]; ok && seenAt.Unix()-writtenAt < GetLastSeenWriteInterval() {
+		return nil
+	}
+
+	agentInfo, err := GetAgentByUUID(agentUUID)
+	if err != nil {
+		return err
+	}
+	prevState := AgentLiveness(agentInfo.Liveness)
+	agentInfo.LastSeen = seenAt.Unix()
+	agentInfo.Liveness = string(LivenessHealthy)
+
+	if err := putAgentInfo(agentUUID, *agentInfo); err != nil {
+		return err
+	}
+	lastSeenWritten[agentUUID] = agentInfo.LastSeen
+	if prevState != "" && prevState != LivenessHealthy {
+		publishLivenessEvent(agentUUID, *agentInfo, prevState, seenAt)
+	}
+	return nil
+}
+
+// forgetLastSeenWritten 삭제된 에이전트 하트비트 저장 시각 제거
+func forgetLastSeenWritten(agentUUID string) {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+	delete(lastSeenWritten, agentUUID)
+}
+
+// CheckAgentLiveness 전체 에이전트 생존 상태 점검 후 상태가 변경된 에이전트 이벤트 반환
+func CheckAgentLiveness(now time.Time) ([]LivenessEvent, error) {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+
+	agentList, err := ListAgent()
+	if err != nil {
+		return nil, err
+	}
+
+	degraded, unreachable := GetLivenessThreshold()
+	var eventList []LivenessEvent
+	for _, agentInfo := range agentList {
+		// 제거된 에이전트, 삭제된 VM(클러스터) 에이전트, 하트비트 정보가 없는 에이전트는 점검 대상에서 제외
+		if agentInfo.AgentState == string(Disable) || agentInfo.Orphaned || agentInfo.LastSeen == 0 {
+			continue
+		}
+		prevState := AgentLiveness(agentInfo.Liveness)
+		curState, changed := liveness.Transition(prevState, agentInfo.LastSeen, now, degraded, unreachable)
+		if !changed {
+			continue
+		}
+
+		agentUUID := MakeAgentUUIDByInfo(agentInfo)
+		agentInfo.Liveness = string(curState)
+		if err := putAgentInfo(agentUUID, agentInfo); err != nil {
+			util.GetLogger().Error(err)
+			continue
+		}
+		eventList = append(eventList, publishLivenessEvent(agentUUID, agentInfo, prevState, now))
+	}
+	return eventList, nil
+}
+
+func putAgentInfo(agentUUID string, agentInfo AgentInfo) error {
+	agentInfoBytes, err := json.Marshal(agentInfo)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to convert metadata format to json, error=%s", err))
+	}
+	if err = cbstore.GetInstance().StorePut(types.Agent+agentUUID, string(agentInfoBytes)); err != nil {
+		return errors.New(fmt.Sprintf("failed to put metadata, error=%s", err))
+	}
+	return nil
+}
+
+// publishLivenessEvent 상태 전이 이벤트를 InfluxDB에 저장하여 알람 태스크(kapacitor)에서 구독 가능하도록 처리
+func publishLivenessEvent(agentUUID string, agentInfo AgentInfo, prevState AgentLiveness, now time.Time) LivenessEvent {
+	curState := AgentLiveness(agentInfo.Liveness)
+	event := LivenessEvent{
+		AgentUUID: agentUUID,
+		Agent:     agentInfo,
+		PrevState: prevState,
+		CurState:  curState,
+		LastSeen:  agentInfo.LastSeen,
+		Time:      now.Unix(),
+	}
+	util.GetLogger().Info(fmt.Sprintf("agent %s liveness changed, %s => %s", agentUUID, prevState, curState))
+
+	tagArr := map[string]string{
+		"agentUUID":   agentUUID,
+		"serviceType": agentInfo.ServiceType,
+		types.NsId:    agentInfo.NsId,
+	}
+	if util.CheckMCK8SType(agentInfo.ServiceType) {
+		tagArr["mck8sId"] = agentInfo.Mck8sId
+	} else {
+		tagArr[types.McisId] = agentInfo.McisId
+		tagArr[types.VmId] = agentInfo.VmId
+		tagArr[types.CspType] = agentInfo.CspType
+	}
+	fieldArr := map[string]interface{}{
+		"state":      string(curState),
+		"prev_state": string(prevState),
+		"state_code": curState.ToCode(),
+		"last_seen":  agentInfo.LastSeen,
+		"elapsed":    now.Unix() - agentInfo.LastSeen,
+	}
+	if err := v1.GetInstance().WritePoint(v1.DefaultDatabase, LivenessMeasurement, tagArr, fieldArr, now); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to write agent liveness event, error=%s", err))
+	}
+	return event
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/credential"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// ProvisionIngestToken 에이전트 HTTP 수신 인증 토큰 발급 (기존 발급 토큰이 있을 경우 재사용)
+func ProvisionIngestToken(agentUUID string) (string, error) {
+	token, err := getIngestToken(agentUUID)
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		return token, nil
+	}
+	if token, err = generateSecret(); err != nil {
+		return "", err
+	}
+	encryptedStr, err := credential.EncryptSecret([]byte(token))
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to encrypt ingest token, error=%s", err))
+	}
+	if err = cbstore.GetInstance().StorePut(types.IngestToken+agentUUID, encryptedStr); err != nil {
+		return "", errors.New(fmt.Sprintf("failed to put ingest token, error=%s", err))
+	}
+	return token, nil
+}
+
+// ValidateIngestToken 에이전트 HTTP 수신 인증 토큰 체크
+func ValidateIngestToken(agentUUID string, token string) bool {
+	if agentUUID == "" || token == "" {
+		return false
+	}
+	storedToken, err := getIngestToken(agentUUID)
+	if err != nil || storedToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(storedToken), []byte(token)) == 1
+}
+
+// RevokeIngestToken 에이전트 HTTP 수신 인증 토큰 폐기
+func RevokeIngestToken(agentUUID string) error {
+	token, err := cbstore.GetInstance().StoreGet(types.IngestToken + agentUUID)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	return cbstore.GetInstance().StoreDelete(types.IngestToken + agentUUID)
+}
+
+func getIngestToken(agentUUID string) (string, error) {
+	encryptedStr, err := cbstore.GetInstance().StoreGet(types.IngestToken + agentUUID)
+	if err != nil {
+		return "", err
+	}
+	if encryptedStr == nil || *encryptedStr == "" {
+		return "", nil
+	}
+	tokenBytes, err := credential.DecryptSecret(*encryptedStr)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to decrypt ingest token, error=%s", err))
+	}
+	return string(tokenBytes), nil
+}
@@ -0,0 +1,203 @@
+package common
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	DefaultAgentPageSize = 50
+	MaxAgentPageSize     = 1000
+)
+
+// AgentListFilter 에이전트 목록 조회 조건 (필터, 검색, 정렬, 페이지)
+type AgentListFilter struct {
+	NsId        string `json:"ns_id"`
+	McisId      string `json:"mcis_id"`
+	CspType     string `json:"csp_type"`
+	ServiceType string `json:"service_type"`
+	AgentState  string `json:"agent_state"`
+	AgentHealth string `json:"agent_health"`
+	AgentType   string `json:"agent_type"`
+	Liveness    string `json:"liveness"`
+	Keyword     string `json:"keyword"`
+	SortBy      string `json:"sort_by"`
+	Order       string `json:"order"`
+	Page        int    `json:"page"`
+	Size        int    `json:"size"`
+}
+
+// AgentHealthCount 에이전트 헬스 상태별 개수
+type AgentHealthCount struct {
+	Total     int `json:"total"`
+	Healthy   int `json:"healthy"`
+	Unhealthy int `json:"unhealthy"`
+}
+
+// AgentListSummary 조회 조건에 해당하는 에이전트 요약 정보 (전체, CSP 별 헬스 상태)
+type AgentListSummary struct {
+	AgentHealthCount
+	Csp map[string]AgentHealthCount `json:"csp"`
+}
+
+// AgentListResult 에이전트 목록 조회 결과
+type AgentListResult struct {
+	Total   int              `json:"total"`
+	Page    int              `json:"page"`
+	Size    int              `json:"size"`
+	Items   []AgentInfo      `json:"items"`
+	Summary AgentListSummary `json:"summary"`
+}
+
+// SearchAgent 조건 기반 에이전트 메타데이터 목록 조회
+func SearchAgent(filter AgentListFilter) (*AgentListResult, error) {
+	agentList, err := ListAgent()
+	if err != nil {
+		return nil, err
+	}
+
+	filteredList := []AgentInfo{}
+	for _, agentInfo := range agentList {
+		if filter.match(agentInfo) {
+			filteredList = append(filteredList, agentInfo)
+		}
+	}
+
+	sortAgentList(filteredList, filter.SortBy, filter.Order)
+
+	result := AgentListResult{
+		Total:   len(filteredList),
+		Summary: summarizeAgentList(filteredList),
+	}
+	result.Page, result.Size, result.Items = paginateAgentList(filteredList, filter.Page, filter.Size)
+	return &result, nil
+}
+
+// FilterAgent 조건 기반 에이전트 메타데이터 맵 조회 (정렬, 페이지 미적용)
+func FilterAgent(filter AgentListFilter) (map[string]AgentInfo, error) {
+	agentList, err := ListAgent()
+	if err != nil {
+		return nil, err
+	}
+	filteredList := map[string]AgentInfo{}
+	for uuid, agentInfo := range agentList {
+		if filter.match(agentInfo) {
+			filteredList[uuid] = agentInfo
+		}
+	}
+	return filteredList, nil
+}
+
+func (f AgentListFilter) match(agentInfo AgentInfo) bool {
+	conditions := [][2]string{
+		{f.NsId, agentInfo.NsId},
+		{f.McisId, agentInfo.McisId},
+		{f.CspType, agentInfo.CspType},
+		{f.ServiceType, agentInfo.ServiceType},
+		{f.AgentState, agentInfo.AgentState},
+		{f.AgentHealth, agentInfo.AgentHealth},
+		{f.AgentType, agentInfo.AgentType},
+		{f.Liveness, agentInfo.Liveness},
+	}
+	for _, condition := range conditions {
+		if condition[0] != "" && !strings.EqualFold(condition[0], condition[1]) {
+			return false
+		}
+	}
+
+	// 키워드 검색 (UUID, 아이디, IP 부분 일치)
+	if f.Keyword != "" {
+		keyword := strings.ToLower(f.Keyword)
+		searchFields := []string{MakeAgentUUIDByInfo(agentInfo), agentInfo.McisId, agentInfo.VmId, agentInfo.Mck8sId, agentInfo.PublicIp}
+		for _, field := range searchFields {
+			if strings.Contains(strings.ToLower(field), keyword) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func sortAgentList(agentList []AgentInfo, sortBy string, order string) {
+	sortKey := func(agentInfo AgentInfo) string {
+		switch sortBy {
+		case "ns_id":
+			return agentInfo.NsId
+		case "mcis_id":
+			return agentInfo.McisId
+		case "vm_id":
+			return agentInfo.VmId
+		case "csp_type":
+			return agentInfo.CspType
+		case "service_type":
+			return agentInfo.ServiceType
+		case "agent_state":
+			return agentInfo.AgentState
+		case "agent_health":
+			return agentInfo.AgentHealth
+		case "agent_type":
+			return agentInfo.AgentType
+		case "liveness":
+			return agentInfo.Liveness
+		default:
+			return MakeAgentUUIDByInfo(agentInfo)
+		}
+	}
+	isDesc := strings.EqualFold(order, "desc")
+	sort.SliceStable(agentList, func(i, j int) bool {
+		iKey, jKey := sortKey(agentList[i]), sortKey(agentList[j])
+		if iKey == jKey {
+			// 정렬 기준 값이 같을 경우 UUID 기준 정렬
+			iKey, jKey = MakeAgentUUIDByInfo(agentList[i]), MakeAgentUUIDByInfo(agentList[j])
+		}
+		if isDesc {
+			return iKey > jKey
+		}
+		return iKey < jKey
+	})
+}
+
+func summarizeAgentList(agentList []AgentInfo) AgentListSummary {
+	summary := AgentListSummary{Csp: map[string]AgentHealthCount{}}
+	for _, agentInfo := range agentList {
+		// CSP 정보가 없는 MCK8S 에이전트는 서비스 타입 기준으로 집계
+		cspKey := agentInfo.CspType
+		if cspKey == "" {
+			cspKey = agentInfo.ServiceType
+		}
+		cspCount := summary.Csp[cspKey]
+		cspCount.Total++
+		summary.Total++
+		if agentInfo.AgentHealth == string(Healthy) {
+			cspCount.Healthy++
+			summary.Healthy++
+		} else {
+			cspCount.Unhealthy++
+			summary.Unhealthy++
+		}
+		summary.Csp[cspKey] = cspCount
+	}
+	return summary
+}
+
+func paginateAgentList(agentList []AgentInfo, page int, size int) (int, int, []AgentInfo) {
+	if size <= 0 {
+		size = DefaultAgentPageSize
+	}
+	if size > MaxAgentPageSize {
+		size = MaxAgentPageSize
+	}
+	if page <= 0 {
+		page = 1
+	}
+	start := (page - 1) * size
+	if start >= len(agentList) {
+		return page, size, []AgentInfo{}
+	}
+	end := start + size
+	if end > len(agentList) {
+		end = len(agentList)
+	}
+	return page, size, agentList[start:end]
+}
@@ -0,0 +1,193 @@
+package common
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/credential"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// KafkaAgentCredential 에이전트 kafka 접속 자격증명 (SASL: SCRAM 계정, SSL: 클라이언트 인증서)
+type KafkaAgentCredential struct {
+	Principal string `json:"principal"`
+	Topic     string `json:"topic"`
+	Username  string `json:"username,omitempty"`
+	Password  string `json:"password,omitempty"`
+	CertPEM   string `json:"cert_pem,omitempty"`
+	KeyPEM    string `json:"key_pem,omitempty"`
+}
+
+// ProvisionKafkaCredential 에이전트 kafka 자격증명 발급 및 토픽 쓰기 권한 등록
+//   - 보안 설정이 비활성화(PLAINTEXT)되어 있을 경우 nil 을 반환합니다.
+//   - 기존 발급 자격증명이 있을 경우 재사용하며, SCRAM 계정, ACL 은 다시 등록합니다. (재설치, 재설정 시 멱등 처리)
+func ProvisionKafkaCredential(agentUUID string, topic string) (*KafkaAgentCredential, error) {
+	kafkaConf := config.GetInstance().Kafka
+	if !kafkasec.IsSecurityEnabled(kafkaConf) {
+		return nil, nil
+	}
+	if err := kafkasec.Validate(kafkaConf); err != nil {
+		return nil, err
+	}
+	if err := kafkasec.ValidateAgentCA(kafkaConf); err != nil {
+		return nil, err
+	}
+	// 공용 토픽 방식에서는 토픽 쓰기 권한으로 에이전트 별 접근을 제한할 수 없으므로 자격증명을 발급하지 않습니다.
+	if err := kafkasec.ValidateTopicMode(kafkaConf, config.GetInstance().Monitoring); err != nil {
+		return nil, err
+	}
+
+	kafkaCredential, err := GetKafkaCredential(agentUUID)
+	if err != nil {
+		return nil, err
+	}
+	if kafkaCredential == nil {
+		kafkaCredential = &KafkaAgentCredential{}
+	}
+	kafkaCredential.Principal = kafkasec.GetAgentPrincipal(kafkaConf, agentUUID)
+	kafkaCredential.Topic = topic
+
+	if kafkasec.IsSaslEnabled(kafkaConf) {
+		if kafkaCredential.Password == "" {
+			password, err := generateSecret()
+			if err != nil {
+				return nil, err
+			}
+			kafkaCredential.Username = agentUUID
+			kafkaCredential.Password = password
+		}
+		if err = kafkasec.CreateScramUser(kafkaConf, kafkaCredential.Username, kafkaCredential.Password); err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to create kafka scram user, error=%s", err))
+		}
+	}
+	if kafkasec.IsMutualTLSEnabled(kafkaConf) && (kafkaCredential.CertPEM == "" || kafkaCredential.KeyPEM == "") {
+		certPEM, keyPEM, err := kafkasec.IssueAgentCertificate(kafkaConf, agentUUID)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to issue agent certificate, error=%s", err))
+		}
+		kafkaCredential.CertPEM = certPEM
+		kafkaCredential.KeyPEM = keyPEM
+	}
+
+	if err = kafkasec.AddProducerACL(kafkaConf, kafkaCredential.Principal, topic); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to add kafka producer acl, error=%s", err))
+	}
+	if err = putKafkaCredential(agentUUID, *kafkaCredential); err != nil {
+		return nil, err
+	}
+	return kafkaCredential, nil
+}
+
+// GetKafkaCredential 에이전트 kafka 자격증명 조회 (발급 이력이 없을 경우 nil 반환)
+func GetKafkaCredential(agentUUID string) (*KafkaAgentCredential, error) {
+	encryptedStr, err := cbstore.GetInstance().StoreGet(types.KafkaCredential + agentUUID)
+	if err != nil {
+		return nil, err
+	}
+	if encryptedStr == nil || *encryptedStr == "" {
+		return nil, nil
+	}
+	credentialBytes, err := credential.DecryptSecret(*encryptedStr)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to decrypt kafka credential, error=%s", err))
+	}
+	kafkaCredential := KafkaAgentCredential{}
+	if err = json.Unmarshal(credentialBytes, &kafkaCredential); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert kafka credential, error=%s", err))
+	}
+	return &kafkaCredential, nil
+}
+
+// RevokeKafkaCredential 에이전트 kafka 자격증명 폐기 (토픽 쓰기 권한, SCRAM 계정 삭제)
+//   - 클라이언트 인증서는 폐기 목록을 관리하지 않으므로 ACL 삭제로 토픽 접근을 차단합니다.
+func RevokeKafkaCredential(agentUUID string) error {
+	kafkaCredential, err := GetKafkaCredential(agentUUID)
+	if err != nil {
+		return err
+	}
+	if kafkaCredential == nil {
+		return nil
+	}
+	kafkaConf := config.GetInstance().Kafka
+	if kafkaCredential.Topic != "" {
+		if err = kafkasec.RemoveProducerACL(kafkaConf, kafkaCredential.Principal, kafkaCredential.Topic); err != nil {
+			return errors.New(fmt.Sprintf("failed to remove kafka producer acl, error=%s", err))
+		}
+	}
+	if kafkaCredential.Username != "" {
+		if err = kafkasec.DeleteScramUser(kafkaConf, kafkaCredential.Username); err != nil {
+			return errors.New(fmt.Sprintf("failed to delete kafka scram user, error=%s", err))
+		}
+	}
+	return cbstore.GetInstance().StoreDelete(types.KafkaCredential + agentUUID)
+}
+
+func putKafkaCredential(agentUUID string, kafkaCredential KafkaAgentCredential) error {
+	credentialBytes, err := json.Marshal(kafkaCredential)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to convert kafka credential format to json, error=%s", err))
+	}
+	encryptedStr, err := credential.EncryptSecret(credentialBytes)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to encrypt kafka credential, error=%s", err))
+	}
+	if err = cbstore.GetInstance().StorePut(types.KafkaCredential+agentUUID, encryptedStr); err != nil {
+		return errors.New(fmt.Sprintf("failed to put kafka credential, error=%s", err))
+	}
+	return nil
+}
+
+func generateSecret() (string, error) {
+	passwordBytes := make([]byte, 24)
+	if _, err := rand.Read(passwordBytes); err != nil {
+		return "", errors.New(fmt.Sprintf("failed to generate secret, error=%s", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(passwordBytes), nil
+}
+
+// 에이전트 kafka 인증서, 키 파일 경로
+const (
+	AgentKafkaCertDir      = "/etc/telegraf/cb-dragonfly"
+	AgentKafkaCaFile       = AgentKafkaCertDir + "/kafka-ca.pem"
+	AgentKafkaCertFile     = AgentKafkaCertDir + "/kafka-agent.pem"
+	AgentKafkaCertKeyFile  = AgentKafkaCertDir + "/kafka-agent.key"
+	kafkaSecurityPlacement = "{{kafka_security}}"
+)
+
+// RenderKafkaSecurity telegraf kafka 출력 플러그인 보안 설정 생성
+//   - TLS 사용 시 인증서, 키 파일은 에이전트의 AgentKafkaCertDir 경로에 복사되어 있어야 합니다.
+func RenderKafkaSecurity(kafkaCredential *KafkaAgentCredential) string {
+	kafkaConf := config.GetInstance().Kafka
+	if kafkaCredential == nil || !kafkasec.IsSecurityEnabled(kafkaConf) {
+		return ""
+	}
+	var securityConf []string
+	if kafkasec.IsTLSEnabled(kafkaConf) {
+		securityConf = append(securityConf, "  enable_tls = true")
+		if kafkaConf.SslCaLocation != "" {
+			securityConf = append(securityConf, fmt.Sprintf("  tls_ca = \"%s\"", AgentKafkaCaFile))
+		}
+	}
+	if kafkasec.IsMutualTLSEnabled(kafkaConf) {
+		securityConf = append(securityConf, fmt.Sprintf("  tls_cert = \"%s\"", AgentKafkaCertFile))
+		securityConf = append(securityConf, fmt.Sprintf("  tls_key = \"%s\"", AgentKafkaCertKeyFile))
+	}
+	if kafkasec.IsSaslEnabled(kafkaConf) {
+		securityConf = append(securityConf, fmt.Sprintf("  sasl_username = \"%s\"", kafkaCredential.Username))
+		securityConf = append(securityConf, fmt.Sprintf("  sasl_password = \"%s\"", kafkaCredential.Password))
+		securityConf = append(securityConf, fmt.Sprintf("  sasl_mechanism = \"%s\"", strings.ToUpper(kafkaConf.SaslMechanism)))
+	}
+	return strings.Join(securityConf, "\n") + "\n"
+}
+
+// ReplaceKafkaSecurity telegraf 설정 파일의 kafka 보안 설정 변수 값 설정
+func ReplaceKafkaSecurity(strConf string, kafkaCredential *KafkaAgentCredential) string {
+	return strings.ReplaceAll(strConf, kafkaSecurityPlacement+"\n", RenderKafkaSecurity(kafkaCredential))
+}
@@ -0,0 +1,68 @@
+package liveness
+
+import "time"
+
+// State 에이전트 하트비트 기반 생존 상태 (정상, 지연, 응답없음)
+type State string
+
+const (
+	Healthy     State = "healthy"
+	Degraded    State = "degraded"
+	Unreachable State = "unreachable"
+)
+
+const (
+	DefaultDegradedThreshold    = 120
+	DefaultUnreachableThreshold = 300
+)
+
+// ToCode 알람 임계치 비교를 위한 상태 코드 (healthy: 0, degraded: 1, unreachable: 2)
+func (s State) ToCode() int {
+	switch s {
+	case Degraded:
+		return 1
+	case Unreachable:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// Threshold degraded, unreachable 판단 기준 시간 보정 (s)
+//   - degraded 기준 시간이 없을 경우 기본값을 적용합니다.
+//   - unreachable 기준 시간이 degraded 기준 시간 이하일 경우 기본 간격만큼 늘려 적용합니다.
+func Threshold(degraded int, unreachable int) (int64, int64) {
+	if degraded <= 0 {
+		degraded = DefaultDegradedThreshold
+	}
+	if unreachable <= degraded {
+		unreachable = degraded + (DefaultUnreachableThreshold - DefaultDegradedThreshold)
+	}
+	return int64(degraded), int64(unreachable)
+}
+
+// Evaluate 마지막 수신 시각 기준 생존 상태 판단
+func Evaluate(lastSeen int64, now time.Time, degraded int64, unreachable int64) State {
+	elapsed := now.Unix() - lastSeen
+	if elapsed >= unreachable {
+		return Unreachable
+	}
+	if elapsed >= degraded {
+		return Degraded
+	}
+	return Healthy
+}
+
+// Transition 이전 상태 대비 현재 생존 상태 판단 (상태 변경 여부 반환)
+func Transition(prevState State, lastSeen int64, now time.Time, degraded int64, unreachable int64) (State, bool) {
+	curState := Evaluate(lastSeen, now, degraded, unreachable)
+	return curState, curState != prevState
+}
+
+// WriteInterval 하트비트 최소 저장 간격 (degraded 판단 기준 시간의 1/4)
+func WriteInterval(degraded int64) int64 {
+	if degraded < 4 {
+		return 1
+	}
+	return degraded / 4
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common/liveness"
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestThreshold(t *testing.T) {
+	testCases := []struct {
+		name                string
+		degraded            int
+		unreachable         int
+		expectedDegraded    int64
+		expectedUnreachable int64
+	}{
+		{name: "configured", degraded: 60, unreachable: 180, expectedDegraded: 60, expectedUnreachable: 180},
+		{name: "default", degraded: 0, unreachable: 0, expectedDegraded: 120, expectedUnreachable: 300},
+		{name: "unreachable not greater than degraded", degraded: 60, unreachable: 60, expectedDegraded: 60, expectedUnreachable: 240},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			degraded, unreachable := liveness.Threshold(tc.degraded, tc.unreachable)
+			if degraded != tc.expectedDegraded || unreachable != tc.expectedUnreachable {
+				t.Errorf("expected (%d, %d), got (%d, %d)", tc.expectedDegraded, tc.expectedUnreachable, degraded, unreachable)
+			}
+		})
+	}
+}
+
+func TestTransition(t *testing.T) {
+	lastSeen := baseTime.Unix()
+	testCases := []struct {
+		name            string
+		prevState       liveness.State
+		elapsed         time.Duration
+		expectedState   liveness.State
+		expectedChanged bool
+	}{
+		{name: "healthy", prevState: liveness.Healthy, elapsed: 119 * time.Second, expectedState: liveness.Healthy, expectedChanged: false},
+		{name: "healthy to degraded", prevState: liveness.Healthy, elapsed: 120 * time.Second, expectedState: liveness.Degraded, expectedChanged: true},
+		{name: "degraded", prevState: liveness.Degraded, elapsed: 299 * time.Second, expectedState: liveness.Degraded, expectedChanged: false},
+		{name: "degraded to unreachable", prevState: liveness.Degraded, elapsed: 300 * time.Second, expectedState: liveness.Unreachable, expectedChanged: true},
+		{name: "healthy to unreachable", prevState: liveness.Healthy, elapsed: time.Hour, expectedState: liveness.Unreachable, expectedChanged: true},
+		{name: "unreachable to healthy", prevState: liveness.Unreachable, elapsed: 0, expectedState: liveness.Healthy, expectedChanged: true},
+		{name: "unknown to healthy", prevState: "", elapsed: 0, expectedState: liveness.Healthy, expectedChanged: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			curState, changed := liveness.Transition(tc.prevState, lastSeen, baseTime.Add(tc.elapsed), 120, 300)
+			if curState != tc.expectedState || changed != tc.expectedChanged {
+				t.Errorf("expected (%s, %t), got (%s, %t)", tc.expectedState, tc.expectedChanged, curState, changed)
+			}
+		})
+	}
+}
+
+func TestToCode(t *testing.T) {
+	for state, expected := range map[liveness.State]int{liveness.Healthy: 0, liveness.Degraded: 1, liveness.Unreachable: 2, "": 0} {
+		if code := state.ToCode(); code != expected {
+			t.Errorf("expected code %d of %q, got %d", expected, state, code)
+		}
+	}
+}
+
+func TestWriteInterval(t *testing.T) {
+	for degraded, expected := range map[int64]int64{120: 30, 4: 1, 3: 1, 0: 1} {
+		if interval := liveness.WriteInterval(degraded); interval != expected {
+			t.Errorf("expected write interval %d of degraded %d, got %d", expected, degraded, interval)
+		}
+	}
+}
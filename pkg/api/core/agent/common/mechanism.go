@@ -0,0 +1,128 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// IsValidAgentType 에이전트 수집 방식 (push, pull) 체크
+func IsValidAgentType(agentType string) bool {
+	return strings.EqualFold(agentType, types.PushPolicy) || strings.EqualFold(agentType, types.PullPolicy)
+}
+
+// ResolveAgentType 에이전트 수집 방식 조회 (요청 값 > 에이전트 메타데이터 > 모니터링 기본 정책)
+func ResolveAgentType(info AgentInstallInfo) string {
+	if IsValidAgentType(info.AgentType) {
+		return strings.ToLower(info.AgentType)
+	}
+	if agentInfo, err := GetAgent(info); err == nil && IsValidAgentType(agentInfo.AgentType) {
+		return strings.ToLower(agentInfo.AgentType)
+	}
+	return strings.ToLower(config.GetInstance().Monitoring.DefaultPolicy)
+}
+
+// IsValidTransport 에이전트 메트릭 전송 방식 (kafka, http, nats) 체크
+func IsValidTransport(transport string) bool {
+	return strings.EqualFold(transport, types.KafkaTransport) || strings.EqualFold(transport, types.HTTPTransport) || strings.EqualFold(transport, types.NatsTransport)
+}
+
+// ResolveTransport 에이전트 메트릭 전송 방식 조회 (요청 값 > 에이전트 메타데이터 > 수신 기본 설정 > kafka)
+func ResolveTransport(info AgentInstallInfo) string {
+	if IsValidTransport(info.Transport) {
+		return strings.ToLower(info.Transport)
+	}
+	if agentInfo, err := GetAgent(info); err == nil && IsValidTransport(agentInfo.Transport) {
+		return strings.ToLower(agentInfo.Transport)
+	}
+	if IsValidTransport(config.GetInstance().Ingestion.Transport) {
+		return strings.ToLower(config.GetInstance().Ingestion.Transport)
+	}
+	return types.KafkaTransport
+}
+
+// agentTypeCacheTTL VM 에이전트 수집 방식 조회 결과 캐시 유지 시간 (다른 인스턴스에서 변경한 수집 방식 반영 주기)
+const agentTypeCacheTTL = 30 * time.Second
+
+type agentTypeCacheEntry struct {
+	agentType string
+	expireAt  time.Time
+}
+
+// agentTypeCache VM 별 에이전트 수집 방식 조회 결과 (메타데이터 변경 시 초기화)
+var (
+	agentTypeCacheLock sync.Mutex
+	agentTypeCache     = map[string]agentTypeCacheEntry{}
+)
+
+// GetVMAgentType VM 에이전트 수집 방식 조회 (메트릭 조회 시 활용, 에이전트가 없을 경우 모니터링 기본 정책)
+//   - CSP 유형을 알 수 없으므로 에이전트 UUID 접두어로 메타데이터를 조회하며, 메트릭 조회마다 저장소를 조회하지 않도록 결과를 캐시합니다.
+func GetVMAgentType(nsId string, mcisId string, vmId string) string {
+	cacheKey := fmt.Sprintf("%s/%s/%s", nsId, mcisId, vmId)
+	now := time.Now()
+	agentTypeCacheLock.Lock()
+	entry, ok := agentTypeCache[cacheKey]
+	agentTypeCacheLock.Unlock()
+	if ok && now.Before(entry.expireAt) {
+		return entry.agentType
+	}
+
+	agentType := lookupVMAgentType(nsId, mcisId, vmId)
+	agentTypeCacheLock.Lock()
+	agentTypeCache[cacheKey] = agentTypeCacheEntry{agentType: agentType, expireAt: now.Add(agentTypeCacheTTL)}
+	agentTypeCacheLock.Unlock()
+	return agentType
+}
+
+func lookupVMAgentType(nsId string, mcisId string, vmId string) string {
+	for _, serviceType := range []string{types.MCIS, types.VM} {
+		agentKeyPrefix := types.Agent + fmt.Sprintf("%s_%s_%s_%s_", nsId, serviceType, mcisId, vmId)
+		agentByteMap, err := cbstore.GetInstance().StoreGetListMap(agentKeyPrefix, true)
+		if err != nil {
+			continue
+		}
+		for _, agentBytes := range agentByteMap {
+			agentInfo := AgentInfo{}
+			if err := json.Unmarshal([]byte(agentBytes), &agentInfo); err != nil {
+				continue
+			}
+			if agentInfo.NsId == nsId && agentInfo.McisId == mcisId && agentInfo.VmId == vmId && IsValidAgentType(agentInfo.AgentType) {
+				return strings.ToLower(agentInfo.AgentType)
+			}
+		}
+	}
+	return strings.ToLower(config.GetInstance().Monitoring.DefaultPolicy)
+}
+
+// resetAgentTypeCache 에이전트 메타데이터 변경(등록, 수정, 삭제) 시 수집 방식 조회 결과 캐시 초기화
+func resetAgentTypeCache() {
+	agentTypeCacheLock.Lock()
+	defer agentTypeCacheLock.Unlock()
+	agentTypeCache = map[string]agentTypeCacheEntry{}
+}
+
+// IsAgentTypeEnabled 에이전트 수집 방식 모듈 구동 여부 (모니터링 기본 정책 또는 혼합 정책 사용 시 PUSH, PULL 모두)
+func IsAgentTypeEnabled(agentType string) bool {
+	monConfig := config.GetInstance().Monitoring
+	return monConfig.MixedPolicy || strings.EqualFold(agentType, monConfig.DefaultPolicy)
+}
+
+// IsPushAgent VM 에이전트 PUSH 수집 방식 여부
+func IsPushAgent(nsId string, mcisId string, vmId string) bool {
+	return GetVMAgentType(nsId, mcisId, vmId) == types.PushPolicy
+}
+
+// GetAgentTopic 에이전트 메트릭 전송 kafka 토픽 조회 (컨슈머 그룹 공용 토픽 방식일 경우 공용 토픽)
+func GetAgentTopic(agentUUID string) string {
+	monConfig := config.GetInstance().Monitoring
+	if strings.EqualFold(monConfig.CollectorMode, types.ConsumerGroupCollectorMode) && strings.EqualFold(monConfig.CollectorTopicMode, types.SharedTopicMode) {
+		return types.MCISSharedTopic
+	}
+	return agentUUID
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
@@ -39,6 +42,13 @@ type AgentInfo struct {
 	AgentUnhealthyRespCnt int    `json:"agent_unhealthy_resp_cnt"`
 	PublicIp              string `json:"public_ip"`
 	Mck8sId               string `json:"mck8s_id"`
+	LastSeen              int64  `json:"last_seen"`
+	Liveness              string `json:"liveness"`
+	Profile               string `json:"profile"`
+	Region                string `json:"region"`
+	Transport             string `json:"transport"`
+	Orphaned              bool   `json:"orphaned"`
+	OrphanedAt            int64  `json:"orphaned_at"`
 }
 
 func MakeAgentUUID(info AgentInstallInfo) string {
@@ -61,6 +71,8 @@ func DeleteAgent(info AgentInstallInfo) (string, error) {
 	if err := cbstore.GetInstance().StoreDelete(types.Agent + agentUUID); err != nil {
 		return agentUUID, err
 	}
+	forgetLastSeenWritten(agentUUID)
+	resetAgentTypeCache()
 	return agentUUID, nil
 }
 
@@ -69,6 +81,8 @@ func DeleteAgentByUUID(agentUUID string) error {
 	if err := cbstore.GetInstance().StoreDelete(types.Agent + agentUUID); err != nil {
 		return err
 	}
+	forgetLastSeenWritten(agentUUID)
+	resetAgentTypeCache()
 	return nil
 }
 
@@ -111,14 +125,26 @@ func GetAgent(info AgentInstallInfo) (*AgentInfo, error) {
 
 // GetAgentByUUID UUID 기준 에이전트 메타데이터 조회
 func GetAgentByUUID(agentUUID string) (*AgentInfo, error) {
+	agentInfo, err := FindAgentByUUID(agentUUID)
+	if err != nil {
+		return nil, err
+	}
+	if agentInfo == nil {
+		return nil, errors.New(fmt.Sprintf("failed to get agent with UUID %s", agentUUID))
+	}
+	return agentInfo, nil
+}
+
+// FindAgentByUUID UUID 기준 에이전트 메타데이터 조회 (메타데이터가 없을 경우 nil, nil 반환)
+//   - 저장소 조회 실패와 메타데이터 삭제를 구분해야 하는 경우 사용합니다.
+func FindAgentByUUID(agentUUID string) (*AgentInfo, error) {
 	agentInfo := AgentInfo{}
 	agentInfoStr, err := cbstore.GetInstance().StoreGet(fmt.Sprintf(types.Agent + agentUUID))
 	if err != nil {
 		return nil, err
 	}
-
 	if agentInfoStr == nil {
-		return nil, errors.New(fmt.Sprintf("failed to get agent with UUID %s", agentUUID))
+		return nil, nil
 	}
 	if err = json.Unmarshal([]byte(*agentInfoStr), &agentInfo); err != nil {
 		return nil, errors.New(fmt.Sprintf("failed to convert agent info, error=%s", err))
@@ -127,7 +153,11 @@ func GetAgentByUUID(agentUUID string) (*AgentInfo, error) {
 }
 
 // PutAgent 에이전트 메타데이터 수정
+//   - agentState 가 빈 값일 경우 기존 메타데이터의 설치 상태 정보를 유지
 func PutAgent(info AgentInstallInfo, unHealthyRespCnt int, agentState AgentState, agentHealth AgentHealth) (string, AgentInfo, error) {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+
 	agentUUID := MakeAgentUUID(info)
 	agentInfo := AgentInfo{}
 	if util.CheckMCK8SType(info.ServiceType) {
@@ -167,6 +197,53 @@ func PutAgent(info AgentInstallInfo, unHealthyRespCnt int, agentState AgentState
 		agentInfo.AgentHealth = string(agentHealth)
 	}
 
+	// 하트비트 정보는 기존 메타데이터 값을 유지 (최초 등록 시 등록 시각을 기준으로 설정)
+	prevAgentInfo, err := GetAgentByUUID(agentUUID)
+	if err == nil && prevAgentInfo.LastSeen != 0 {
+		agentInfo.LastSeen = prevAgentInfo.LastSeen
+		agentInfo.Liveness = prevAgentInfo.Liveness
+	} else {
+		agentInfo.LastSeen = time.Now().Unix()
+		agentInfo.Liveness = string(LivenessHealthy)
+	}
+
+	// 설치 상태 정보 요청 값이 없을 경우 기존 메타데이터 값 유지
+	if agentState == "" && prevAgentInfo != nil {
+		agentInfo.AgentState = prevAgentInfo.AgentState
+	}
+
+	// 수집 방식 정보 설정 (요청 값 > 기존 메타데이터 값 > 모니터링 기본 정책)
+	if IsValidAgentType(info.AgentType) {
+		agentInfo.AgentType = strings.ToLower(info.AgentType)
+	} else if prevAgentInfo != nil && IsValidAgentType(prevAgentInfo.AgentType) {
+		agentInfo.AgentType = prevAgentInfo.AgentType
+	}
+
+	// 메트릭 전송 방식 설정 (요청 값 > 기존 메타데이터 값)
+	if IsValidTransport(info.Transport) {
+		agentInfo.Transport = strings.ToLower(info.Transport)
+	} else if prevAgentInfo != nil {
+		agentInfo.Transport = prevAgentInfo.Transport
+	}
+
+	// 수집 프로파일 정보 설정 (요청 값이 없을 경우 기존 메타데이터 값 유지)
+	agentInfo.Profile = info.Profile
+	if agentInfo.Profile == "" && prevAgentInfo != nil {
+		agentInfo.Profile = prevAgentInfo.Profile
+	}
+
+	// 리전 정보 설정 (요청 값이 없을 경우 기존 메타데이터 값 유지)
+	agentInfo.Region = info.Region
+	if agentInfo.Region == "" && prevAgentInfo != nil {
+		agentInfo.Region = prevAgentInfo.Region
+	}
+
+	// 인벤토리 정합성 점검 결과(삭제된 VM, 클러스터 여부)는 기존 메타데이터 값 유지
+	if prevAgentInfo != nil {
+		agentInfo.Orphaned = prevAgentInfo.Orphaned
+		agentInfo.OrphanedAt = prevAgentInfo.OrphanedAt
+	}
+
 	agentInfoBytes, err := json.Marshal(agentInfo)
 	if err != nil {
 		return "", AgentInfo{}, errors.New(fmt.Sprintf("failed to convert metadata format to json, error=%s", err))
@@ -175,5 +252,35 @@ func PutAgent(info AgentInstallInfo, unHealthyRespCnt int, agentState AgentState
 	if err != nil {
 		return "", AgentInfo{}, errors.New(fmt.Sprintf("failed to put metadata, error=%s", err))
 	}
+	resetAgentTypeCache()
 	return agentUUID, agentInfo, nil
 }
+
+// DisablePushAgent PUSH 에이전트 상태 unhealthy, disable 수정 (수집 토픽 삭제 시, PULL 에이전트 및 메타데이터가 없는 에이전트는 제외)
+func DisablePushAgent(agentUUID string) error {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+
+	agentInfo, err := FindAgentByUUID(agentUUID)
+	if err != nil || agentInfo == nil || agentInfo.AgentType == types.PullPolicy {
+		return err
+	}
+	agentInfo.AgentHealth = string(Unhealthy)
+	agentInfo.AgentState = string(Disable)
+	return putAgentInfo(agentUUID, *agentInfo)
+}
+
+// UpdateAgentHealth 에이전트 헬스상태 정보 수정
+//   - 저장된 메타데이터를 다시 조회하여 헬스상태, 비정상 횟수만 변경하므로 다른 경로(인벤토리 정합성 점검 등)에서 변경한 정보를 덮어쓰지 않습니다.
+func UpdateAgentHealth(agentUUID string, unHealthyRespCnt int, agentHealth AgentHealth) error {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+
+	agentInfo, err := GetAgentByUUID(agentUUID)
+	if err != nil {
+		return err
+	}
+	agentInfo.AgentUnhealthyRespCnt = unHealthyRespCnt
+	agentInfo.AgentHealth = string(agentHealth)
+	return putAgentInfo(agentUUID, *agentInfo)
+}
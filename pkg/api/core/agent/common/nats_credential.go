@@ -0,0 +1,80 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/credential"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/natssec"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// 에이전트 NATS 자격증명, 인증서 파일 경로
+const (
+	AgentNatsCredsFile = AgentKafkaCertDir + "/nats-agent.creds"
+	AgentNatsCaFile    = AgentKafkaCertDir + "/nats-ca.pem"
+)
+
+// ProvisionNatsCredential 에이전트 NATS 자격증명(.creds) 발급 (기존 발급 자격증명이 있을 경우 재사용)
+//   - 에이전트 별 사용자 JWT 로 자신의 subject 에만 publish 할 수 있도록 제한합니다.
+func ProvisionNatsCredential(agentUUID string) (string, error) {
+	creds, err := GetNatsCredential(agentUUID)
+	if err != nil {
+		return "", err
+	}
+	if creds != "" {
+		return creds, nil
+	}
+
+	ingestionConf := config.GetInstance().Ingestion
+	if ingestionConf.NatsAccountSeedLocation == "" {
+		return "", errors.New("empty nats account seed location, nats transport requires per-agent nats credential")
+	}
+	accountSeed, err := ioutil.ReadFile(ingestionConf.NatsAccountSeedLocation)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to read nats account seed, error=%s", err))
+	}
+	if creds, err = natssec.IssueAgentCredential(accountSeed, agentUUID, natssec.GetAgentSubject(ingestionConf.NatsSubject, agentUUID)); err != nil {
+		return "", err
+	}
+	encryptedStr, err := credential.EncryptSecret([]byte(creds))
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to encrypt nats credential, error=%s", err))
+	}
+	if err = cbstore.GetInstance().StorePut(types.NatsCredential+agentUUID, encryptedStr); err != nil {
+		return "", errors.New(fmt.Sprintf("failed to put nats credential, error=%s", err))
+	}
+	return creds, nil
+}
+
+// GetNatsCredential 에이전트 NATS 자격증명 조회 (발급 이력이 없을 경우 빈 문자열 반환)
+func GetNatsCredential(agentUUID string) (string, error) {
+	encryptedStr, err := cbstore.GetInstance().StoreGet(types.NatsCredential + agentUUID)
+	if err != nil {
+		return "", err
+	}
+	if encryptedStr == nil || *encryptedStr == "" {
+		return "", nil
+	}
+	credsBytes, err := credential.DecryptSecret(*encryptedStr)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to decrypt nats credential, error=%s", err))
+	}
+	return string(credsBytes), nil
+}
+
+// RevokeNatsCredential 에이전트 NATS 자격증명 폐기
+//   - 발급된 사용자 JWT 는 만료 시각이 없으므로, 폐기된 에이전트의 메세지는 수신 시 에이전트 메타데이터 조회 실패로 거부됩니다.
+func RevokeNatsCredential(agentUUID string) error {
+	creds, err := cbstore.GetInstance().StoreGet(types.NatsCredential + agentUUID)
+	if err != nil {
+		return err
+	}
+	if creds == nil {
+		return nil
+	}
+	return cbstore.GetInstance().StoreDelete(types.NatsCredential + agentUUID)
+}
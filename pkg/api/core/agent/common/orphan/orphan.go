@@ -0,0 +1,74 @@
+package orphan
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	PolicyMark   = "mark"
+	PolicyDelete = "delete"
+
+	// DefaultGracePeriod orphaned 표시 후 메타데이터 삭제까지 기본 유예 시간 (s)
+	DefaultGracePeriod = 86400
+	// DefaultMaxRatio 한 번의 점검에서 새로 삭제 확인된 에이전트 기본 비율 상한
+	DefaultMaxRatio = 0.5
+	// guardMinAgents 비율 상한을 적용할 최소 점검 에이전트 수 (소규모 환경의 정상 VM 삭제는 허용)
+	guardMinAgents = 3
+)
+
+// Action 삭제된 VM, 클러스터 에이전트 처리
+type Action string
+
+const (
+	None  Action = ""      // 처리 없음 (이미 표시된 에이전트의 유예 시간 대기 포함)
+	Mark  Action = "mark"  // orphaned 표시
+	Purge Action = "purge" // 메타데이터 삭제
+)
+
+// GracePeriod 메타데이터 삭제 유예 시간 (미설정 시 기본 값)
+func GracePeriod(gracePeriod int) int64 {
+	if gracePeriod <= 0 {
+		return DefaultGracePeriod
+	}
+	return int64(gracePeriod)
+}
+
+// MaxRatio 새로 삭제 확인된 에이전트 비율 상한 (미설정 또는 범위 밖일 경우 기본 값)
+func MaxRatio(maxRatio float64) float64 {
+	if maxRatio <= 0 || maxRatio > 1 {
+		return DefaultMaxRatio
+	}
+	return maxRatio
+}
+
+// Decide 삭제된 VM, 클러스터 에이전트 처리 결정
+//   - 처리 정책과 관계없이 먼저 orphaned 표시하며, delete 정책은 표시 후 유예 시간(s)이 지난 에이전트만 삭제합니다.
+func Decide(policy string, orphaned bool, orphanedAt int64, now time.Time, gracePeriod int64) Action {
+	if !orphaned {
+		return Mark
+	}
+	if policy == PolicyDelete && now.Unix()-orphanedAt >= gracePeriod {
+		return Purge
+	}
+	return None
+}
+
+// Guard 새로 삭제 확인된 에이전트 비율 점검 (점검 생략 여부, 사유 반환)
+//   - 인벤토리 조회 결과가 비어있거나 일시적으로 누락된 경우 다수의 에이전트가 한 번에 삭제되지 않도록 삭제 에이전트 처리를 생략합니다.
+//   - emptyInventory 는 에이전트가 있는데 Tumblebug 네임스페이스 목록이 비어있는 경우입니다.
+func Guard(missingCnt int, checkedCnt int, maxRatio float64, emptyInventory bool) (bool, string) {
+	if missingCnt == 0 {
+		return false, ""
+	}
+	if emptyInventory {
+		return true, fmt.Sprintf("tumblebug inventory is empty, %d agents disappeared", missingCnt)
+	}
+	if checkedCnt < guardMinAgents {
+		return false, ""
+	}
+	if ratio := float64(missingCnt) / float64(checkedCnt); ratio > maxRatio {
+		return true, fmt.Sprintf("%d of %d agents disappeared at once (ratio %.2f > %.2f)", missingCnt, checkedCnt, ratio, maxRatio)
+	}
+	return false, ""
+}
@@ -0,0 +1,83 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common/orphan"
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestDecide(t *testing.T) {
+	gracePeriod := int64(3600)
+	testCases := []struct {
+		name       string
+		policy     string
+		orphaned   bool
+		orphanedAt time.Time
+		expected   orphan.Action
+	}{
+		{name: "mark new orphan", policy: orphan.PolicyMark, orphaned: false, expected: orphan.Mark},
+		{name: "mark policy keeps orphan", policy: orphan.PolicyMark, orphaned: true, orphanedAt: baseTime.Add(-24 * time.Hour), expected: orphan.None},
+		{name: "delete policy marks first", policy: orphan.PolicyDelete, orphaned: false, expected: orphan.Mark},
+		{name: "delete policy within grace period", policy: orphan.PolicyDelete, orphaned: true, orphanedAt: baseTime.Add(-59 * time.Minute), expected: orphan.None},
+		{name: "delete policy at grace period", policy: orphan.PolicyDelete, orphaned: true, orphanedAt: baseTime.Add(-time.Hour), expected: orphan.Purge},
+		{name: "delete policy after grace period", policy: orphan.PolicyDelete, orphaned: true, orphanedAt: baseTime.Add(-2 * time.Hour), expected: orphan.Purge},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var orphanedAt int64
+			if !tc.orphanedAt.IsZero() {
+				orphanedAt = tc.orphanedAt.Unix()
+			}
+			if action := orphan.Decide(tc.policy, tc.orphaned, orphanedAt, baseTime, gracePeriod); action != tc.expected {
+				t.Errorf("expected action %q, got %q", tc.expected, action)
+			}
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	testCases := []struct {
+		name           string
+		missingCnt     int
+		checkedCnt     int
+		emptyInventory bool
+		expected       bool
+	}{
+		{name: "no missing agent", missingCnt: 0, checkedCnt: 10, expected: false},
+		{name: "no missing agent with empty inventory", missingCnt: 0, checkedCnt: 0, emptyInventory: true, expected: false},
+		{name: "empty inventory", missingCnt: 2, checkedCnt: 2, emptyInventory: true, expected: true},
+		{name: "few agents", missingCnt: 2, checkedCnt: 2, expected: false},
+		{name: "below ratio", missingCnt: 4, checkedCnt: 10, expected: false},
+		{name: "at ratio", missingCnt: 5, checkedCnt: 10, expected: false},
+		{name: "above ratio", missingCnt: 6, checkedCnt: 10, expected: true},
+		{name: "all agents disappeared", missingCnt: 10, checkedCnt: 10, expected: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			skip, reason := orphan.Guard(tc.missingCnt, tc.checkedCnt, 0.5, tc.emptyInventory)
+			if skip != tc.expected {
+				t.Errorf("expected skip %t, got %t (%s)", tc.expected, skip, reason)
+			}
+			if skip && reason == "" {
+				t.Errorf("expected skip reason")
+			}
+		})
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	if gracePeriod := orphan.GracePeriod(0); gracePeriod != orphan.DefaultGracePeriod {
+		t.Errorf("expected default grace period, got %d", gracePeriod)
+	}
+	if gracePeriod := orphan.GracePeriod(600); gracePeriod != 600 {
+		t.Errorf("expected configured grace period, got %d", gracePeriod)
+	}
+	for maxRatio, expected := range map[float64]float64{0: orphan.DefaultMaxRatio, -1: orphan.DefaultMaxRatio, 1.5: orphan.DefaultMaxRatio, 0.3: 0.3, 1: 1} {
+		if ratio := orphan.MaxRatio(maxRatio); ratio != expected {
+			t.Errorf("expected max ratio %.2f of %.2f, got %.2f", expected, maxRatio, ratio)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+const (
+	MinimalProfile  = "minimal"
+	StandardProfile = "standard"
+	DatabaseProfile = "database"
+
+	DefaultProfile = StandardProfile
+)
+
+// SupportedProfileInputs 수집 프로파일에서 선택 가능한 telegraf 입력 플러그인 목록
+var SupportedProfileInputs = []string{"cpu", "cpufreq", "disk", "diskio", "mem", "swap", "net", "system", "processes", "procstat", "net_response"}
+
+// ProfileInput 수집 프로파일 입력 플러그인 설정
+type ProfileInput struct {
+	Name     string            `json:"name"`
+	Interval int               `json:"interval,omitempty"` // 플러그인 수집주기 (s), 0일 경우 프로파일 수집주기 적용
+	Options  map[string]string `json:"options,omitempty"`  // 플러그인 설정 값 (procstat: pattern, net_response: protocol, address)
+}
+
+// CollectionProfile 에이전트 수집 프로파일 (수집 메트릭, 수집 주기)
+type CollectionProfile struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Interval    int            `json:"interval,omitempty"` // 에이전트 기본 수집주기 (s), 0일 경우 mcis_agent_interval 적용
+	Inputs      []ProfileInput `json:"inputs"`
+}
+
+// MCISProfileInfo MCIS 단위 수집 프로파일 지정 정보
+type MCISProfileInfo struct {
+	NsId    string `json:"ns_id"`
+	McisId  string `json:"mcis_id"`
+	Profile string `json:"profile"`
+}
+
+// 기본 제공 수집 프로파일 (cb-store 에 동일한 이름의 프로파일이 있을 경우 저장된 프로파일 우선 적용)
+var builtinProfiles = map[string]CollectionProfile{
+	MinimalProfile: {
+		Name:        MinimalProfile,
+		Description: "cpu, memory, disk usage only",
+		Inputs:      []ProfileInput{{Name: "cpu"}, {Name: "mem"}, {Name: "disk", Interval: 60}},
+	},
+	StandardProfile: {
+		Name:        StandardProfile,
+		Description: "default system metrics",
+		Inputs: []ProfileInput{
+			{Name: "cpu"}, {Name: "cpufreq"}, {Name: "disk"}, {Name: "diskio"}, {Name: "mem"},
+			{Name: "swap"}, {Name: "net"}, {Name: "system"}, {Name: "processes"},
+		},
+	},
+	DatabaseProfile: {
+		Name:        DatabaseProfile,
+		Description: "default system metrics with database process and port check",
+		Inputs: []ProfileInput{
+			{Name: "cpu"}, {Name: "cpufreq"}, {Name: "disk"}, {Name: "diskio"}, {Name: "mem"},
+			{Name: "swap"}, {Name: "net"}, {Name: "system"}, {Name: "processes"},
+			{Name: "procstat", Options: map[string]string{"pattern": "mysqld|postgres|mongod|redis-server"}},
+			{Name: "net_response", Interval: 30, Options: map[string]string{"protocol": "tcp", "address": "localhost:3306"}},
+		},
+	},
+}
+
+// Validate 수집 프로파일 설정 값 체크
+func (p CollectionProfile) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errors.New("empty profile name")
+	}
+	if p.Interval < 0 {
+		return errors.New(fmt.Sprintf("invalid profile interval %d", p.Interval))
+	}
+	if len(p.Inputs) == 0 {
+		return errors.New(fmt.Sprintf("profile %s has no input plugin", p.Name))
+	}
+	for _, input := range p.Inputs {
+		if !isSupportedProfileInput(input.Name) {
+			return errors.New(fmt.Sprintf("unsupported input plugin %s, supported=%v", input.Name, SupportedProfileInputs))
+		}
+		if input.Interval < 0 {
+			return errors.New(fmt.Sprintf("invalid interval %d of input plugin %s", input.Interval, input.Name))
+		}
+	}
+	return nil
+}
+
+func isSupportedProfileInput(name string) bool {
+	for _, supported := range SupportedProfileInputs {
+		if name == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// ListProfile 수집 프로파일 목록 조회 (기본 제공 프로파일 포함)
+func ListProfile() ([]CollectionProfile, error) {
+	profileMap := map[string]CollectionProfile{}
+	for name, profile := range builtinProfiles {
+		profileMap[name] = profile
+	}
+
+	profileListByteMap, err := cbstore.GetInstance().StoreGetListMap(types.CollectionProfile, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, bytes := range profileListByteMap {
+		profile := CollectionProfile{}
+		if err := json.Unmarshal([]byte(bytes), &profile); err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to convert profile list, error=%s", err))
+		}
+		profileMap[profile.Name] = profile
+	}
+
+	profileList := make([]CollectionProfile, 0, len(profileMap))
+	for _, profile := range profileMap {
+		profileList = append(profileList, profile)
+	}
+	sort.Slice(profileList, func(i, j int) bool { return profileList[i].Name < profileList[j].Name })
+	return profileList, nil
+}
+
+// GetProfile 수집 프로파일 조회
+func GetProfile(name string) (*CollectionProfile, error) {
+	profileStr, err := cbstore.GetInstance().StoreGet(types.CollectionProfile + name)
+	if err != nil {
+		return nil, err
+	}
+	if profileStr == nil {
+		if profile, ok := builtinProfiles[name]; ok {
+			return &profile, nil
+		}
+		return nil, errors.New(fmt.Sprintf("failed to get profile with name %s", name))
+	}
+
+	profile := CollectionProfile{}
+	if err = json.Unmarshal([]byte(*profileStr), &profile); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert profile info, error=%s", err))
+	}
+	return &profile, nil
+}
+
+// PutProfile 수집 프로파일 생성 및 수정
+func PutProfile(profile CollectionProfile) (*CollectionProfile, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+	profileBytes, err := json.Marshal(profile)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert profile format to json, error=%s", err))
+	}
+	if err = cbstore.GetInstance().StorePut(types.CollectionProfile+profile.Name, string(profileBytes)); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to put profile, error=%s", err))
+	}
+	return &profile, nil
+}
+
+// DeleteProfile 수집 프로파일 삭제 (기본 제공 프로파일은 저장된 변경 내역만 삭제)
+func DeleteProfile(name string) error {
+	profileStr, err := cbstore.GetInstance().StoreGet(types.CollectionProfile + name)
+	if err != nil {
+		return err
+	}
+	if profileStr == nil {
+		if _, ok := builtinProfiles[name]; ok {
+			return errors.New(fmt.Sprintf("builtin profile %s can not be deleted", name))
+		}
+		return errors.New(fmt.Sprintf("failed to get profile with name %s", name))
+	}
+	return cbstore.GetInstance().StoreDelete(types.CollectionProfile + name)
+}
+
+// GetMCISProfile MCIS 단위 수집 프로파일 조회
+func GetMCISProfile(nsId string, mcisId string) (string, error) {
+	profileName, err := cbstore.GetInstance().StoreGet(fmt.Sprintf("%s%s/%s", types.MCISCollectionProfile, nsId, mcisId))
+	if err != nil {
+		return "", err
+	}
+	if profileName == nil {
+		return "", nil
+	}
+	return *profileName, nil
+}
+
+// PutMCISProfile MCIS 단위 수집 프로파일 지정 (이후 설치되는 MCIS 에이전트에 적용)
+func PutMCISProfile(info MCISProfileInfo) error {
+	if _, err := GetProfile(info.Profile); err != nil {
+		return err
+	}
+	return cbstore.GetInstance().StorePut(fmt.Sprintf("%s%s/%s", types.MCISCollectionProfile, info.NsId, info.McisId), info.Profile)
+}
+
+// ResolveProfile 에이전트 적용 수집 프로파일 조회 (요청 값 > 에이전트 메타데이터 > MCIS 지정 프로파일 > 기본 프로파일)
+func ResolveProfile(info AgentInstallInfo) (*CollectionProfile, error) {
+	profileName := info.Profile
+	if profileName == "" {
+		if agentInfo, err := GetAgent(info); err == nil && agentInfo.Profile != "" {
+			profileName = agentInfo.Profile
+		}
+	}
+	if profileName == "" {
+		mcisProfile, err := GetMCISProfile(info.NsId, info.McisId)
+		if err != nil {
+			return nil, err
+		}
+		profileName = mcisProfile
+	}
+	if profileName == "" {
+		profileName = DefaultProfile
+	}
+	return GetProfile(profileName)
+}
@@ -0,0 +1,95 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// RawModeInfo 원본 샘플(raw) 저장 설정 정보
+//   - McisId 가 없을 경우 네임스페이스 전체 에이전트에 적용합니다.
+type RawModeInfo struct {
+	NsId   string `json:"ns_id"`
+	McisId string `json:"mcis_id,omitempty"`
+}
+
+// RawModeFilter 콜렉터 원본 샘플 저장 대상 판단 (네임스페이스, 네임스페이스/MCIS 키)
+type RawModeFilter map[string]bool
+
+func makeRawModeKey(nsId string, mcisId string) string {
+	if mcisId == "" {
+		return nsId
+	}
+	return fmt.Sprintf("%s/%s", nsId, mcisId)
+}
+
+// IsEnabled 네임스페이스 또는 MCIS 단위 raw 모드 설정 여부
+func (f RawModeFilter) IsEnabled(nsId string, mcisId string) bool {
+	return f[makeRawModeKey(nsId, "")] || f[makeRawModeKey(nsId, mcisId)]
+}
+
+// ListRawMode raw 모드 설정 목록 조회
+func ListRawMode() ([]RawModeInfo, error) {
+	rawModeListByteMap, err := cbstore.GetInstance().StoreGetListMap(types.RawMode, true)
+	if err != nil {
+		return nil, err
+	}
+	rawModeList := make([]RawModeInfo, 0, len(rawModeListByteMap))
+	for _, bytes := range rawModeListByteMap {
+		rawMode := RawModeInfo{}
+		if err := json.Unmarshal([]byte(bytes), &rawMode); err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to convert raw mode list, error=%s", err))
+		}
+		rawModeList = append(rawModeList, rawMode)
+	}
+	sort.Slice(rawModeList, func(i, j int) bool {
+		return makeRawModeKey(rawModeList[i].NsId, rawModeList[i].McisId) < makeRawModeKey(rawModeList[j].NsId, rawModeList[j].McisId)
+	})
+	return rawModeList, nil
+}
+
+// GetRawModeFilter 콜렉터 원본 샘플 저장 대상 조회
+func GetRawModeFilter() (RawModeFilter, error) {
+	rawModeList, err := ListRawMode()
+	if err != nil {
+		return nil, err
+	}
+	filter := RawModeFilter{}
+	for _, rawMode := range rawModeList {
+		filter[makeRawModeKey(rawMode.NsId, rawMode.McisId)] = true
+	}
+	return filter, nil
+}
+
+// PutRawMode 네임스페이스 또는 MCIS 단위 raw 모드 설정
+func PutRawMode(info RawModeInfo) error {
+	if strings.TrimSpace(info.NsId) == "" {
+		return errors.New("empty namespace id")
+	}
+	rawModeBytes, err := json.Marshal(info)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to convert raw mode format to json, error=%s", err))
+	}
+	if err = cbstore.GetInstance().StorePut(types.RawMode+makeRawModeKey(info.NsId, info.McisId), string(rawModeBytes)); err != nil {
+		return errors.New(fmt.Sprintf("failed to put raw mode, error=%s", err))
+	}
+	return nil
+}
+
+// DeleteRawMode 네임스페이스 또는 MCIS 단위 raw 모드 해제
+func DeleteRawMode(info RawModeInfo) error {
+	rawModeKey := types.RawMode + makeRawModeKey(info.NsId, info.McisId)
+	rawModeStr, err := cbstore.GetInstance().StoreGet(rawModeKey)
+	if err != nil {
+		return err
+	}
+	if rawModeStr == nil {
+		return errors.New(fmt.Sprintf("failed to get raw mode with key %s", makeRawModeKey(info.NsId, info.McisId)))
+	}
+	return cbstore.GetInstance().StoreDelete(rawModeKey)
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// SetAgentOrphaned 에이전트 설치 대상(VM, 클러스터) 삭제 여부 설정
+//   - orphaned 설정 시 하트비트 상태 점검, PULL 수집 대상에서 제외됩니다.
+func SetAgentOrphaned(agentUUID string, orphaned bool, now time.Time) error {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+
+	agentInfo, err := GetAgentByUUID(agentUUID)
+	if err != nil {
+		return err
+	}
+	if agentInfo.Orphaned == orphaned {
+		return nil
+	}
+	agentInfo.Orphaned = orphaned
+	agentInfo.OrphanedAt = 0
+	if orphaned {
+		agentInfo.OrphanedAt = now.Unix()
+	}
+	return putAgentInfo(agentUUID, *agentInfo)
+}
+
+// UpdateAgentPublicIp 에이전트 설치 VM 공인 IP 변경
+func UpdateAgentPublicIp(agentUUID string, publicIp string) error {
+	livenessLock.Lock()
+	defer livenessLock.Unlock()
+
+	agentInfo, err := GetAgentByUUID(agentUUID)
+	if err != nil {
+		return err
+	}
+	agentInfo.PublicIp = publicIp
+	return putAgentInfo(agentUUID, *agentInfo)
+}
+
+// PurgeAgent 에이전트 메타데이터 및 관련 자격증명, 수집 토픽 정리 (에이전트 제거 작업 없이 Dragonfly 정보만 삭제)
+func PurgeAgent(agentInfo AgentInfo) error {
+	agentUUID := MakeAgentUUIDByInfo(agentInfo)
+	if err := DeleteAgentByUUID(agentUUID); err != nil {
+		return errors.New(fmt.Sprintf("failed to delete metadata, error=%s", err))
+	}
+
+	// kafka 자격증명, HTTP 수신 토큰, NATS 자격증명, 거부 메세지 이력 폐기
+	if err := RevokeKafkaCredential(agentUUID); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke kafka credential, error=%s", err))
+	}
+	if err := RevokeIngestToken(agentUUID); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke ingest token, error=%s", err))
+	}
+	if err := RevokeNatsCredential(agentUUID); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke nats credential, error=%s", err))
+	}
+	if err := DeleteDeadLetter(agentUUID); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to delete dead letter, error=%s", err))
+	}
+
+	// 콜렉터 수집 토픽 삭제 요청
+	if util.CheckMCK8SType(agentInfo.ServiceType) {
+		if err := util.PutMCK8SRingQueue(types.TopicDel, agentUUID); err != nil {
+			util.GetLogger().Error(err)
+		}
+	} else if agentInfo.AgentType != types.PullPolicy {
+		if err := util.RingQueuePut(types.TopicDel, agentUUID); err != nil {
+			util.GetLogger().Error(err)
+		}
+	}
+	return nil
+}
@@ -6,12 +6,16 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/bramvdbogaerde/go-scp"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/mcis/inputs"
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/natssec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	sshrun "github.com/cloud-barista/cb-spider/cloud-control-manager/vm-ssh"
@@ -20,7 +24,7 @@ import (
 )
 
 func CreateTelegrafConfigFile(installInfo common.AgentInstallInfo) (string, error) {
-	mechanism := fmt.Sprintf(strings.ToLower(config.GetInstance().Monitoring.DefaultPolicy))
+	mechanism := common.ResolveAgentType(installInfo)
 	rootPath := os.Getenv("CBMON_ROOT")
 	filePath := rootPath + "/file/conf/mcis/telegraf.conf"
 	read, err := ioutil.ReadFile(filePath)
@@ -33,6 +37,15 @@ func CreateTelegrafConfigFile(installInfo common.AgentInstallInfo) (string, erro
 	// 파일 내의 변수 값 설정 (hostId, collectorServer)
 	strConf := string(read)
 
+	// 수집 방식, 전송 방식 기반 출력 플러그인 설정
+	agentUUID := common.MakeAgentUUID(installInfo)
+	outputPlugins, err := RenderOutputPlugins(mechanism, common.ResolveTransport(installInfo), agentUUID)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to render output plugins, error=%s", err))
+		return "", err
+	}
+	strConf = strings.ReplaceAll(strConf, "{{output_plugins}}", outputPlugins)
+
 	serverPort := config.GetInstance().Dragonfly.Port
 	if strings.EqualFold(config.GetInstance().GetMonConfig().DeployType, types.Helm) {
 		serverPort = config.GetInstance().Dragonfly.HelmPort
@@ -47,8 +60,22 @@ func CreateTelegrafConfigFile(installInfo common.AgentInstallInfo) (string, erro
 	strConf = strings.ReplaceAll(strConf, "{{mechanism}}", mechanism)
 	strConf = strings.ReplaceAll(strConf, "{{server_port}}", fmt.Sprintf("%d", serverPort))
 
-	strConf = strings.ReplaceAll(strConf, "{{topic}}", fmt.Sprintf("%s_%s_%s_%s_%s", installInfo.NsId, installInfo.ServiceType, installInfo.McisId, installInfo.VmId, installInfo.CspType))
-	strConf = strings.ReplaceAll(strConf, "{{agent_collect_interval}}", fmt.Sprintf("%ds", config.GetInstance().Monitoring.MCISAgentInterval))
+	strConf = strings.ReplaceAll(strConf, "{{topic}}", common.GetAgentTopic(agentUUID))
+	strConf = strings.ReplaceAll(strConf, "{{agent_uuid}}", agentUUID)
+
+	// 수집 프로파일 기반 수집주기, 입력 플러그인 설정
+	profile, err := common.ResolveProfile(installInfo)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to get collection profile, error=%s", err))
+		return "", err
+	}
+	inputPlugins, err := RenderInputPlugins(*profile)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to render input plugins, error=%s", err))
+		return "", err
+	}
+	strConf = strings.ReplaceAll(strConf, "{{agent_collect_interval}}", fmt.Sprintf("%ds", GetProfileInterval(*profile)))
+	strConf = strings.ReplaceAll(strConf, "{{input_plugins}}", inputPlugins)
 
 	var kafkaPort int
 	if strings.EqualFold(config.GetInstance().GetMonConfig().DeployType, types.Helm) {
@@ -72,7 +99,185 @@ func CreateTelegrafConfigFile(installInfo common.AgentInstallInfo) (string, erro
 	return telegrafConfFile, err
 }
 
+// GetProfileInterval 수집 프로파일 에이전트 수집주기 조회 (s)
+func GetProfileInterval(profile common.CollectionProfile) int {
+	if profile.Interval > 0 {
+		return profile.Interval
+	}
+	return config.GetInstance().Monitoring.MCISAgentInterval
+}
+
+// RenderInputPlugins 수집 프로파일 기반 telegraf 입력 플러그인 설정 생성
+func RenderInputPlugins(profile common.CollectionProfile) (string, error) {
+	if err := profile.Validate(); err != nil {
+		return "", err
+	}
+	inputPath := os.Getenv("CBMON_ROOT") + "/file/conf/mcis/inputs/"
+
+	var inputPlugins []string
+	for _, input := range profile.Inputs {
+		read, err := ioutil.ReadFile(inputPath + input.Name + ".conf")
+		if err != nil {
+			return "", errors.New(fmt.Sprintf("failed to read input plugin %s, error=%s", input.Name, err))
+		}
+		// 플러그인 수집주기(미설정 시 에이전트 수집주기 적용), 옵션 값 설정
+		strInput, err := inputs.Render(string(read), input.Interval, input.Options)
+		if err != nil {
+			return "", errors.New(fmt.Sprintf("failed to render input plugin %s, error=%s", input.Name, err))
+		}
+		inputPlugins = append(inputPlugins, strInput)
+	}
+	return strings.Join(inputPlugins, "\n"), nil
+}
+
+// RenderOutputPlugins 수집 방식, 전송 방식 기반 telegraf 출력 플러그인 설정 생성 (PUSH: kafka, http, nats, PULL: 없음)
+//   - 전송 방식 별 에이전트 자격증명(kafka 계정/인증서, HTTP 수신 토큰)을 함께 발급합니다.
+func RenderOutputPlugins(agentType string, transport string, agentUUID string) (string, error) {
+	if !strings.EqualFold(agentType, types.PushPolicy) {
+		return "", nil
+	}
+	read, err := ioutil.ReadFile(os.Getenv("CBMON_ROOT") + fmt.Sprintf("/file/conf/mcis/outputs/%s.conf", transport))
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to read %s output plugin, error=%s", transport, err))
+	}
+	strOutput := string(read)
+
+	ingestionConf := config.GetInstance().Ingestion
+	switch transport {
+	case types.KafkaTransport:
+		// 에이전트 kafka 자격증명 발급 (보안 설정 비활성화 시 nil)
+		kafkaCredential, err := common.ProvisionKafkaCredential(agentUUID, common.GetAgentTopic(agentUUID))
+		if err != nil {
+			return "", errors.New(fmt.Sprintf("failed to provision kafka credential, error=%s", err))
+		}
+		strOutput = common.ReplaceKafkaSecurity(strOutput, kafkaCredential)
+	case types.HTTPTransport:
+		token, err := common.ProvisionIngestToken(agentUUID)
+		if err != nil {
+			return "", errors.New(fmt.Sprintf("failed to provision ingest token, error=%s", err))
+		}
+		ingestUrl := ingestionConf.HTTPUrl
+		if ingestUrl == "" {
+			serverPort := config.GetInstance().Dragonfly.Port
+			if strings.EqualFold(config.GetInstance().GetMonConfig().DeployType, types.Helm) {
+				serverPort = config.GetInstance().Dragonfly.HelmPort
+			}
+			ingestUrl = fmt.Sprintf("http://cb-dragonfly:%d/dragonfly/ingest", serverPort)
+		}
+		strOutput = strings.ReplaceAll(strOutput, "{{ingest_url}}", ingestUrl)
+		strOutput = strings.ReplaceAll(strOutput, "{{ingest_token}}", token)
+	case types.NatsTransport:
+		// 에이전트 NATS 자격증명 발급 (에이전트 subject publish 권한만 부여)
+		if _, err := common.ProvisionNatsCredential(agentUUID); err != nil {
+			return "", errors.New(fmt.Sprintf("failed to provision nats credential, error=%s", err))
+		}
+		natsTLS := ""
+		if ingestionConf.NatsTlsCaLocation != "" {
+			natsTLS = fmt.Sprintf("  tls_ca = \"%s\"\n", common.AgentNatsCaFile)
+		}
+		strOutput = strings.ReplaceAll(strOutput, "{{nats_url}}", ingestionConf.NatsAgentUrl)
+		strOutput = strings.ReplaceAll(strOutput, "{{nats_subject}}", natssec.GetAgentSubject(ingestionConf.NatsSubject, agentUUID))
+		strOutput = strings.ReplaceAll(strOutput, "{{nats_credentials}}", common.AgentNatsCredsFile)
+		strOutput = strings.ReplaceAll(strOutput, "{{nats_tls}}\n", natsTLS)
+	default:
+		return "", errors.New(fmt.Sprintf("unsupported transport %s", transport))
+	}
+	return strOutput, nil
+}
+
+// CopyKafkaCredentialFiles 에이전트에 kafka TLS 인증서, 키 파일 복사
+//   - kafka 전송 방식이 아니거나 TLS 미사용 시 복사하지 않으며, $HOME/cb-dragonfly 폴더가 생성되어 있어야 합니다.
+func CopyKafkaCredentialFiles(sshInfo sshrun.SSHInfo, info common.AgentInstallInfo) error {
+	kafkaConf := config.GetInstance().Kafka
+	if !kafkasec.IsTLSEnabled(kafkaConf) || common.ResolveTransport(info) != types.KafkaTransport {
+		return nil
+	}
+	kafkaCredential, err := common.GetKafkaCredential(common.MakeAgentUUID(info))
+	if err != nil {
+		return err
+	}
+	if kafkaCredential == nil {
+		return nil
+	}
+
+	copyFiles := map[string]string{}
+	if kafkaConf.SslCaLocation != "" {
+		copyFiles[kafkaConf.SslCaLocation] = common.AgentKafkaCaFile
+	}
+	contentFiles := map[string]string{}
+	if kafkasec.IsMutualTLSEnabled(kafkaConf) {
+		contentFiles[common.AgentKafkaCertFile] = kafkaCredential.CertPEM
+		contentFiles[common.AgentKafkaCertKeyFile] = kafkaCredential.KeyPEM
+	}
+	return copyCredentialFiles(sshInfo, copyFiles, contentFiles)
+}
+
+// CopyNatsCredentialFiles 에이전트에 NATS 자격증명(.creds), 서버 CA 인증서 파일 복사
+//   - nats 전송 방식이 아닐 경우 복사하지 않으며, $HOME/cb-dragonfly 폴더가 생성되어 있어야 합니다.
+func CopyNatsCredentialFiles(sshInfo sshrun.SSHInfo, info common.AgentInstallInfo) error {
+	if !strings.EqualFold(common.ResolveAgentType(info), types.PushPolicy) || common.ResolveTransport(info) != types.NatsTransport {
+		return nil
+	}
+	creds, err := common.GetNatsCredential(common.MakeAgentUUID(info))
+	if err != nil {
+		return err
+	}
+	if creds == "" {
+		return nil
+	}
+
+	copyFiles := map[string]string{}
+	if caLocation := config.GetInstance().Ingestion.NatsTlsCaLocation; caLocation != "" {
+		copyFiles[caLocation] = common.AgentNatsCaFile
+	}
+	return copyCredentialFiles(sshInfo, copyFiles, map[string]string{common.AgentNatsCredsFile: creds})
+}
+
+// copyCredentialFiles 에이전트에 자격증명 파일 복사
+//   - copyFiles: 로컬 파일 경로 > 에이전트 파일 경로
+//   - contentFiles: 에이전트 파일 경로 > 파일 내용 (임시 파일로 저장 후 복사)
+func copyCredentialFiles(sshInfo sshrun.SSHInfo, copyFiles map[string]string, contentFiles map[string]string) error {
+	for targetFile, content := range contentFiles {
+		tempFile, err := ioutil.TempFile("", "agent-credential-*")
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to create temporary credential file, error=%s", err))
+		}
+		defer os.Remove(tempFile.Name())
+		if _, err = tempFile.WriteString(content); err != nil {
+			tempFile.Close()
+			return errors.New(fmt.Sprintf("failed to write temporary credential file, error=%s", err))
+		}
+		tempFile.Close()
+		copyFiles[tempFile.Name()] = targetFile
+	}
+
+	if _, err := sshrun.SSHRun(sshInfo, fmt.Sprintf("sudo mkdir -p %s", common.AgentKafkaCertDir)); err != nil {
+		return errors.New(fmt.Sprintf("failed to make credential directory, error=%s", err))
+	}
+	for sourceFile, targetFile := range copyFiles {
+		tempTargetFile := "$HOME/cb-dragonfly/" + filepath.Base(targetFile)
+		if err := sshrun.SSHCopy(sshInfo, sourceFile, tempTargetFile); err != nil {
+			return errors.New(fmt.Sprintf("failed to copy credential file, error=%s", err))
+		}
+		if _, err := sshrun.SSHRun(sshInfo, fmt.Sprintf("sudo mv %s %s && sudo chmod 600 %s", tempTargetFile, targetFile, targetFile)); err != nil {
+			return errors.New(fmt.Sprintf("failed to move credential file, error=%s", err))
+		}
+	}
+	return nil
+}
+
 func InstallAgent(info common.AgentInstallInfo) (int, error) {
+	// 수집 프로파일 확인
+	profile, err := common.ResolveProfile(info)
+	if err != nil {
+		return http.StatusBadRequest, errors.New(fmt.Sprintf("failed to get collection profile, error=%s", err))
+	}
+	info.Profile = profile.Name
+	info.AgentType = common.ResolveAgentType(info)
+	if !common.IsAgentTypeEnabled(info.AgentType) {
+		return http.StatusBadRequest, errors.New(fmt.Sprintf("agent type %s is not enabled, enable monitoring.mixed_policy to use agent type other than default policy", info.AgentType))
+	}
+
 	rootPath := os.Getenv("CBMON_ROOT")
 	sshInfo := sshrun.SSHInfo{
 		ServerPort: info.PublicIp + ":" + info.Port,
@@ -173,6 +378,17 @@ func InstallAgent(info common.AgentInstallInfo) (int, error) {
 		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to move telegraf.conf, error=%s", err))
 	}
 
+	// kafka TLS 인증서, 키 파일 복사
+	if err = CopyKafkaCredentialFiles(sshInfo, info); err != nil {
+		common.CleanAgentInstall(info, &sshInfo, &osType, nil)
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to copy kafka credential files, error=%s", err))
+	}
+	// NATS 자격증명, 인증서 파일 복사
+	if err = CopyNatsCredentialFiles(sshInfo, info); err != nil {
+		common.CleanAgentInstall(info, &sshInfo, &osType, nil)
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to copy nats credential files, error=%s", err))
+	}
+
 	// 카프카 도메인 정보 기입 /etc/hosts => agent에서 도메인 등록하도록 기능 변경
 	inputDomain := fmt.Sprintf("echo '%s %s' | sudo tee -a /etc/hosts", config.GetInstance().Dragonfly.DragonflyIP, "cb-dragonfly-kafka cb-dragonfly")
 	if _, err = sshrun.SSHRun(sshInfo, inputDomain); err != nil {
@@ -228,6 +444,93 @@ func InstallAgent(info common.AgentInstallInfo) (int, error) {
 	return http.StatusOK, nil
 }
 
+// ApplyAgentProfile 설치된 에이전트에 수집 프로파일 변경 적용
+func ApplyAgentProfile(info common.AgentInstallInfo) (int, error) {
+	if _, err := common.GetProfile(info.Profile); err != nil {
+		return http.StatusBadRequest, err
+	}
+	return reconfigureAgent(info)
+}
+
+// ChangeAgentType 설치된 에이전트 수집 방식 (push, pull) 변경 적용
+func ChangeAgentType(info common.AgentInstallInfo) (int, error) {
+	if !common.IsValidAgentType(info.AgentType) {
+		return http.StatusBadRequest, errors.New(fmt.Sprintf("invalid agent type %s, agent type must be push or pull", info.AgentType))
+	}
+	info.AgentType = strings.ToLower(info.AgentType)
+	if !common.IsAgentTypeEnabled(info.AgentType) {
+		return http.StatusBadRequest, errors.New(fmt.Sprintf("agent type %s is not enabled, enable monitoring.mixed_policy to use agent type other than default policy", info.AgentType))
+	}
+	return reconfigureAgent(info)
+}
+
+// reconfigureAgent 에이전트 telegraf.conf 재생성 후 에이전트 재시작, 메타데이터 수정
+func reconfigureAgent(info common.AgentInstallInfo) (int, error) {
+	agentInfo, err := common.GetAgent(info)
+	if err != nil {
+		return http.StatusBadRequest, errors.New(fmt.Sprintf("failed to get agent metadata, error=%s", err))
+	}
+
+	sshInfo := sshrun.SSHInfo{
+		ServerPort: info.PublicIp + ":" + info.Port,
+		UserName:   info.UserName,
+		PrivateKey: []byte(info.SshKey),
+	}
+
+	// {사용자계정}/cb-dragonfly 폴더 생성
+	createFolderCmd := fmt.Sprintf("mkdir -p $HOME/cb-dragonfly")
+	if _, err := sshrun.SSHRun(sshInfo, createFolderCmd); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to make directory cb-dragonfly, error=%s", err))
+	}
+
+	// telegraf_conf 파일 복사
+	telegrafConfSourceFile, err := CreateTelegrafConfigFile(info)
+	if err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to create telegraf.conf, error=%s", err))
+	}
+	defer os.Remove(telegrafConfSourceFile)
+
+	telegrafConfTargetFile := "$HOME/cb-dragonfly/telegraf.conf"
+	if err = sshrun.SSHCopy(sshInfo, telegrafConfSourceFile, telegrafConfTargetFile); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to copy telegraf.conf, error=%s", err))
+	}
+	if err = CopyKafkaCredentialFiles(sshInfo, info); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to copy kafka credential files, error=%s", err))
+	}
+	if err = CopyNatsCredentialFiles(sshInfo, info); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to copy nats credential files, error=%s", err))
+	}
+	if _, err = sshrun.SSHRun(sshInfo, "sudo mv $HOME/cb-dragonfly/telegraf.conf /etc/telegraf/ && sudo systemctl restart telegraf"); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to apply telegraf.conf, error=%s", err))
+	}
+	sshrun.SSHRun(sshInfo, "sudo rm -rf $HOME/cb-dragonfly")
+
+	// 메타데이터 수정
+	if _, _, err = common.PutAgent(info, agentInfo.AgentUnhealthyRespCnt, common.AgentState(agentInfo.AgentState), common.AgentHealth(agentInfo.AgentHealth)); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to put metadata to cb-store, error=%s", err))
+	}
+
+	// PULL 방식 또는 다른 전송 방식으로 변경된 경우 사용하지 않는 자격증명 폐기
+	isPush := strings.EqualFold(common.ResolveAgentType(info), types.PushPolicy)
+	transport := common.ResolveTransport(info)
+	if !isPush || transport != types.KafkaTransport {
+		if err = common.RevokeKafkaCredential(common.MakeAgentUUID(info)); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to revoke kafka credential, error=%s", err))
+		}
+	}
+	if !isPush || transport != types.HTTPTransport {
+		if err = common.RevokeIngestToken(common.MakeAgentUUID(info)); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to revoke ingest token, error=%s", err))
+		}
+	}
+	if !isPush || transport != types.NatsTransport {
+		if err = common.RevokeNatsCredential(common.MakeAgentUUID(info)); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to revoke nats credential, error=%s", err))
+		}
+	}
+	return http.StatusOK, nil
+}
+
 func UninstallAgent(info common.AgentInstallInfo) (int, error) {
 	var err error
 	sshInfo := sshrun.SSHInfo{
@@ -288,13 +591,28 @@ func UninstallAgent(info common.AgentInstallInfo) (int, error) {
 	common.CleanAgentInstall(info, &sshInfo, &osType, nil)
 
 	// 메타데이터 삭제
+	agentType := common.ResolveAgentType(info)
 	_, err = common.DeleteAgent(info)
 	if err != nil {
 		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to delete metadata, error=%s", err))
 	}
 
+	// kafka 자격증명, HTTP 수신 토큰, NATS 자격증명 폐기
+	if err = common.RevokeKafkaCredential(common.MakeAgentUUID(info)); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke kafka credential, error=%s", err))
+	}
+	if err = common.RevokeIngestToken(common.MakeAgentUUID(info)); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke ingest token, error=%s", err))
+	}
+	if err = common.RevokeNatsCredential(common.MakeAgentUUID(info)); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke nats credential, error=%s", err))
+	}
+	if err = common.DeleteDeadLetter(common.MakeAgentUUID(info)); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to delete dead letter, error=%s", err))
+	}
+
 	// Topic Queue 등록
-	if config.GetInstance().GetMonConfig().DefaultPolicy == types.PushPolicy {
+	if agentType == types.PushPolicy {
 		if err = util.RingQueuePut(types.TopicDel, common.MakeAgentUUID(info)); err != nil {
 			util.GetLogger().Error(err)
 		}
@@ -334,6 +652,17 @@ func ConfigureSnapshotAgent(info common.SnapshotAgentInstallInfo) (int, error) {
 		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to move telegraf.conf, error=%s", err.Error()))
 	}
 
+	// kafka TLS 인증서, 키 파일 복사
+	if err = CopyKafkaCredentialFiles(sshInfo, info.NewAgent); err != nil {
+		common.RestoreSnapshotAgent(&sshInfo)
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to copy kafka credential files, error=%s", err.Error()))
+	}
+	// NATS 자격증명, 인증서 파일 복사
+	if err = CopyNatsCredentialFiles(sshInfo, info.NewAgent); err != nil {
+		common.RestoreSnapshotAgent(&sshInfo)
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to copy nats credential files, error=%s", err.Error()))
+	}
+
 	if err = common.ChangeSnapshotAgentHosts(info.BaseAgent.PublicIp, info.NewAgent.PublicIp, &sshInfo); err != nil {
 		common.RestoreSnapshotAgent(&sshInfo)
 		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to change agent hosts file, error=%s", err.Error()))
@@ -0,0 +1,68 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// placeholderRegexp 입력 플러그인 템플릿 옵션 치환 위치 ({{key}})
+var placeholderRegexp = regexp.MustCompile(`\{\{([a-zA-Z0-9_]+)\}\}`)
+
+// Render telegraf 입력 플러그인 템플릿에 수집주기, 옵션 값 적용
+//   - 수집주기 미설정 시 {{interval}} 줄을 제거하여 에이전트 수집주기를 적용합니다.
+//   - 옵션 값은 TOML 문자열로 이스케이프하여 적용하므로 따옴표, 역슬래시를 포함한 값이 설정을 깨뜨리지 않습니다.
+//   - 템플릿을 한 번만 치환하므로 옵션 값에 포함된 {{key}} 는 치환하지 않습니다.
+func Render(template string, interval int, options map[string]string) (string, error) {
+	intervalConf := ""
+	if interval > 0 {
+		intervalConf = fmt.Sprintf("  interval = \"%ds\"\n", interval)
+	}
+	template = strings.ReplaceAll(template, "{{interval}}\n", intervalConf)
+
+	var missingKeys []string
+	rendered := placeholderRegexp.ReplaceAllStringFunc(template, func(placeholder string) string {
+		key := placeholderRegexp.FindStringSubmatch(placeholder)[1]
+		val, ok := options[key]
+		if !ok {
+			missingKeys = append(missingKeys, key)
+			return placeholder
+		}
+		return EscapeString(val)
+	})
+	if len(missingKeys) > 0 {
+		return "", errors.New(fmt.Sprintf("missing option %s", strings.Join(missingKeys, ", ")))
+	}
+	return rendered, nil
+}
+
+// EscapeString TOML 기본 문자열("...") 내부에 사용할 수 있도록 값 이스케이프
+func EscapeString(val string) string {
+	var sb strings.Builder
+	for _, r := range val {
+		switch r {
+		case '\\':
+			sb.WriteString(`\\`)
+		case '"':
+			sb.WriteString(`\"`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\r':
+			sb.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				sb.WriteString(fmt.Sprintf(`\u%04X`, r))
+				continue
+			}
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
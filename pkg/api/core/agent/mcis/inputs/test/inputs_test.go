@@ -0,0 +1,100 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/mcis/inputs"
+)
+
+const procstatTemplate = `[[inputs.procstat]]
+{{interval}}
+  pattern = "{{pattern}}"
+  pid_tag = false
+`
+
+type procstatConf struct {
+	Inputs struct {
+		Procstat []struct {
+			Interval string `toml:"interval"`
+			Pattern  string `toml:"pattern"`
+			PidTag   bool   `toml:"pid_tag"`
+		} `toml:"procstat"`
+	} `toml:"inputs"`
+}
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		name             string
+		interval         int
+		options          map[string]string
+		expectedPattern  string
+		expectedInterval string
+		expectErr        bool
+	}{
+		{name: "plain pattern", options: map[string]string{"pattern": "nginx"}, expectedPattern: "nginx"},
+		{name: "plugin interval", interval: 30, options: map[string]string{"pattern": "nginx"}, expectedPattern: "nginx", expectedInterval: "30s"},
+		{name: "quoted pattern", options: map[string]string{"pattern": `java -jar "app.jar"`}, expectedPattern: `java -jar "app.jar"`},
+		{name: "backslash pattern", options: map[string]string{"pattern": `C:\app\bin\.*`}, expectedPattern: `C:\app\bin\.*`},
+		{
+			name:            "key injection",
+			options:         map[string]string{"pattern": "nginx\"\n  pid_tag = true\n  x = \""},
+			expectedPattern: "nginx\"\n  pid_tag = true\n  x = \"",
+		},
+		{name: "placeholder in value", options: map[string]string{"pattern": "{{pattern}}"}, expectedPattern: "{{pattern}}"},
+		{name: "missing option", options: map[string]string{}, expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered, err := inputs.Render(procstatTemplate, tc.interval, tc.options)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", rendered)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to render template, error=%s", err)
+			}
+
+			var conf procstatConf
+			meta, err := toml.Decode(rendered, &conf)
+			if err != nil {
+				t.Fatalf("failed to decode rendered config %q, error=%s", rendered, err)
+			}
+			if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+				t.Errorf("expected no injected keys, got %v", undecoded)
+			}
+			if len(conf.Inputs.Procstat) != 1 {
+				t.Fatalf("expected 1 procstat plugin, got %d", len(conf.Inputs.Procstat))
+			}
+			plugin := conf.Inputs.Procstat[0]
+			if plugin.Pattern != tc.expectedPattern {
+				t.Errorf("expected pattern %q, got %q", tc.expectedPattern, plugin.Pattern)
+			}
+			if plugin.Interval != tc.expectedInterval {
+				t.Errorf("expected interval %q, got %q", tc.expectedInterval, plugin.Interval)
+			}
+			if plugin.PidTag {
+				t.Errorf("expected pid_tag to be false")
+			}
+		})
+	}
+}
+
+func TestEscapeString(t *testing.T) {
+	testCases := map[string]string{
+		"nginx":     "nginx",
+		`a"b`:       `a\"b`,
+		`a\b`:       `a\\b`,
+		"a\tb\r\nc": `a\tb\r\nc`,
+		"a\x01b":    `a\u0001b`,
+		"프로세스":      "프로세스",
+	}
+	for val, expected := range testCases {
+		if escaped := inputs.EscapeString(val); escaped != expected {
+			t.Errorf("expected %q of %q, got %q", expected, val, escaped)
+		}
+	}
+}
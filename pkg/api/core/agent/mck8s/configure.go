@@ -14,6 +14,7 @@ import (
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
@@ -55,8 +56,19 @@ func CreateTelegrafConfigConfigmap(info common.AgentInstallInfo, yamlData unstru
 	// 파일 내의 변수 값 설정 (hostId, collectorServer)
 	strConf := string(read)
 
+	// 에이전트 kafka 자격증명 발급 (컨피그맵 방식은 인증서 파일을 전달할 수 없으므로 SASL 만 지원)
+	topic := fmt.Sprintf("%s_mck8s_%s", info.NsId, info.Mck8sId)
+	if kafkasec.IsTLSEnabled(config.GetInstance().Kafka) {
+		return corev1.ConfigMap{}, errors.New("kafka tls is not supported for mck8s agent")
+	}
+	kafkaCredential, err := common.ProvisionKafkaCredential(common.MakeAgentUUID(info), topic)
+	if err != nil {
+		return corev1.ConfigMap{}, errors.New(fmt.Sprintf("failed to provision kafka credential, error=%s", err))
+	}
+	strConf = common.ReplaceKafkaSecurity(strConf, kafkaCredential)
+
 	// 파일 MCK8S 에이전트 변수 값 설정
-	strConf = strings.ReplaceAll(strConf, "{{topic}}", fmt.Sprintf("%s_mck8s_%s", info.NsId, info.Mck8sId))
+	strConf = strings.ReplaceAll(strConf, "{{topic}}", topic)
 	strConf = strings.ReplaceAll(strConf, "{{ns_id}}", info.NsId)
 	strConf = strings.ReplaceAll(strConf, "{{mck8s_id}}", info.Mck8sId)
 	strConf = strings.ReplaceAll(strConf, "{{server_port}}", fmt.Sprintf("%d", serverPort))
@@ -345,6 +357,11 @@ func UninstallAgent(info common.AgentInstallInfo) (int, error) {
 		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to delete metadata, error=%s", err))
 	}
 
+	// kafka 자격증명 폐기
+	if err = common.RevokeKafkaCredential(agentUUID); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke kafka credential, error=%s", err))
+	}
+
 	// 토픽 큐에 삭제 에이전트 정보를 등록
 	err = util.PutMCK8SRingQueue(types.TopicDel, agentUUID)
 	if err != nil {
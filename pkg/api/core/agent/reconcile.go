@@ -0,0 +1,375 @@
+package agent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common/orphan"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	OrphanMark   = orphan.PolicyMark
+	OrphanDelete = orphan.PolicyDelete
+
+	vmRunningStatus = "Running"
+	defaultSSHPort  = "22"
+)
+
+// ReconcileAction 인벤토리 정합성 점검 조치
+type ReconcileAction string
+
+const (
+	ActionOrphaned      ReconcileAction = "orphaned"       // 삭제된 VM, 클러스터 에이전트 표시
+	ActionRemoved       ReconcileAction = "removed"        // 삭제된 VM, 클러스터 에이전트 메타데이터 삭제
+	ActionRestored      ReconcileAction = "restored"       // 인벤토리에서 다시 확인된 에이전트 표시 해제
+	ActionIpUpdated     ReconcileAction = "ip_updated"     // VM 공인 IP 변경
+	ActionInstalled     ReconcileAction = "installed"      // 신규 VM 에이전트 자동 설치
+	ActionInstallFailed ReconcileAction = "install_failed" // 신규 VM 에이전트 자동 설치 실패
+)
+
+// ReconcileEvent 에이전트 별 정합성 점검 조치 내역
+type ReconcileEvent struct {
+	AgentUUID string          `json:"agent_uuid"`
+	Action    ReconcileAction `json:"action"`
+	Detail    string          `json:"detail"`
+}
+
+// ReconcileResult 인벤토리 정합성 점검 결과
+type ReconcileResult struct {
+	DryRun           bool             `json:"dry_run"` // 조치 내역만 산출하고 메타데이터에 반영하지 않음
+	StartedAt        int64            `json:"started_at"`
+	FinishedAt       int64            `json:"finished_at"`
+	CheckedCnt       int              `json:"checked_cnt"`                  // 점검 에이전트 수
+	SkippedNs        []string         `json:"skipped_ns"`                   // 인벤토리 조회 실패로 점검에서 제외된 네임스페이스
+	OrphanSkipReason string           `json:"orphan_skip_reason,omitempty"` // 삭제 확인 에이전트 비율 상한 초과로 삭제 에이전트 처리를 생략한 사유
+	Events           []ReconcileEvent `json:"events"`
+}
+
+// orphanCandidate 삭제된 VM, 클러스터 에이전트 (처리 보류 후 비율 점검 통과 시 처리)
+type orphanCandidate struct {
+	agentInfo common.AgentInfo
+	detail    string
+}
+
+// nsInventory 네임스페이스 Tumblebug 인벤토리
+type nsInventory struct {
+	vmMap      map[string]tumblebug.VM // {mcisId}/{vmId}
+	clusterSet map[string]bool
+	mcisErr    error
+	clusterErr error
+}
+
+// 주기 점검과 API 요청 점검이 동시에 실행되지 않도록 직렬화
+var reconcileLock sync.Mutex
+
+// ReconcileAgent Tumblebug MCIS, VM, MCK8S 인벤토리 기준 에이전트 메타데이터 정합성 점검
+//   - 삭제된 VM, 클러스터의 에이전트는 orphaned 표시하며, delete 정책의 경우 표시 후 orphan_grace_period(s)가 지난 에이전트를 삭제합니다.
+//   - 새로 삭제 확인된 에이전트 비율이 max_orphan_ratio 를 넘거나 인벤토리가 비어있는 경우 삭제 에이전트 처리를 생략합니다.
+//   - VM 공인 IP가 변경된 경우 에이전트 메타데이터를 갱신합니다.
+//   - auto_install 대상 네임스페이스의 신규 VM(Running)에 에이전트를 설치합니다.
+//   - 인벤토리 조회에 실패한 네임스페이스는 점검에서 제외합니다.
+func ReconcileAgent(dryRun bool) (*ReconcileResult, int, error) {
+	reconcileLock.Lock()
+	defer reconcileLock.Unlock()
+
+	now := time.Now()
+	result := ReconcileResult{
+		DryRun:    dryRun,
+		StartedAt: now.Unix(),
+		SkippedNs: []string{},
+		Events:    []ReconcileEvent{},
+	}
+
+	// 점검 시점의 인벤토리 기준으로 비교
+	tbClient := tumblebug.GetInstance()
+	tbClient.InvalidateCache()
+	nsList, err := tbClient.ListNamespace()
+	if err != nil {
+		return nil, http.StatusBadGateway, errors.New(fmt.Sprintf("failed to get tumblebug namespace list, error=%s", err))
+	}
+	nsSet := map[string]bool{}
+	for _, ns := range nsList {
+		nsSet[ns.Id] = true
+	}
+
+	agentList, err := common.ListAgent()
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get agent list, error=%s", err))
+	}
+
+	inventoryMap := map[string]*nsInventory{}
+	getInventory := func(nsId string) *nsInventory {
+		if inventory, ok := inventoryMap[nsId]; ok {
+			return inventory
+		}
+		inventory := listNsInventory(tbClient, nsId)
+		inventoryMap[nsId] = inventory
+		return inventory
+	}
+	skippedNsSet := map[string]bool{}
+
+	reconcileConfig := config.GetInstance().Reconcile
+	orphanPolicy := strings.ToLower(reconcileConfig.OrphanPolicy)
+	var orphanCandidates []orphanCandidate
+	skippedAgentCnt := 0
+	for _, agentInfo := range sortAgentInfoList(agentList) {
+		agentUUID := common.MakeAgentUUIDByInfo(agentInfo)
+		result.CheckedCnt++
+
+		// 삭제된 VM, 클러스터 여부 확인
+		orphanDetail := ""
+		if !nsSet[agentInfo.NsId] {
+			orphanDetail = fmt.Sprintf("namespace %s not found", agentInfo.NsId)
+		} else if util.CheckMCK8SType(agentInfo.ServiceType) {
+			inventory := getInventory(agentInfo.NsId)
+			if inventory.clusterErr != nil {
+				skippedNsSet[agentInfo.NsId] = true
+				skippedAgentCnt++
+				continue
+			}
+			if !inventory.clusterSet[agentInfo.Mck8sId] {
+				orphanDetail = fmt.Sprintf("k8s cluster %s not found", agentInfo.Mck8sId)
+			}
+		} else {
+			inventory := getInventory(agentInfo.NsId)
+			if inventory.mcisErr != nil {
+				skippedNsSet[agentInfo.NsId] = true
+				skippedAgentCnt++
+				continue
+			}
+			vm, ok := inventory.vmMap[makeVMKey(agentInfo.McisId, agentInfo.VmId)]
+			if !ok {
+				orphanDetail = fmt.Sprintf("vm %s not found in mcis %s", agentInfo.VmId, agentInfo.McisId)
+			} else if vm.PublicIP != "" && vm.PublicIP != agentInfo.PublicIp {
+				event := ReconcileEvent{AgentUUID: agentUUID, Action: ActionIpUpdated, Detail: fmt.Sprintf("%s => %s", agentInfo.PublicIp, vm.PublicIP)}
+				if !dryRun {
+					if err := common.UpdateAgentPublicIp(agentUUID, vm.PublicIP); err != nil {
+						util.GetLogger().Error(fmt.Sprintf("failed to update agent %s public ip, error=%s", agentUUID, err))
+						continue
+					}
+				}
+				result.Events = append(result.Events, event)
+			}
+		}
+
+		if orphanDetail == "" {
+			if agentInfo.Orphaned {
+				if !dryRun {
+					if err := common.SetAgentOrphaned(agentUUID, false, now); err != nil {
+						util.GetLogger().Error(fmt.Sprintf("failed to restore agent %s, error=%s", agentUUID, err))
+						continue
+					}
+				}
+				result.Events = append(result.Events, ReconcileEvent{AgentUUID: agentUUID, Action: ActionRestored})
+			}
+			continue
+		}
+
+		orphanCandidates = append(orphanCandidates, orphanCandidate{agentInfo: agentInfo, detail: orphanDetail})
+	}
+
+	// 삭제 에이전트 처리 (인벤토리 일시 누락으로 다수 에이전트가 한 번에 삭제되지 않도록 비율 점검)
+	missingCnt := 0
+	for _, candidate := range orphanCandidates {
+		if !candidate.agentInfo.Orphaned {
+			missingCnt++
+		}
+	}
+	checkedCnt := result.CheckedCnt - skippedAgentCnt
+	if skip, reason := orphan.Guard(missingCnt, checkedCnt, orphan.MaxRatio(reconcileConfig.MaxOrphanRatio), len(nsList) == 0); skip {
+		util.GetLogger().Warn(fmt.Sprintf("skip orphan agent handling, %s", reason))
+		result.OrphanSkipReason = reason
+		orphanCandidates = nil
+	}
+	gracePeriod := orphan.GracePeriod(reconcileConfig.OrphanGracePeriod)
+	for _, candidate := range orphanCandidates {
+		agentInfo := candidate.agentInfo
+		agentUUID := common.MakeAgentUUIDByInfo(agentInfo)
+		switch orphan.Decide(orphanPolicy, agentInfo.Orphaned, agentInfo.OrphanedAt, now, gracePeriod) {
+		case orphan.Mark:
+			if !dryRun {
+				if err := common.SetAgentOrphaned(agentUUID, true, now); err != nil {
+					util.GetLogger().Error(fmt.Sprintf("failed to mark agent %s as orphaned, error=%s", agentUUID, err))
+					continue
+				}
+			}
+			result.Events = append(result.Events, ReconcileEvent{AgentUUID: agentUUID, Action: ActionOrphaned, Detail: candidate.detail})
+		case orphan.Purge:
+			if !dryRun {
+				if err := common.PurgeAgent(agentInfo); err != nil {
+					util.GetLogger().Error(fmt.Sprintf("failed to remove agent %s, error=%s", agentUUID, err))
+					continue
+				}
+			}
+			result.Events = append(result.Events, ReconcileEvent{AgentUUID: agentUUID, Action: ActionRemoved, Detail: candidate.detail})
+		}
+	}
+
+	// auto_install 대상 네임스페이스 신규 VM 에이전트 설치
+	installedVMSet := map[string]bool{}
+	for _, agentInfo := range agentList {
+		if util.CheckMCISType(agentInfo.ServiceType) {
+			installedVMSet[agentInfo.NsId+"/"+makeVMKey(agentInfo.McisId, agentInfo.VmId)] = true
+		}
+	}
+	for _, autoInstall := range reconcileConfig.AutoInstall {
+		if !nsSet[autoInstall.NsId] {
+			continue
+		}
+		inventory := getInventory(autoInstall.NsId)
+		if inventory.mcisErr != nil {
+			skippedNsSet[autoInstall.NsId] = true
+			continue
+		}
+		for _, vmKey := range sortVMKeyList(inventory.vmMap) {
+			vm := inventory.vmMap[vmKey]
+			if installedVMSet[autoInstall.NsId+"/"+vmKey] || !strings.EqualFold(vm.Status, vmRunningStatus) || vm.PublicIP == "" {
+				continue
+			}
+			result.Events = append(result.Events, installVMAgent(autoInstall, strings.SplitN(vmKey, "/", 2)[0], vm, dryRun))
+		}
+	}
+
+	for nsId := range skippedNsSet {
+		result.SkippedNs = append(result.SkippedNs, nsId)
+	}
+	sort.Strings(result.SkippedNs)
+	result.FinishedAt = time.Now().Unix()
+
+	if !dryRun {
+		if err := putReconcileResult(result); err != nil {
+			util.GetLogger().Error(err)
+		}
+	}
+	return &result, http.StatusOK, nil
+}
+
+// GetReconcileResult 최근 인벤토리 정합성 점검 결과 조회
+func GetReconcileResult() (*ReconcileResult, int, error) {
+	resultStr, err := cbstore.GetInstance().StoreGet(types.ReconcileResult)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get reconcile result, error=%s", err))
+	}
+	if resultStr == nil || *resultStr == "" {
+		return nil, http.StatusNotFound, errors.New("reconcile result not found")
+	}
+	result := ReconcileResult{}
+	if err := json.Unmarshal([]byte(*resultStr), &result); err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to convert reconcile result, error=%s", err))
+	}
+	return &result, http.StatusOK, nil
+}
+
+func putReconcileResult(result ReconcileResult) error {
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to convert reconcile result, error=%s", err))
+	}
+	if err := cbstore.GetInstance().StorePut(types.ReconcileResult, string(resultBytes)); err != nil {
+		return errors.New(fmt.Sprintf("failed to put reconcile result, error=%s", err))
+	}
+	return nil
+}
+
+// listNsInventory 네임스페이스 MCIS VM, 쿠버네티스 클러스터 목록 조회
+func listNsInventory(tbClient *tumblebug.Client, nsId string) *nsInventory {
+	inventory := nsInventory{
+		vmMap:      map[string]tumblebug.VM{},
+		clusterSet: map[string]bool{},
+	}
+
+	mcisList, err := tbClient.ListMCIS(nsId)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to get mcis list, ns=%s, error=%s", nsId, err))
+		inventory.mcisErr = err
+	}
+	for _, mcis := range mcisList {
+		for _, vm := range mcis.Vm {
+			inventory.vmMap[makeVMKey(mcis.Id, vm.Id)] = vm
+		}
+	}
+
+	clusterList, err := tbClient.ListK8sCluster(nsId)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to get k8s cluster list, ns=%s, error=%s", nsId, err))
+		inventory.clusterErr = err
+	}
+	for _, cluster := range clusterList {
+		inventory.clusterSet[cluster.Id] = true
+	}
+	return &inventory
+}
+
+// installVMAgent 신규 VM 에이전트 설치 (auto_install 자격증명 사용)
+func installVMAgent(autoInstall config.AutoInstall, mcisId string, vm tumblebug.VM, dryRun bool) ReconcileEvent {
+	installInfo := common.AgentInstallInfo{
+		NsId:         autoInstall.NsId,
+		McisId:       mcisId,
+		VmId:         vm.Id,
+		PublicIp:     vm.PublicIP,
+		CspType:      vm.ConnectionConfig.ProviderName,
+		Port:         vm.SSHPort,
+		ServiceType:  types.MCIS,
+		Profile:      autoInstall.Profile,
+		AgentType:    autoInstall.AgentType,
+		CredentialId: autoInstall.CredentialId,
+		Region:       vm.Region.Region,
+	}
+	if installInfo.Port == "" {
+		installInfo.Port = defaultSSHPort
+	}
+	event := ReconcileEvent{AgentUUID: common.MakeAgentUUID(installInfo), Action: ActionInstalled}
+	if installInfo.CspType == "" {
+		event.Action = ActionInstallFailed
+		event.Detail = "unknown csp type of vm connection"
+		return event
+	}
+	if dryRun {
+		return event
+	}
+
+	if status, err := InstallAgent(installInfo); status != http.StatusOK {
+		util.GetLogger().Error(fmt.Sprintf("failed to install agent %s, error=%s", event.AgentUUID, err))
+		event.Action = ActionInstallFailed
+		if err != nil {
+			event.Detail = err.Error()
+		}
+	}
+	return event
+}
+
+func makeVMKey(mcisId string, vmId string) string {
+	return mcisId + "/" + vmId
+}
+
+func sortAgentInfoList(agentList map[string]common.AgentInfo) []common.AgentInfo {
+	agentInfoList := make([]common.AgentInfo, 0, len(agentList))
+	for _, agentInfo := range agentList {
+		agentInfoList = append(agentInfoList, agentInfo)
+	}
+	sort.Slice(agentInfoList, func(i, j int) bool {
+		return common.MakeAgentUUIDByInfo(agentInfoList[i]) < common.MakeAgentUUIDByInfo(agentInfoList[j])
+	})
+	return agentInfoList
+}
+
+func sortVMKeyList(vmMap map[string]tumblebug.VM) []string {
+	vmKeyList := make([]string, 0, len(vmMap))
+	for vmKey := range vmMap {
+		vmKeyList = append(vmKeyList, vmKey)
+	}
+	sort.Strings(vmKeyList)
+	return vmKeyList
+}
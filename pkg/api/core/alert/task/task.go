@@ -1,10 +1,13 @@
 package task
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
 	"regexp"
+	"sort"
 	"strings"
 
 	kapacitorclient "github.com/shaodan/kapacitor-client"
@@ -25,6 +28,22 @@ const (
 	AlertMessageFormat = "[{{.Level}}] {{.ID}} {{.TaskName}} Alert \n%s"
 )
 
+// 추가 태그 조건의 태그 키는 kapacitor lambda 식에 직접 포함되므로 식별자 형식만 허용
+var tagKeyRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// 태그 값 문자열 리터럴 escape
+var tagValueReplacer = strings.NewReplacer("\\", "\\\\", "'", "\\'")
+
+// ValidateTagFilter 알람 추가 태그 조건 검증 (태그 키는 영문, 숫자, _ 로 구성)
+func ValidateTagFilter(tagFilter map[string]string) error {
+	for tagKey := range tagFilter {
+		if !tagKeyRegexp.MatchString(tagKey) {
+			return errors.New(fmt.Sprintf("invalid tag_filter key %q, tag key must match %s", tagKey, tagKeyRegexp.String()))
+		}
+	}
+	return nil
+}
+
 func ListTasks() ([]types.AlertTask, error) {
 	listOpts := kapacitorclient.ListTasksOptions{
 		Pattern: KapacitorTaskPattern,
@@ -189,13 +208,30 @@ func DeleteTask(taskId string) error {
 }
 
 func setTemplateVars(alertTaskReq types.AlertTaskReq) (map[string]kapacitorclient.Var, error) {
+	if err := ValidateTagFilter(alertTaskReq.TagFilter); err != nil {
+		return nil, err
+	}
 	varMaps := map[string]kapacitorclient.Var{}
 
 	varMaps["measurement"] = newTaskVar(kapacitorclient.VarString, alertTaskReq.Measurement)
 
 	varMaps["target_type"] = newTaskVar(kapacitorclient.VarString, alertTaskReq.TargetType)
 	varMaps["target_id"] = newTaskVar(kapacitorclient.VarString, alertTaskReq.TargetId)
-	varMaps["where_filter"] = newTaskVar(kapacitorclient.VarLambda, fmt.Sprintf("\"%sId\" == '%s'", strings.ToLower(alertTaskReq.TargetType), alertTaskReq.TargetId))
+	whereFilter := fmt.Sprintf("\"%sId\" == '%s'", strings.ToLower(alertTaskReq.TargetType), alertTaskReq.TargetId)
+	tagKeyArr := make([]string, 0, len(alertTaskReq.TagFilter))
+	for tagKey := range alertTaskReq.TagFilter {
+		tagKeyArr = append(tagKeyArr, tagKey)
+	}
+	sort.Strings(tagKeyArr)
+	for _, tagKey := range tagKeyArr {
+		whereFilter += fmt.Sprintf(" AND \"%s\" == '%s'", tagKey, tagValueReplacer.Replace(alertTaskReq.TagFilter[tagKey]))
+	}
+	varMaps["where_filter"] = newTaskVar(kapacitorclient.VarLambda, whereFilter)
+	tagFilterBytes, err := json.Marshal(alertTaskReq.TagFilter)
+	if err != nil {
+		return nil, err
+	}
+	varMaps["tag_filter"] = newTaskVar(kapacitorclient.VarString, string(tagFilterBytes))
 
 	varMaps["event_params"] = newTaskVar(kapacitorclient.VarString, alertTaskReq.EventDuration)
 	varMaps["event_duration"] = newTaskVar(kapacitorclient.VarDuration, alertTaskReq.EventDuration)
@@ -277,6 +313,10 @@ func mappingAlertTaskInfo(task kapacitorclient.Task) types.AlertTask {
 
 		AlertPostUrl: getVarByKey(task.Vars, "alert_post_url").(string),
 	}
+	// 태그 조건 추가 이전에 생성된 태스크는 tag_filter 변수 없음
+	if tagFilterStr, ok := getVarByKey(task.Vars, "tag_filter").(string); ok && tagFilterStr != "" {
+		_ = json.Unmarshal([]byte(tagFilterStr), &alertTask.TagFilter)
+	}
 	return alertTask
 }
 
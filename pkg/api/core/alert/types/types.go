@@ -5,8 +5,9 @@ type AlertTaskReq struct {
 
 	Measurement string `json:"measurement"`
 
-	TargetType string `json:"target_type"`
-	TargetId   string `json:"target_id"`
+	TargetType string            `json:"target_type"`
+	TargetId   string            `json:"target_id"`
+	TagFilter  map[string]string `json:"tag_filter,omitempty"` // 추가 태그 일치 조건 (예: latency 측정 값의 interRegion: "true", reachable: "false")
 
 	EventDuration string `json:"event_duration"`
 	Metric        string `json:"metric"`
@@ -29,8 +30,9 @@ type AlertTask struct {
 
 	Measurement string `json:"measurement"`
 
-	TargetType string `json:"target_type"`
-	TargetId   string `json:"target_id"`
+	TargetType string            `json:"target_type"`
+	TargetId   string            `json:"target_id"`
+	TagFilter  map[string]string `json:"tag_filter,omitempty"` // 추가 태그 일치 조건 (예: latency 측정 값의 interRegion: "true", reachable: "false")
 
 	EventDuration string `json:"event_duration"`
 	Metric        string `json:"metric"`
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 
@@ -13,6 +14,10 @@ import (
 
 // 모니터링 정책 설정
 func SetMonConfig(newMonConfig config.Monitoring) (*config.Monitoring, int, error) {
+	// kafka 보안 설정 사용 시 공용 토픽 방식 변경 불가
+	if err := kafkasec.ValidateTopicMode(config.GetInstance().Kafka, newMonConfig); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
 	config.GetInstance().SetMonConfig(newMonConfig)
 
 	var monConfigMap map[string]interface{}
@@ -31,17 +36,24 @@ func SetMonConfig(newMonConfig config.Monitoring) (*config.Monitoring, int, erro
 	}
 
 	monConfig := config.Monitoring{
-		MCISAgentInterval:       cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_agent_interval")),
-		MCK8SAgentInterval:      cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_agent_interval")),
-		MCISCollectorInterval:   cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_collector_interval")),
-		MCK8SCollectorInterval:  cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_collector_interval")),
-		MaxHostCount:            cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "max_host_count")),
-		MonitoringPolicy:        cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "monitoring_policy")),
-		DefaultPolicy:           cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "default_policy")),
-		PullerInterval:          cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_interval")),
-		PullerAggregateInterval: cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_aggregate_interval")),
-		AggregateType:           cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "aggregate_type")),
-		DeployType:              cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "deploy_type")),
+		MCISAgentInterval:             cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_agent_interval")),
+		MCK8SAgentInterval:            cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_agent_interval")),
+		MCISCollectorInterval:         cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_collector_interval")),
+		MCK8SCollectorInterval:        cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_collector_interval")),
+		MaxHostCount:                  cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "max_host_count")),
+		MonitoringPolicy:              cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "monitoring_policy")),
+		DefaultPolicy:                 cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "default_policy")),
+		PullerInterval:                cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_interval")),
+		PullerAggregateInterval:       cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_aggregate_interval")),
+		AggregateType:                 cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "aggregate_type")),
+		DeployType:                    cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "deploy_type")),
+		HeartbeatCheckInterval:        cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "heartbeat_check_interval")),
+		HeartbeatDegradedThreshold:    cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "heartbeat_degraded_threshold")),
+		HeartbeatUnreachableThreshold: cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "heartbeat_unreachable_threshold")),
+		CollectorMode:                 cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "collector_mode")),
+		CollectorCount:                cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "collector_count")),
+		CollectorTopicMode:            cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "collector_topic_mode")),
+		AllowedLateness:               cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "allowed_lateness")),
 	}
 
 	return &monConfig, http.StatusOK, nil
@@ -50,17 +62,24 @@ func SetMonConfig(newMonConfig config.Monitoring) (*config.Monitoring, int, erro
 // 모니터링 정책 조회
 func GetMonConfig() (*config.Monitoring, int, error) {
 	monConfig := config.Monitoring{
-		MCISAgentInterval:       cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_agent_interval")),
-		MCK8SAgentInterval:      cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_agent_interval")),
-		MCISCollectorInterval:   cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_collector_interval")),
-		MCK8SCollectorInterval:  cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_collector_interval")),
-		MaxHostCount:            cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "max_host_count")),
-		MonitoringPolicy:        cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "monitoring_policy")),
-		DefaultPolicy:           cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "default_policy")),
-		PullerInterval:          cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_interval")),
-		PullerAggregateInterval: cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_aggregate_interval")),
-		AggregateType:           cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "aggregate_type")),
-		DeployType:              cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "deploy_type")),
+		MCISAgentInterval:             cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_agent_interval")),
+		MCK8SAgentInterval:            cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_agent_interval")),
+		MCISCollectorInterval:         cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mcis_collector_interval")),
+		MCK8SCollectorInterval:        cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "mck8s_collector_interval")),
+		MaxHostCount:                  cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "max_host_count")),
+		MonitoringPolicy:              cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "monitoring_policy")),
+		DefaultPolicy:                 cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "default_policy")),
+		PullerInterval:                cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_interval")),
+		PullerAggregateInterval:       cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "puller_aggregate_interval")),
+		AggregateType:                 cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "aggregate_type")),
+		DeployType:                    cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "deploy_type")),
+		HeartbeatCheckInterval:        cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "heartbeat_check_interval")),
+		HeartbeatDegradedThreshold:    cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "heartbeat_degraded_threshold")),
+		HeartbeatUnreachableThreshold: cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "heartbeat_unreachable_threshold")),
+		CollectorMode:                 cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "collector_mode")),
+		CollectorCount:                cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "collector_count")),
+		CollectorTopicMode:            cbstore.GetInstance().StoreGetToString(fmt.Sprintf("%s/%s", types.MonConfig, "collector_topic_mode")),
+		AllowedLateness:               cbstore.GetInstance().StoreGetToInt(fmt.Sprintf("%s/%s", types.MonConfig, "allowed_lateness")),
 	}
 
 	if monConfig.MCISAgentInterval == -1 || monConfig.MCK8SAgentInterval == -1 || monConfig.MCISCollectorInterval == -1 || monConfig.MaxHostCount == -1 || monConfig.MonitoringPolicy == "" || monConfig.DefaultPolicy == "" || monConfig.PullerInterval == -1 || monConfig.PullerAggregateInterval == -1 || monConfig.AggregateType == "" || monConfig.DeployType == "" {
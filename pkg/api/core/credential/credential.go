@@ -0,0 +1,209 @@
+package credential
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// 자격증명 유형
+const (
+	SSHCredential   = "ssh"
+	MCK8SCredential = "mck8s"
+)
+
+// 자격증명 저장소 유형
+const (
+	CBStoreProvider    = "cbstore"
+	VaultProvider      = "vault"
+	KubernetesProvider = "kubernetes"
+)
+
+// 자격증명 시크릿 키
+const (
+	SecretUserName    = "user_name"
+	SecretSshKey      = "ssh_key"
+	SecretServerCA    = "server_ca"
+	SecretClientCA    = "client_ca"
+	SecretClientKey   = "client_key"
+	SecretClientToken = "client_token"
+)
+
+// Credential 에이전트 자격증명 정보 (시크릿 값은 등록 요청 시에만 사용하며 조회 결과에 포함하지 않음)
+type Credential struct {
+	Id          string            `json:"id"`
+	Type        string            `json:"type"`     // ssh, mck8s
+	Provider    string            `json:"provider"` // cbstore, vault, kubernetes
+	Description string            `json:"description"`
+	SecretRef   string            `json:"secret_ref,omitempty"`  // vault 시크릿 경로 또는 kubernetes secret 이름 ({namespace}/{name})
+	Secret      map[string]string `json:"secret,omitempty"`      // cbstore 저장 시크릿 값
+	SecretKeys  []string          `json:"secret_keys,omitempty"` // cbstore 저장 시크릿 키 목록
+	CreatedAt   int64             `json:"created_at"`
+}
+
+// cb-store 저장 자격증명 정보 (시크릿 값 암호화)
+type storedCredential struct {
+	Credential
+	EncryptedSecret string `json:"encrypted_secret,omitempty"`
+}
+
+// Validate 자격증명 등록 정보 체크
+func (c Credential) Validate() error {
+	if strings.Contains(c.Id, "/") {
+		return errors.New(fmt.Sprintf("invalid credential id %s", c.Id))
+	}
+	if c.Type != SSHCredential && c.Type != MCK8SCredential {
+		return errors.New(fmt.Sprintf("unsupported credential type %s, type must be %s or %s", c.Type, SSHCredential, MCK8SCredential))
+	}
+	switch c.Provider {
+	case CBStoreProvider:
+		if len(c.Secret) == 0 {
+			return errors.New("empty secret for cbstore credential")
+		}
+	case VaultProvider, KubernetesProvider:
+		if c.SecretRef == "" {
+			return errors.New(fmt.Sprintf("empty secret_ref for %s credential", c.Provider))
+		}
+	default:
+		return errors.New(fmt.Sprintf("unsupported credential provider %s", c.Provider))
+	}
+	return nil
+}
+
+// RegisterCredential 자격증명 등록
+func RegisterCredential(credential Credential) (*Credential, error) {
+	if credential.Provider == "" {
+		credential.Provider = CBStoreProvider
+	}
+	if credential.Id == "" {
+		credential.Id = uuid.New().String()
+	}
+	if err := credential.Validate(); err != nil {
+		return nil, err
+	}
+	if existCredential, _ := cbstore.GetInstance().StoreGet(types.Credential + credential.Id); existCredential != nil {
+		return nil, errors.New(fmt.Sprintf("already exist credential with id %s", credential.Id))
+	}
+
+	stored := storedCredential{Credential: credential}
+	stored.CreatedAt = time.Now().Unix()
+	if credential.Provider == CBStoreProvider {
+		secretBytes, err := json.Marshal(credential.Secret)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to convert secret format to json, error=%s", err))
+		}
+		encryptedSecret, err := EncryptSecret(secretBytes)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to encrypt secret, error=%s", err))
+		}
+		stored.EncryptedSecret = encryptedSecret
+		stored.SecretKeys = secretKeys(credential.Secret)
+	}
+	stored.Secret = nil
+
+	storedBytes, err := json.Marshal(stored)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert credential format to json, error=%s", err))
+	}
+	if err = cbstore.GetInstance().StorePut(types.Credential+credential.Id, string(storedBytes)); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to put credential, error=%s", err))
+	}
+	return &stored.Credential, nil
+}
+
+// ListCredential 자격증명 목록 조회 (시크릿 값 제외)
+func ListCredential() ([]Credential, error) {
+	credentialListByteMap, err := cbstore.GetInstance().StoreGetListMap(types.Credential, true)
+	if err != nil {
+		return nil, err
+	}
+	credentialList := []Credential{}
+	for _, bytes := range credentialListByteMap {
+		stored := storedCredential{}
+		if err := json.Unmarshal([]byte(bytes), &stored); err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to convert credential list, error=%s", err))
+		}
+		credentialList = append(credentialList, stored.Credential)
+	}
+	sort.Slice(credentialList, func(i, j int) bool { return credentialList[i].Id < credentialList[j].Id })
+	return credentialList, nil
+}
+
+// GetCredential 자격증명 조회 (시크릿 값 제외)
+func GetCredential(id string) (*Credential, error) {
+	stored, err := getStoredCredential(id)
+	if err != nil {
+		return nil, err
+	}
+	return &stored.Credential, nil
+}
+
+// DeleteCredential 자격증명 삭제
+func DeleteCredential(id string) error {
+	if _, err := getStoredCredential(id); err != nil {
+		return err
+	}
+	return cbstore.GetInstance().StoreDelete(types.Credential + id)
+}
+
+// ResolveSecret 자격증명 시크릿 값 조회 (cb-store 복호화 또는 외부 저장소 조회)
+func ResolveSecret(id string) (string, map[string]string, error) {
+	stored, err := getStoredCredential(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var secret map[string]string
+	switch stored.Provider {
+	case CBStoreProvider:
+		secretBytes, err := DecryptSecret(stored.EncryptedSecret)
+		if err != nil {
+			return "", nil, errors.New(fmt.Sprintf("failed to decrypt secret of credential %s, error=%s", id, err))
+		}
+		if err = json.Unmarshal(secretBytes, &secret); err != nil {
+			return "", nil, errors.New(fmt.Sprintf("failed to convert secret of credential %s, error=%s", id, err))
+		}
+	case VaultProvider:
+		secret, err = getVaultSecret(stored.SecretRef)
+	case KubernetesProvider:
+		secret, err = getKubernetesSecret(stored.SecretRef)
+	default:
+		err = errors.New(fmt.Sprintf("unsupported credential provider %s", stored.Provider))
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return stored.Type, secret, nil
+}
+
+func getStoredCredential(id string) (*storedCredential, error) {
+	credentialStr, err := cbstore.GetInstance().StoreGet(types.Credential + id)
+	if err != nil {
+		return nil, err
+	}
+	if credentialStr == nil {
+		return nil, errors.New(fmt.Sprintf("failed to get credential with id %s", id))
+	}
+	stored := storedCredential{}
+	if err = json.Unmarshal([]byte(*credentialStr), &stored); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert credential info, error=%s", err))
+	}
+	return &stored, nil
+}
+
+func secretKeys(secret map[string]string) []string {
+	keys := make([]string, 0, len(secret))
+	for key := range secret {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,29 @@
+package credential
+
+import (
+	"os"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/credential/secret"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+)
+
+// MasterKeyEnv 자격증명 암호화 마스터 키 환경변수
+const MasterKeyEnv = "CBMON_CREDENTIAL_MASTER_KEY"
+
+func getMasterKey() string {
+	masterKey := os.Getenv(MasterKeyEnv)
+	if masterKey == "" {
+		masterKey = config.GetInstance().Credential.MasterKey
+	}
+	return masterKey
+}
+
+// EncryptSecret 시크릿 값 암호화 (자격증명 마스터 키 사용)
+func EncryptSecret(plainText []byte) (string, error) {
+	return secret.Encrypt(getMasterKey(), plainText)
+}
+
+// DecryptSecret 시크릿 값 복호화 (자격증명 마스터 키 사용)
+func DecryptSecret(encrypted string) ([]byte, error) {
+	return secret.Decrypt(getMasterKey(), encrypted)
+}
@@ -0,0 +1,92 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+)
+
+const providerTimeout = 10
+
+// getVaultSecret Vault KV v2 시크릿 조회 (secretRef: 시크릿 경로)
+func getVaultSecret(secretRef string) (map[string]string, error) {
+	vaultConfig := config.GetInstance().Credential
+	if vaultConfig.VaultAddr == "" {
+		return nil, errors.New("vault address is not configured")
+	}
+	mount := vaultConfig.VaultMount
+	if mount == "" {
+		mount = "secret"
+	}
+
+	secretUrl := fmt.Sprintf("%s/v1/%s/data/%s", strings.TrimRight(vaultConfig.VaultAddr, "/"), strings.Trim(mount, "/"), strings.TrimLeft(secretRef, "/"))
+	req, err := http.NewRequest(http.MethodGet, secretUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", vaultConfig.VaultToken)
+
+	client := http.Client{Timeout: providerTimeout * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to get vault secret, error=%s", err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(fmt.Sprintf("failed to get vault secret %s, status=%d", secretRef, resp.StatusCode))
+	}
+
+	vaultResp := struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&vaultResp); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert vault secret, error=%s", err))
+	}
+	secret := map[string]string{}
+	for key, val := range vaultResp.Data.Data {
+		secret[key] = fmt.Sprintf("%v", val)
+	}
+	return secret, nil
+}
+
+// getKubernetesSecret Kubernetes Secret 조회 (secretRef: {namespace}/{name} 또는 {name})
+func getKubernetesSecret(secretRef string) (map[string]string, error) {
+	namespace := config.GetInstance().Credential.K8sNamespace
+	name := secretRef
+	if splitRef := strings.SplitN(secretRef, "/", 2); len(splitRef) == 2 {
+		namespace, name = splitRef[0], splitRef[1]
+	}
+
+	inClusterK8sConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to get in-cluster kubernetes config, error=%s", err))
+	}
+	clientSet, err := kubernetes.NewForConfig(inClusterK8sConfig)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to create kubernetes client, error=%s", err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), providerTimeout*time.Second)
+	defer cancel()
+	k8sSecret, err := clientSet.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to get kubernetes secret %s/%s, error=%s", namespace, name, err))
+	}
+	secret := map[string]string{}
+	for key, val := range k8sSecret.Data {
+		secret[key] = string(val)
+	}
+	return secret, nil
+}
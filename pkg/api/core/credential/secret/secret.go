@@ -0,0 +1,54 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+)
+
+func newGCM(masterKey string) (cipher.AEAD, error) {
+	if masterKey == "" {
+		return nil, errors.New("credential master key is not configured")
+	}
+	// AES-256 키 생성
+	key := sha256.Sum256([]byte(masterKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt AES-GCM 암호화 (base64(nonce + 암호문))
+func Encrypt(masterKey string, plainText []byte) (string, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, plainText, nil)), nil
+}
+
+// Decrypt AES-GCM 복호화 (마스터 키가 다르거나 암호문이 변조된 경우 에러 반환)
+func Decrypt(masterKey string, encrypted string) ([]byte, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	if len(cipherText) < gcm.NonceSize() {
+		return nil, errors.New("invalid encrypted secret")
+	}
+	nonce, cipherText := cipherText[:gcm.NonceSize()], cipherText[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, cipherText, nil)
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/credential/secret"
+)
+
+const masterKey = "test-master-key"
+
+func TestRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name      string
+		plainText []byte
+	}{
+		{name: "password", plainText: []byte("p@ssw0rd")},
+		{name: "json secret", plainText: []byte(`{"username":"cb","private_key":"-----BEGIN KEY-----\n..."}`)},
+		{name: "empty secret", plainText: []byte{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encrypted, err := secret.Encrypt(masterKey, tc.plainText)
+			if err != nil {
+				t.Fatalf("failed to encrypt secret, error=%s", err)
+			}
+			if len(tc.plainText) > 0 && bytes.Contains([]byte(encrypted), tc.plainText) {
+				t.Errorf("expected encrypted secret not to contain plain text")
+			}
+			decrypted, err := secret.Decrypt(masterKey, encrypted)
+			if err != nil {
+				t.Fatalf("failed to decrypt secret, error=%s", err)
+			}
+			if !bytes.Equal(decrypted, tc.plainText) {
+				t.Errorf("expected %q, got %q", tc.plainText, decrypted)
+			}
+		})
+	}
+
+	// 동일한 값도 nonce 가 달라 암호문이 달라야 함
+	first, _ := secret.Encrypt(masterKey, []byte("p@ssw0rd"))
+	second, _ := secret.Encrypt(masterKey, []byte("p@ssw0rd"))
+	if first == second {
+		t.Errorf("expected different cipher texts for each encryption")
+	}
+}
+
+func TestDecryptFail(t *testing.T) {
+	encrypted, err := secret.Encrypt(masterKey, []byte("p@ssw0rd"))
+	if err != nil {
+		t.Fatalf("failed to encrypt secret, error=%s", err)
+	}
+	raw, _ := base64.StdEncoding.DecodeString(encrypted)
+
+	// 암호문 마지막 바이트(인증 태그) 변조
+	tampered := append([]byte{}, raw...)
+	tampered[len(tampered)-1] ^= 0x01
+	// nonce 변조
+	tamperedNonce := append([]byte{}, raw...)
+	tamperedNonce[0] ^= 0x01
+
+	testCases := []struct {
+		name      string
+		masterKey string
+		encrypted string
+	}{
+		{name: "wrong key", masterKey: "other-master-key", encrypted: encrypted},
+		{name: "empty key", masterKey: "", encrypted: encrypted},
+		{name: "tampered cipher text", masterKey: masterKey, encrypted: base64.StdEncoding.EncodeToString(tampered)},
+		{name: "tampered nonce", masterKey: masterKey, encrypted: base64.StdEncoding.EncodeToString(tamperedNonce)},
+		{name: "truncated", masterKey: masterKey, encrypted: base64.StdEncoding.EncodeToString(raw[:8])},
+		{name: "invalid base64", masterKey: masterKey, encrypted: "not base64!"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if decrypted, err := secret.Decrypt(tc.masterKey, tc.encrypted); err == nil {
+				t.Errorf("expected decrypt error, got %q", decrypted)
+			}
+		})
+	}
+
+	if _, err := secret.Encrypt("", []byte("p@ssw0rd")); err == nil {
+		t.Errorf("expected encrypt error without master key")
+	}
+}
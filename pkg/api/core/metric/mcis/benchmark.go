@@ -0,0 +1,691 @@
+package mcis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb1-client/models"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/servicemon"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// 벤치마크 캠페인 실행 상태
+const (
+	BenchmarkRunning  = "running"
+	BenchmarkFinished = "finished"
+	BenchmarkFailed   = "failed"
+)
+
+const (
+	RttBenchmark = "rtt"
+
+	defaultBenchmarkPeriod = "30d"
+
+	// 실행 중인 캠페인은 주기적으로 updated_at 을 갱신하며, 갱신이 멈춘 캠페인은 실행 인스턴스가 종료된 것으로 판단
+	benchmarkHeartbeatInterval = 60 * time.Second
+	benchmarkStaleTimeout      = 3 * benchmarkHeartbeatInterval
+)
+
+// SupportedBenchmarks Milkyway 벤치마크 종류
+//   - cpus, cpum: CPU 단일, 멀티 스레드 / memR, memW: 메모리 읽기, 쓰기 / fioR, fioW: 디스크 읽기, 쓰기 / dbR, dbW: DB 읽기, 쓰기 / rtt: 네트워크 지연
+var SupportedBenchmarks = []string{"cpus", "cpum", "memR", "memW", "fioR", "fioW", "dbR", "dbW", RttBenchmark}
+
+var campaignIdRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// BenchmarkCampaign 벤치마크 캠페인 (대상 VM, 벤치마크 종류, 실행 주기)
+type BenchmarkCampaign struct {
+	Id         string        `json:"id"`
+	NsId       string        `json:"ns_id"`
+	McisId     string        `json:"mcis_id"`
+	VmIds      []string      `json:"vm_ids"`             // 대상 VM (미입력 시 MCIS 전체 VM)
+	Benchmarks []string      `json:"benchmarks"`         // 벤치마크 종류
+	RttHost    string        `json:"rtt_host,omitempty"` // rtt 측정 대상 주소
+	Interval   int           `json:"interval"`           // 실행 주기 (분), 0일 경우 요청 시에만 실행
+	CreatedAt  int64         `json:"created_at"`
+	LastRun    *BenchmarkRun `json:"last_run,omitempty"`
+}
+
+// BenchmarkRun 벤치마크 캠페인 실행 결과
+type BenchmarkRun struct {
+	Status     string            `json:"status"`
+	StartedAt  int64             `json:"started_at"`
+	UpdatedAt  int64             `json:"updated_at"` // 실행 중 상태 갱신 시각
+	FinishedAt int64             `json:"finished_at"`
+	SuccessCnt int               `json:"success_cnt"`
+	FailedCnt  int               `json:"failed_cnt"`
+	Message    string            `json:"message,omitempty"`
+	Results    []BenchmarkResult `json:"results"`
+}
+
+// BenchmarkResult VM 별 벤치마크 결과
+type BenchmarkResult struct {
+	VmId      string  `json:"vm_id"`
+	SpecId    string  `json:"spec_id"`
+	CspType   string  `json:"csp_type"`
+	Region    string  `json:"region"`
+	Benchmark string  `json:"benchmark"`
+	Result    float64 `json:"result"`
+	Unit      string  `json:"unit"`
+	Elapsed   float64 `json:"elapsed"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// BenchmarkHistoryFilter 벤치마크 이력 조회 조건
+type BenchmarkHistoryFilter struct {
+	NsId       string
+	McisId     string
+	VmId       string
+	CampaignId string
+	Benchmark  string
+	SpecId     string
+	CspType    string
+	Period     string // 조회 기간 (InfluxQL duration, 기본값 30d)
+}
+
+// BenchmarkRecord 벤치마크 측정 값
+type BenchmarkRecord struct {
+	Time    string  `json:"time"`
+	Result  float64 `json:"result"`
+	Elapsed float64 `json:"elapsed"`
+	Unit    string  `json:"unit"`
+}
+
+// BenchmarkHistory VM, 벤치마크 별 측정 이력
+type BenchmarkHistory struct {
+	NsId       string            `json:"ns_id"`
+	McisId     string            `json:"mcis_id"`
+	VmId       string            `json:"vm_id"`
+	CampaignId string            `json:"campaign_id"`
+	Benchmark  string            `json:"benchmark"`
+	SpecId     string            `json:"spec_id"`
+	CspType    string            `json:"csp_type"`
+	Region     string            `json:"region"`
+	Records    []BenchmarkRecord `json:"records"`
+}
+
+// BenchmarkStat CSP, 스펙 별 벤치마크 통계
+type BenchmarkStat struct {
+	Benchmark string  `json:"benchmark"`
+	CspType   string  `json:"csp_type"`
+	SpecId    string  `json:"spec_id"`
+	Unit      string  `json:"unit"`
+	Mean      float64 `json:"mean"`
+	Min       float64 `json:"min"`
+	Max       float64 `json:"max"`
+	Stddev    float64 `json:"stddev"`
+	Count     int     `json:"count"`
+}
+
+// 캠페인 중복 실행 방지 (실행 중인 캠페인 아이디)
+var runningCampaign = struct {
+	sync.Mutex
+	idSet map[string]bool
+}{idSet: map[string]bool{}}
+
+// 실행 결과 갱신과 캠페인 수정이 동시에 메타데이터를 덮어쓰지 않도록 직렬화
+var campaignLock sync.Mutex
+
+// IsSupportedBenchmark 벤치마크 종류 지원 여부
+func IsSupportedBenchmark(benchmark string) bool {
+	for _, supported := range SupportedBenchmarks {
+		if supported == benchmark {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 벤치마크 캠페인 설정 값 체크
+func (bc BenchmarkCampaign) Validate() error {
+	if !campaignIdRegexp.MatchString(bc.Id) {
+		return errors.New(fmt.Sprintf("invalid campaign id %s", bc.Id))
+	}
+	if bc.NsId == "" || bc.McisId == "" {
+		return errors.New("empty ns_id or mcis_id")
+	}
+	if len(bc.Benchmarks) == 0 {
+		return errors.New("empty benchmarks")
+	}
+	for _, benchmark := range bc.Benchmarks {
+		if !IsSupportedBenchmark(benchmark) {
+			return errors.New(fmt.Sprintf("unsupported benchmark %s, supported benchmarks are %s", benchmark, strings.Join(SupportedBenchmarks, ", ")))
+		}
+		if benchmark == RttBenchmark && bc.RttHost == "" {
+			return errors.New("empty rtt_host for rtt benchmark")
+		}
+	}
+	if bc.Interval < 0 {
+		return errors.New(fmt.Sprintf("invalid interval %d", bc.Interval))
+	}
+	return nil
+}
+
+// ListBenchmarkCampaign 벤치마크 캠페인 목록 조회
+func ListBenchmarkCampaign() ([]BenchmarkCampaign, error) {
+	campaignByteMap, err := cbstore.GetInstance().StoreGetListMap(types.BenchmarkCampaign, true)
+	if err != nil {
+		return nil, err
+	}
+	campaignList := []BenchmarkCampaign{}
+	for _, campaignStr := range campaignByteMap {
+		campaign := BenchmarkCampaign{}
+		if err := json.Unmarshal([]byte(campaignStr), &campaign); err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to convert benchmark campaign, error=%s", err))
+		}
+		campaignList = append(campaignList, campaign)
+	}
+	sort.Slice(campaignList, func(i, j int) bool {
+		return campaignList[i].Id < campaignList[j].Id
+	})
+	return campaignList, nil
+}
+
+// GetBenchmarkCampaign 벤치마크 캠페인 조회
+func GetBenchmarkCampaign(campaignId string) (*BenchmarkCampaign, int, error) {
+	campaignStr, err := cbstore.GetInstance().StoreGet(types.BenchmarkCampaign + campaignId)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get benchmark campaign, error=%s", err))
+	}
+	if campaignStr == nil || *campaignStr == "" {
+		return nil, http.StatusNotFound, errors.New(fmt.Sprintf("benchmark campaign %s not found", campaignId))
+	}
+	campaign := BenchmarkCampaign{}
+	if err := json.Unmarshal([]byte(*campaignStr), &campaign); err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to convert benchmark campaign, error=%s", err))
+	}
+	return &campaign, http.StatusOK, nil
+}
+
+// PutBenchmarkCampaign 벤치마크 캠페인 생성, 수정 (기존 실행 결과 유지)
+func PutBenchmarkCampaign(campaign BenchmarkCampaign) (*BenchmarkCampaign, int, error) {
+	if err := campaign.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	campaignLock.Lock()
+	defer campaignLock.Unlock()
+
+	campaign.CreatedAt = time.Now().Unix()
+	campaign.LastRun = nil
+	if prevCampaign, _, err := GetBenchmarkCampaign(campaign.Id); err == nil {
+		campaign.CreatedAt = prevCampaign.CreatedAt
+		campaign.LastRun = prevCampaign.LastRun
+	}
+	if err := putBenchmarkCampaign(campaign); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &campaign, http.StatusOK, nil
+}
+
+// DeleteBenchmarkCampaign 벤치마크 캠페인 삭제 (저장된 벤치마크 이력은 유지)
+func DeleteBenchmarkCampaign(campaignId string) (int, error) {
+	campaignLock.Lock()
+	defer campaignLock.Unlock()
+
+	if _, errCode, err := GetBenchmarkCampaign(campaignId); err != nil {
+		return errCode, err
+	}
+	if err := cbstore.GetInstance().StoreDelete(types.BenchmarkCampaign + campaignId); err != nil {
+		return http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to delete benchmark campaign, error=%s", err))
+	}
+	return http.StatusNoContent, nil
+}
+
+// StartBenchmarkCampaign 벤치마크 캠페인 실행 요청 (비동기 실행, 결과는 캠페인 last_run 및 벤치마크 이력으로 조회)
+//   - auth 는 Tumblebug VM 목록 조회 시 사용하며, 빈 값일 경우 설정 계정을 사용합니다.
+//   - 실행 고루틴은 wg 로 종료를 추적하며, ctx 취소 시 진행 중인 벤치마크까지만 실행하고 실패 상태로 종료합니다.
+//   - 다른 인스턴스에서 실행 중인 캠페인(상태 갱신이 멈추지 않은 캠페인)은 실행하지 않습니다.
+func StartBenchmarkCampaign(ctx context.Context, wg *sync.WaitGroup, campaignId string, auth string) (int, error) {
+	campaign, errCode, err := GetBenchmarkCampaign(campaignId)
+	if err != nil {
+		return errCode, err
+	}
+
+	runningCampaign.Lock()
+	defer runningCampaign.Unlock()
+	if runningCampaign.idSet[campaignId] || (campaign.LastRun != nil && campaign.LastRun.Status == BenchmarkRunning && !isStaleBenchmarkRun(*campaign.LastRun, time.Now())) {
+		return http.StatusConflict, errors.New(fmt.Sprintf("benchmark campaign %s is already running", campaignId))
+	}
+	if ctx.Err() != nil {
+		return http.StatusServiceUnavailable, errors.New("benchmark campaign cannot be started during shutdown")
+	}
+	runningCampaign.idSet[campaignId] = true
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() {
+			runningCampaign.Lock()
+			delete(runningCampaign.idSet, campaignId)
+			runningCampaign.Unlock()
+		}()
+		runBenchmarkCampaign(ctx, *campaign, auth)
+	}()
+	return http.StatusAccepted, nil
+}
+
+// StartDueBenchmarkCampaign 실행 주기가 도래한 벤치마크 캠페인 실행 (실행 요청한 캠페인 아이디 목록 반환)
+func StartDueBenchmarkCampaign(ctx context.Context, wg *sync.WaitGroup, now time.Time) ([]string, error) {
+	campaignList, err := ListBenchmarkCampaign()
+	if err != nil {
+		return nil, err
+	}
+	var startedList []string
+	for _, campaign := range campaignList {
+		if campaign.Interval <= 0 {
+			continue
+		}
+		if campaign.LastRun != nil && now.Unix()-campaign.LastRun.StartedAt < int64(campaign.Interval*60) {
+			continue
+		}
+		if _, err := StartBenchmarkCampaign(ctx, wg, campaign.Id, ""); err != nil {
+			continue
+		}
+		startedList = append(startedList, campaign.Id)
+	}
+	return startedList, nil
+}
+
+// FailStaleBenchmarkCampaign 실행 인스턴스가 종료되어 running 상태로 남은 캠페인을 실패 처리 (실패 처리한 캠페인 아이디 목록 반환)
+//   - 상태 갱신(updated_at)이 benchmarkStaleTimeout 이상 멈춘 캠페인을 대상으로 합니다.
+func FailStaleBenchmarkCampaign(now time.Time) ([]string, error) {
+	campaignList, err := ListBenchmarkCampaign()
+	if err != nil {
+		return nil, err
+	}
+	var failedList []string
+	for _, campaign := range campaignList {
+		if campaign.LastRun == nil || campaign.LastRun.Status != BenchmarkRunning || !isStaleBenchmarkRun(*campaign.LastRun, now) {
+			continue
+		}
+		runningCampaign.Lock()
+		isLocalRun := runningCampaign.idSet[campaign.Id]
+		runningCampaign.Unlock()
+		if isLocalRun {
+			continue
+		}
+		if failStaleBenchmarkRun(campaign.Id, now) {
+			failedList = append(failedList, campaign.Id)
+		}
+	}
+	return failedList, nil
+}
+
+// isStaleBenchmarkRun 실행 상태 갱신이 멈춘 캠페인 실행 여부
+func isStaleBenchmarkRun(run BenchmarkRun, now time.Time) bool {
+	updatedAt := run.UpdatedAt
+	if updatedAt == 0 {
+		updatedAt = run.StartedAt
+	}
+	return now.Sub(time.Unix(updatedAt, 0)) > benchmarkStaleTimeout
+}
+
+// runBenchmarkCampaign 캠페인 대상 VM 별 벤치마크 실행 후 결과 저장
+//   - VM 간에는 병렬로, 동일 VM 의 벤치마크는 측정 간섭을 피하기 위해 순차로 실행합니다.
+func runBenchmarkCampaign(ctx context.Context, campaign BenchmarkCampaign, auth string) {
+	run := BenchmarkRun{
+		Status:    BenchmarkRunning,
+		StartedAt: time.Now().Unix(),
+		UpdatedAt: time.Now().Unix(),
+		Results:   []BenchmarkResult{},
+	}
+	updateBenchmarkRun(campaign.Id, run)
+
+	// 실행 중 상태 갱신 (다른 인스턴스에서 중단된 실행으로 판단하지 않도록)
+	heartbeatCtx, stopHeartbeat := context.WithCancel(context.Background())
+	defer stopHeartbeat()
+	go func() {
+		ticker := time.NewTicker(benchmarkHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-heartbeatCtx.Done():
+				return
+			case <-ticker.C:
+				touchBenchmarkRun(campaign.Id, run.StartedAt)
+			}
+		}
+	}()
+
+	vmList, err := listBenchmarkVM(campaign, auth)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to run benchmark campaign %s, error=%s", campaign.Id, err))
+		run.Status = BenchmarkFailed
+		run.Message = err.Error()
+		run.FinishedAt = time.Now().Unix()
+		updateBenchmarkRun(campaign.Id, run)
+		return
+	}
+
+	var wg sync.WaitGroup
+	var resultLock sync.Mutex
+	for _, vm := range vmList {
+		wg.Add(1)
+		go func(vm tumblebug.VM) {
+			defer wg.Done()
+			for _, benchmark := range campaign.Benchmarks {
+				// 종료 요청 시 남은 벤치마크는 실행하지 않음
+				if ctx.Err() != nil {
+					return
+				}
+				result := runBenchmark(campaign, vm, benchmark)
+				resultLock.Lock()
+				run.Results = append(run.Results, result)
+				resultLock.Unlock()
+			}
+		}(vm)
+	}
+	wg.Wait()
+
+	sort.Slice(run.Results, func(i, j int) bool {
+		if run.Results[i].VmId != run.Results[j].VmId {
+			return run.Results[i].VmId < run.Results[j].VmId
+		}
+		return run.Results[i].Benchmark < run.Results[j].Benchmark
+	})
+	for _, result := range run.Results {
+		if result.Error == "" {
+			run.SuccessCnt++
+		} else {
+			run.FailedCnt++
+		}
+	}
+	run.Status = BenchmarkFinished
+	if ctx.Err() != nil {
+		run.Status = BenchmarkFailed
+		run.Message = "benchmark campaign stopped by shutdown"
+	}
+	run.FinishedAt = time.Now().Unix()
+	run.UpdatedAt = run.FinishedAt
+	updateBenchmarkRun(campaign.Id, run)
+}
+
+// listBenchmarkVM 캠페인 대상 VM 목록 조회 (공인 IP가 없는 VM 제외)
+func listBenchmarkVM(campaign BenchmarkCampaign, auth string) ([]tumblebug.VM, error) {
+	vmList, err := tumblebug.GetInstance().WithAuth(auth).ListVM(campaign.NsId, campaign.McisId)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to get mcis vm list, error=%s", err))
+	}
+	vmIdSet := map[string]bool{}
+	for _, vmId := range campaign.VmIds {
+		vmIdSet[vmId] = true
+	}
+	targetList := []tumblebug.VM{}
+	for _, vm := range vmList {
+		if len(vmIdSet) > 0 && !vmIdSet[vm.Id] {
+			continue
+		}
+		if vm.PublicIP == "" {
+			continue
+		}
+		targetList = append(targetList, vm)
+	}
+	if len(targetList) == 0 {
+		return nil, errors.New("no benchmark target vm")
+	}
+	return targetList, nil
+}
+
+// runBenchmark VM Milkyway 벤치마크 실행 후 결과 저장
+func runBenchmark(campaign BenchmarkCampaign, vm tumblebug.VM, benchmark string) BenchmarkResult {
+	result := BenchmarkResult{
+		VmId:      vm.Id,
+		SpecId:    vm.SpecId,
+		CspType:   vm.ConnectionConfig.ProviderName,
+		Region:    vm.Region.Region,
+		Benchmark: benchmark,
+	}
+
+	var metric *types.CBMCISMetric
+	var err error
+	if benchmark == RttBenchmark {
+		metric, _, err = GetMCISMonRTTInfo(campaign.NsId, campaign.McisId, vm.Id, vm.PublicIP, types.Request{Host: campaign.RttHost})
+	} else {
+		metric, _, err = GetMCISCommonMonInfo(campaign.NsId, campaign.McisId, vm.Id, vm.PublicIP, benchmark)
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	if result.Result, err = strconv.ParseFloat(strings.TrimSpace(metric.Result), 64); err != nil {
+		result.Error = fmt.Sprintf("invalid benchmark result %s", metric.Result)
+		return result
+	}
+	result.Unit = metric.Unit
+	result.Elapsed, _ = strconv.ParseFloat(strings.TrimSpace(metric.Elapsed), 64)
+
+	tagArr := map[string]string{
+		types.NsId:   campaign.NsId,
+		types.McisId: campaign.McisId,
+		types.VmId:   vm.Id,
+		"campaignId": campaign.Id,
+		"benchmark":  benchmark,
+		"specId":     result.SpecId,
+		"cspType":    result.CspType,
+		"region":     result.Region,
+	}
+	fieldArr := map[string]interface{}{
+		"result":  result.Result,
+		"elapsed": result.Elapsed,
+		"unit":    result.Unit,
+	}
+	if err := v1.GetWritePipeline().WritePoint(v1.BenchmarkDatabase, v1.BenchmarkMeasurement, tagArr, fieldArr, time.Now()); err != nil {
+		result.Error = fmt.Sprintf("failed to write benchmark result, error=%s", err)
+	}
+	return result
+}
+
+// GetBenchmarkHistory 벤치마크 측정 이력 조회 (VM, 벤치마크 별 시계열)
+func GetBenchmarkHistory(filter BenchmarkHistoryFilter) ([]BenchmarkHistory, int, error) {
+	period, err := getBenchmarkPeriod(filter.Period)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	groupByArr := []string{types.NsId, types.McisId, types.VmId, "campaignId", "benchmark", "specId", "cspType", "region"}
+	rows, err := v1.GetInstance().ReadBenchmark(period, filter.tagFilter(), groupByArr, "\"result\"", "\"elapsed\"", "\"unit\"")
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get benchmark history, error=%s", err))
+	}
+
+	historyList := []BenchmarkHistory{}
+	for _, row := range rows {
+		history := BenchmarkHistory{
+			NsId:       row.Tags[types.NsId],
+			McisId:     row.Tags[types.McisId],
+			VmId:       row.Tags[types.VmId],
+			CampaignId: row.Tags["campaignId"],
+			Benchmark:  row.Tags["benchmark"],
+			SpecId:     row.Tags["specId"],
+			CspType:    row.Tags["cspType"],
+			Region:     row.Tags["region"],
+			Records:    []BenchmarkRecord{},
+		}
+		for _, value := range getRowValueMapList(row) {
+			history.Records = append(history.Records, BenchmarkRecord{
+				Time:    rowString(value["time"]),
+				Result:  rowFloat(value["result"]),
+				Elapsed: rowFloat(value["elapsed"]),
+				Unit:    rowString(value["unit"]),
+			})
+		}
+		historyList = append(historyList, history)
+	}
+	return historyList, http.StatusOK, nil
+}
+
+// GetBenchmarkComparison CSP, 스펙 별 벤치마크 통계 조회 (동일 벤치마크의 클라우드 간 성능 비교)
+func GetBenchmarkComparison(filter BenchmarkHistoryFilter) ([]BenchmarkStat, int, error) {
+	period, err := getBenchmarkPeriod(filter.Period)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	groupByArr := []string{"benchmark", "cspType", "specId"}
+	selectArr := []string{
+		"mean(\"result\") AS \"mean\"", "min(\"result\") AS \"min\"", "max(\"result\") AS \"max\"",
+		"stddev(\"result\") AS \"stddev\"", "count(\"result\") AS \"count\"", "last(\"unit\") AS \"unit\"",
+	}
+	rows, err := v1.GetInstance().ReadBenchmark(period, filter.tagFilter(), groupByArr, selectArr...)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get benchmark statistics, error=%s", err))
+	}
+
+	statList := []BenchmarkStat{}
+	for _, row := range rows {
+		valueList := getRowValueMapList(row)
+		if len(valueList) == 0 {
+			continue
+		}
+		value := valueList[0]
+		statList = append(statList, BenchmarkStat{
+			Benchmark: row.Tags["benchmark"],
+			CspType:   row.Tags["cspType"],
+			SpecId:    row.Tags["specId"],
+			Unit:      rowString(value["unit"]),
+			Mean:      rowFloat(value["mean"]),
+			Min:       rowFloat(value["min"]),
+			Max:       rowFloat(value["max"]),
+			Stddev:    rowFloat(value["stddev"]),
+			Count:     int(rowFloat(value["count"])),
+		})
+	}
+	sort.Slice(statList, func(i, j int) bool {
+		if statList[i].Benchmark != statList[j].Benchmark {
+			return statList[i].Benchmark < statList[j].Benchmark
+		}
+		return statList[i].Mean > statList[j].Mean
+	})
+	return statList, http.StatusOK, nil
+}
+
+func (f BenchmarkHistoryFilter) tagFilter() map[string]string {
+	tagFilter := map[string]string{}
+	for tagKey, tagVal := range map[string]string{
+		types.NsId:   f.NsId,
+		types.McisId: f.McisId,
+		types.VmId:   f.VmId,
+		"campaignId": f.CampaignId,
+		"benchmark":  f.Benchmark,
+		"specId":     f.SpecId,
+		"cspType":    f.CspType,
+	} {
+		if tagVal != "" {
+			tagFilter[tagKey] = tagVal
+		}
+	}
+	return tagFilter
+}
+
+func getBenchmarkPeriod(period string) (string, error) {
+	if period == "" {
+		return defaultBenchmarkPeriod, nil
+	}
+	if !windowRegexp.MatchString(period) {
+		return "", errors.New(fmt.Sprintf("invalid period %s, period must be like 30d", period))
+	}
+	return period, nil
+}
+
+// getRowValueMapList 시리즈 값 목록을 컬럼명 기준 map 목록으로 변환
+func getRowValueMapList(row models.Row) []map[string]interface{} {
+	valueMapList := make([]map[string]interface{}, 0, len(row.Values))
+	for _, value := range row.Values {
+		valueMap := map[string]interface{}{}
+		for idx, column := range row.Columns {
+			if idx < len(value) {
+				valueMap[column] = value[idx]
+			}
+		}
+		valueMapList = append(valueMapList, valueMap)
+	}
+	return valueMapList
+}
+
+func updateBenchmarkRun(campaignId string, run BenchmarkRun) {
+	campaignLock.Lock()
+	defer campaignLock.Unlock()
+
+	campaign, _, err := GetBenchmarkCampaign(campaignId)
+	if err != nil {
+		// 실행 중 삭제된 캠페인
+		return
+	}
+	campaign.LastRun = &run
+	if err := putBenchmarkCampaign(*campaign); err != nil {
+		util.GetLogger().Error(err)
+	}
+}
+
+// touchBenchmarkRun 실행 중인 캠페인 상태 갱신 시각 변경 (startedAt 실행이 아닐 경우 무시)
+func touchBenchmarkRun(campaignId string, startedAt int64) {
+	campaignLock.Lock()
+	defer campaignLock.Unlock()
+
+	campaign, _, err := GetBenchmarkCampaign(campaignId)
+	if err != nil || campaign.LastRun == nil || campaign.LastRun.StartedAt != startedAt || campaign.LastRun.Status != BenchmarkRunning {
+		return
+	}
+	campaign.LastRun.UpdatedAt = time.Now().Unix()
+	if err := putBenchmarkCampaign(*campaign); err != nil {
+		util.GetLogger().Error(err)
+	}
+}
+
+// failStaleBenchmarkRun 상태 갱신이 멈춘 캠페인 실행 실패 처리 (실패 처리 여부 반환)
+func failStaleBenchmarkRun(campaignId string, now time.Time) bool {
+	campaignLock.Lock()
+	defer campaignLock.Unlock()
+
+	campaign, _, err := GetBenchmarkCampaign(campaignId)
+	if err != nil || campaign.LastRun == nil || campaign.LastRun.Status != BenchmarkRunning || !isStaleBenchmarkRun(*campaign.LastRun, now) {
+		return false
+	}
+	campaign.LastRun.Status = BenchmarkFailed
+	campaign.LastRun.Message = "benchmark campaign interrupted, runner instance stopped"
+	campaign.LastRun.FinishedAt = now.Unix()
+	campaign.LastRun.UpdatedAt = now.Unix()
+	if err := putBenchmarkCampaign(*campaign); err != nil {
+		util.GetLogger().Error(err)
+		return false
+	}
+	return true
+}
+
+func putBenchmarkCampaign(campaign BenchmarkCampaign) error {
+	campaignBytes, err := json.Marshal(campaign)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to convert benchmark campaign, error=%s", err))
+	}
+	if err := cbstore.GetInstance().StorePut(types.BenchmarkCampaign+campaign.Id, string(campaignBytes)); err != nil {
+		return errors.New(fmt.Sprintf("failed to put benchmark campaign, error=%s", err))
+	}
+	return nil
+}
+
+// rowFloat 시리즈 값 float 변환 (값이 없을 경우 0)
+func rowFloat(val interface{}) float64 {
+	floatVal, _ := servicemon.ToFloat(val)
+	return floatVal
+}
+
+// rowString 시리즈 값 문자열 변환 (값이 없을 경우 빈 문자열)
+func rowString(val interface{}) string {
+	if val == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", val)
+}
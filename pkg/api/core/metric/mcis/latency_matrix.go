@@ -0,0 +1,322 @@
+package mcis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/servicemon"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// VM 간 지연 시간 측정 대상 IP 종류
+const (
+	PublicIpType  = "public"
+	PrivateIpType = "private"
+)
+
+const (
+	defaultLatencyPeriod    = "24h"
+	defaultLatencyGroupTime = "10m"
+
+	// 측정 요청 단위 손실률 (응답, 무응답)
+	reachableLoss   = 0.0
+	unreachableLoss = 100.0
+)
+
+// LatencyEntry 출발 VM 에서 도착 VM 으로의 지연 시간 측정 결과
+//   - Milkyway mrtt 는 패킷 손실률을 제공하지 않으므로 loss 는 측정 요청 단위 도달 여부입니다. (응답 0, 무응답 100)
+//   - 응답이 없는 경우 rtt 는 null, loss 는 100 이며, 출발 VM 에이전트 측정 실패 시 rtt, loss 모두 null 이고 error 에 사유를 기록합니다.
+type LatencyEntry struct {
+	SrcVmId     string   `json:"src_vm_id"`
+	DstVmId     string   `json:"dst_vm_id"`
+	IpType      string   `json:"ip_type"`
+	DstIp       string   `json:"dst_ip"`
+	SrcRegion   string   `json:"src_region"`
+	DstRegion   string   `json:"dst_region"`
+	InterRegion bool     `json:"inter_region"`
+	Rtt         *float64 `json:"rtt"`  // 평균 RTT (ms)
+	Loss        *float64 `json:"loss"` // 손실률 (%, 응답 0, 무응답 100)
+	Error       string   `json:"error,omitempty"`
+}
+
+// LatencyGrid VM 순서 기준 N×N 매트릭스 ([출발 VM][도착 VM], 측정 불가 및 자기 자신은 null)
+type LatencyGrid struct {
+	Rtt  [][]*float64 `json:"rtt"`
+	Loss [][]*float64 `json:"loss"`
+}
+
+// LatencyMatrix MCIS VM 간 지연 시간 매트릭스
+type LatencyMatrix struct {
+	NsId      string         `json:"ns_id"`
+	McisId    string         `json:"mcis_id"`
+	Timestamp int64          `json:"timestamp"`
+	VmIds     []string       `json:"vm_ids"`
+	Regions   []string       `json:"regions"`
+	Public    LatencyGrid    `json:"public"`
+	Private   LatencyGrid    `json:"private"`
+	Entries   []LatencyEntry `json:"entries"`
+}
+
+// LatencyRecord 시간 구간 별 평균 지연 시간, 평균 손실률 (구간 내 응답이 없는 경우 rtt 는 null)
+type LatencyRecord struct {
+	Time string   `json:"time"`
+	Rtt  *float64 `json:"rtt"`
+	Loss *float64 `json:"loss"`
+}
+
+// LatencyHistory VM 쌍 별 지연 시간 이력
+type LatencyHistory struct {
+	SrcVmId     string          `json:"src_vm_id"`
+	DstVmId     string          `json:"dst_vm_id"`
+	IpType      string          `json:"ip_type"`
+	SrcRegion   string          `json:"src_region"`
+	DstRegion   string          `json:"dst_region"`
+	InterRegion bool            `json:"inter_region"`
+	Records     []LatencyRecord `json:"records"`
+}
+
+type latencyTarget struct {
+	vm     tumblebug.VM
+	ipType string
+	ip     string
+}
+
+// GetMCISLatencyMatrix MCIS 전체 VM 간 지연 시간 매트릭스 측정
+//   - 각 VM 에이전트에 나머지 VM 의 공인, 사설 IP 에 대한 mrtt 측정을 요청합니다.
+//   - store 설정 시 VM 쌍 별 측정 결과를 저장하며, 알람 태스크 대상이 됩니다. (measurement: latency, field: rtt, loss, reachable)
+//   - 응답이 없는 VM 쌍도 loss 100, reachable 0 으로 저장하며, reachable 태그로 알람 대상을 구분할 수 있습니다.
+func GetMCISLatencyMatrix(nsId string, mcisId string, auth string, store bool) (*LatencyMatrix, int, error) {
+	vmList, err := tumblebug.GetInstance().WithAuth(auth).ListVM(nsId, mcisId)
+	if err != nil {
+		if tumblebug.IsNotFound(err) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get mcis vm list, error=%s", err))
+	}
+	sort.Slice(vmList, func(i, j int) bool {
+		return vmList[i].Id < vmList[j].Id
+	})
+
+	now := time.Now()
+	var wg sync.WaitGroup
+	entryList := make([][]LatencyEntry, len(vmList))
+	for idx, srcVm := range vmList {
+		wg.Add(1)
+		go func(idx int, srcVm tumblebug.VM) {
+			defer wg.Done()
+			entryList[idx] = measureLatency(nsId, mcisId, srcVm, vmList)
+		}(idx, srcVm)
+	}
+	wg.Wait()
+
+	matrix := LatencyMatrix{
+		NsId:      nsId,
+		McisId:    mcisId,
+		Timestamp: now.Unix(),
+		VmIds:     make([]string, len(vmList)),
+		Regions:   make([]string, len(vmList)),
+		Public:    newLatencyGrid(len(vmList)),
+		Private:   newLatencyGrid(len(vmList)),
+		Entries:   []LatencyEntry{},
+	}
+	vmIdxMap := map[string]int{}
+	for idx, vm := range vmList {
+		matrix.VmIds[idx] = vm.Id
+		matrix.Regions[idx] = vm.Region.Region
+		vmIdxMap[vm.Id] = idx
+	}
+	for _, entries := range entryList {
+		for _, entry := range entries {
+			matrix.Entries = append(matrix.Entries, entry)
+			grid := matrix.Public
+			if entry.IpType == PrivateIpType {
+				grid = matrix.Private
+			}
+			grid.Rtt[vmIdxMap[entry.SrcVmId]][vmIdxMap[entry.DstVmId]] = entry.Rtt
+			grid.Loss[vmIdxMap[entry.SrcVmId]][vmIdxMap[entry.DstVmId]] = entry.Loss
+
+			// 도달 여부가 확인된 측정 결과 저장 (출발 VM 에이전트 측정 실패는 제외)
+			if store && entry.Loss != nil {
+				writeLatencyEntry(nsId, mcisId, entry, now)
+			}
+		}
+	}
+	return &matrix, http.StatusOK, nil
+}
+
+// GetMCISLatencyHistory MCIS VM 쌍 별 지연 시간 이력 조회 (groupTime 구간 평균)
+func GetMCISLatencyHistory(nsId string, mcisId string, period string, groupTime string, interRegionOnly bool) ([]LatencyHistory, int, error) {
+	if period == "" {
+		period = defaultLatencyPeriod
+	}
+	if groupTime == "" {
+		groupTime = defaultLatencyGroupTime
+	}
+	if !windowRegexp.MatchString(period) {
+		return nil, http.StatusBadRequest, errors.New(fmt.Sprintf("invalid period %s, period must be like 24h", period))
+	}
+	if !windowRegexp.MatchString(groupTime) {
+		return nil, http.StatusBadRequest, errors.New(fmt.Sprintf("invalid group_time %s, group_time must be like 10m", groupTime))
+	}
+
+	tagFilter := map[string]string{types.NsId: nsId, types.McisId: mcisId}
+	if interRegionOnly {
+		tagFilter["interRegion"] = strconv.FormatBool(true)
+	}
+	groupByArr := []string{fmt.Sprintf("time(%s)", groupTime), types.VmId, "targetVmId", "ipType", "srcRegion", "dstRegion", "interRegion"}
+	rows, err := v1.GetInstance().ReadLatency(period, tagFilter, groupByArr, "mean(\"rtt\") AS \"rtt\"", "mean(\"loss\") AS \"loss\"")
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get latency history, error=%s", err))
+	}
+
+	historyList := []LatencyHistory{}
+	for _, row := range rows {
+		history := LatencyHistory{
+			SrcVmId:     row.Tags[types.VmId],
+			DstVmId:     row.Tags["targetVmId"],
+			IpType:      row.Tags["ipType"],
+			SrcRegion:   row.Tags["srcRegion"],
+			DstRegion:   row.Tags["dstRegion"],
+			InterRegion: row.Tags["interRegion"] == strconv.FormatBool(true),
+			Records:     []LatencyRecord{},
+		}
+		for _, value := range getRowValueMapList(row) {
+			record := LatencyRecord{Time: rowString(value["time"])}
+			if rtt, ok := servicemon.ToFloat(value["rtt"]); ok {
+				record.Rtt = &rtt
+			}
+			if loss, ok := servicemon.ToFloat(value["loss"]); ok {
+				record.Loss = &loss
+			}
+			// 측정 결과가 없는 시간 구간 제외 (응답이 없는 구간은 loss 만 포함)
+			if record.Rtt == nil && record.Loss == nil {
+				continue
+			}
+			history.Records = append(history.Records, record)
+		}
+		historyList = append(historyList, history)
+	}
+	sort.Slice(historyList, func(i, j int) bool {
+		if historyList[i].SrcVmId != historyList[j].SrcVmId {
+			return historyList[i].SrcVmId < historyList[j].SrcVmId
+		}
+		if historyList[i].DstVmId != historyList[j].DstVmId {
+			return historyList[i].DstVmId < historyList[j].DstVmId
+		}
+		return historyList[i].IpType < historyList[j].IpType
+	})
+	return historyList, http.StatusOK, nil
+}
+
+// measureLatency 출발 VM 에이전트에 나머지 VM 대상 mrtt 측정 요청
+func measureLatency(nsId string, mcisId string, srcVm tumblebug.VM, vmList []tumblebug.VM) []LatencyEntry {
+	var targetList []latencyTarget
+	for _, dstVm := range vmList {
+		if dstVm.Id == srcVm.Id {
+			continue
+		}
+		if dstVm.PublicIP != "" {
+			targetList = append(targetList, latencyTarget{vm: dstVm, ipType: PublicIpType, ip: dstVm.PublicIP})
+		}
+		if dstVm.PrivateIP != "" {
+			targetList = append(targetList, latencyTarget{vm: dstVm, ipType: PrivateIpType, ip: dstVm.PrivateIP})
+		}
+	}
+
+	entryList := make([]LatencyEntry, len(targetList))
+	mrttParam := types.Mrequest{MultiHost: make([]types.Request, len(targetList))}
+	for idx, target := range targetList {
+		entryList[idx] = LatencyEntry{
+			SrcVmId:     srcVm.Id,
+			DstVmId:     target.vm.Id,
+			IpType:      target.ipType,
+			DstIp:       target.ip,
+			SrcRegion:   srcVm.Region.Region,
+			DstRegion:   target.vm.Region.Region,
+			InterRegion: srcVm.Region.Region != target.vm.Region.Region,
+		}
+		mrttParam.MultiHost[idx] = types.Request{Host: target.ip}
+	}
+	if len(targetList) == 0 {
+		return entryList
+	}
+
+	if srcVm.PublicIP == "" {
+		return setLatencyError(entryList, "empty public ip of source vm")
+	}
+	metric, _, err := GetMCISMonMRTTInfo(nsId, mcisId, srcVm.Id, srcVm.PublicIP, mrttParam)
+	if err != nil {
+		util.GetLogger().Warn(fmt.Sprintf("failed to measure latency, nsId=%s, mcisId=%s, vmId=%s, error=%s", nsId, mcisId, srcVm.Id, err))
+		return setLatencyError(entryList, err.Error())
+	}
+
+	// 측정 결과는 요청 대상 순서와 동일
+	for idx := range entryList {
+		if idx < len(metric.ResultArray) {
+			if rtt, err := strconv.ParseFloat(strings.TrimSpace(metric.ResultArray[idx].Result), 64); err == nil && rtt > 0 {
+				loss := reachableLoss
+				entryList[idx].Rtt = &rtt
+				entryList[idx].Loss = &loss
+				continue
+			}
+		}
+		loss := unreachableLoss
+		entryList[idx].Loss = &loss
+		entryList[idx].Error = "no response"
+	}
+	return entryList
+}
+
+func setLatencyError(entryList []LatencyEntry, errMsg string) []LatencyEntry {
+	for idx := range entryList {
+		entryList[idx].Error = errMsg
+	}
+	return entryList
+}
+
+func writeLatencyEntry(nsId string, mcisId string, entry LatencyEntry, now time.Time) {
+	reachable := entry.Rtt != nil
+	tagArr := map[string]string{
+		types.NsId:    nsId,
+		types.McisId:  mcisId,
+		types.VmId:    entry.SrcVmId,
+		"targetVmId":  entry.DstVmId,
+		"ipType":      entry.IpType,
+		"srcRegion":   entry.SrcRegion,
+		"dstRegion":   entry.DstRegion,
+		"interRegion": strconv.FormatBool(entry.InterRegion),
+		"reachable":   strconv.FormatBool(reachable),
+	}
+	fieldArr := map[string]interface{}{
+		"loss":      *entry.Loss,
+		"reachable": 0,
+	}
+	if reachable {
+		fieldArr["rtt"] = *entry.Rtt
+		fieldArr["reachable"] = 1
+	}
+	if err := v1.GetWritePipeline().WritePoint(v1.DefaultDatabase, v1.LatencyMeasurement, tagArr, fieldArr, now); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to write latency, error=%s", err))
+	}
+}
+
+func newLatencyGrid(size int) LatencyGrid {
+	grid := LatencyGrid{
+		Rtt:  make([][]*float64, size),
+		Loss: make([][]*float64, size),
+	}
+	for idx := 0; idx < size; idx++ {
+		grid.Rtt[idx] = make([]*float64, size)
+		grid.Loss[idx] = make([]*float64, size)
+	}
+	return grid
+}
@@ -8,13 +8,18 @@ import (
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
 const (
 	Rtt  = "Rtt"
 	Mrtt = "Mrtt"
+
+	MilkywayTimeout = 600 // Milkyway 벤치마크 요청 제한 시간 (s)
 )
 
+var milkywayClient = http.Client{Timeout: MilkywayTimeout * time.Second}
+
 type MCISMetric struct {
 	client    http.Client
 	data      types.CBMCISMetric
@@ -24,20 +29,10 @@ type MCISMetric struct {
 	parameter types.Parameter
 }
 
-func GetMCISMonInfo() (interface{}, error) {
-	// TODO: MCIS 서비스 모니터링 정보 조회 기능 개발
-	return nil, nil
-}
-
-func GetMCISRealtimeMonInfo(nsId string, mcisId string) (interface{}, error) {
-	// TODO: MCIS 서비스 실시간 모니터링 정보 조회 기능 개발
-	return nil, nil
-}
-
 // GetMCISCommonMonInfos ...
 func GetMCISCommonMonInfo(nsId string, mcisId string, vmId string, agentIp string, metricName string) (*types.CBMCISMetric, int, error) {
 	// MCIS Get 요청 API 생성
-	resp, err := http.Get(fmt.Sprintf("http://%s:%d/cb-dragonfly/mcis/metric/%s", agentIp, types.AgentPort, metricName))
+	resp, err := milkywayClient.Get(fmt.Sprintf("http://%s:%d/cb-dragonfly/mcis/metric/%s", agentIp, types.AgentPort, metricName))
 	if err != nil {
 		return nil, http.StatusInternalServerError, errors.New("agent server is closed")
 	}
@@ -75,8 +70,7 @@ func GetMCISMonRTTInfo(nsId string, mcisId string, vmId string, agentIp string,
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	httpClient := http.Client{}
-	resp, err := httpClient.Do(req)
+	resp, err := milkywayClient.Do(req)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
@@ -114,8 +108,7 @@ func GetMCISMonMRTTInfo(nsId string, mcisId string, vmId string, agentIp string,
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	httpClient := http.Client{}
-	resp, err := httpClient.Do(req)
+	resp, err := milkywayClient.Do(req)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
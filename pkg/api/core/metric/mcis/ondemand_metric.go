@@ -1,17 +1,20 @@
 package mcis
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
@@ -84,6 +87,52 @@ func getVMOnDemandMonInfo(metric types.Metric, publicIP string) (map[string]inte
 	return resultMetric, nil
 }
 
+// GetVMOnDemandMultiMonInfo 에이전트 메트릭 일괄 조회 (단일 요청, 응답에 포함된 메트릭 별 변환 결과 반환)
+//   - 쉼표로 구분된 메트릭 목록을 요청하며, 메트릭이 하나일 경우 단일 메트릭 조회 요청과 동일합니다.
+//   - 에이전트 응답 상태 코드를 함께 반환합니다. (요청 실패 시 0)
+func GetVMOnDemandMultiMonInfo(ctx context.Context, metricArr []types.Metric, publicIP string) (map[types.Metric]map[string]interface{}, int, error) {
+	metricKeyArr := make([]string, len(metricArr))
+	for idx, metric := range metricArr {
+		metricKeyArr[idx] = metric.ToAgentMetricKey()
+	}
+	agentUrl := fmt.Sprintf("http://%s:%d/cb-dragonfly/metric/%s", publicIP, types.AgentPort, strings.Join(metricKeyArr, ","))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, agentUrl, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, 0, errors.New(fmt.Sprintf("failed to get metric from agent, error=%s", err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, errors.New(fmt.Sprintf("failed to get metric from agent, status=%d", resp.StatusCode))
+	}
+
+	var metricData = map[string]collector.TelegrafMetric{}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	if err = json.Unmarshal(body, &metricData); err != nil {
+		return nil, resp.StatusCode, errors.New(fmt.Sprintf("failed to convert agent metric, error=%s", err))
+	}
+
+	resultMap := map[types.Metric]map[string]interface{}{}
+	for _, metric := range metricArr {
+		telegrafMetric, ok := metricData[metric.ToAgentMetricKey()]
+		if !ok || telegrafMetric.Name == "" {
+			continue
+		}
+		resultMetric, err := collector.ConvertMonMetric(metric, telegrafMetric)
+		if err != nil {
+			return nil, resp.StatusCode, err
+		}
+		resultMap[metric] = resultMetric
+	}
+	return resultMap, resp.StatusCode, nil
+}
+
 type PacketsInfo struct {
 	DestinationIp    string
 	PacketCnt        int
@@ -119,10 +168,6 @@ func GetMCISOnDemandPacketInfo(nsId string, mcisId string, vmId string, watchTim
 		return NetworkPacketsResult{}, http.StatusInternalServerError, errors.New("two or more agents must be installed, and each agent must have a different vm_id")
 	}
 
-	client := http.Client{
-		Timeout: AgentTimeout * time.Second,
-	}
-
 	wg := sync.WaitGroup{}
 	wg.Add(len(targetAgentInfo))
 
@@ -131,32 +176,57 @@ func GetMCISOnDemandPacketInfo(nsId string, mcisId string, vmId string, watchTim
 		PacketsInfos: map[int]PacketsInfo{},
 	}
 
+	var resultLock sync.Mutex
 	for idx, targetAgent := range targetAgentInfo {
-		agentUrl := fmt.Sprintf("http://%s:%d/cb-dragonfly/mcis/dstip/%s/watchtime/%s", sourceAgentIP, types.AgentPort, targetAgent.PublicIp, watchTime)
 		idx := idx
 		targetAgent := targetAgent
 		go func() {
 			defer wg.Done()
-			packetsInfo := PacketsInfo{}
-			resp, err := client.Get(agentUrl)
+			packetsInfo, err := capturePacket(sourceAgentIP, targetAgent.PublicIp, watchTime)
 			if err != nil {
 				fmt.Println("err: "+targetAgent.PublicIp+", msg: ", err)
 				return
 			}
-			body, err2 := ioutil.ReadAll(resp.Body)
-			defer resp.Body.Close()
-			if err2 != nil {
-				fmt.Println("err: "+targetAgent.PublicIp+", msg: ", err2)
-				return
-			}
-			_ = json.Unmarshal(body, &packetsInfo)
-			result.PacketsInfos[idx] = packetsInfo
+			resultLock.Lock()
+			result.PacketsInfos[idx] = *packetsInfo
+			resultLock.Unlock()
 		}()
 	}
 	wg.Wait()
 	return result, http.StatusOK, err
 }
 
+// capturePacket 출발 에이전트에서 도착 IP 로 향하는 패킷을 watchTime (s) 동안 캡처
+func capturePacket(sourceAgentIP string, destinationIP string, watchTime string) (*PacketsInfo, error) {
+	// 캡처 시간 동안 응답 대기
+	timeout := AgentTimeout * time.Second
+	if watchSec, err := strconv.Atoi(watchTime); err == nil {
+		timeout += time.Duration(watchSec) * time.Second
+	}
+	client := http.Client{
+		Timeout: timeout,
+	}
+	agentUrl := fmt.Sprintf("http://%s:%d/cb-dragonfly/mcis/dstip/%s/watchtime/%s", sourceAgentIP, types.AgentPort, destinationIP, watchTime)
+	resp, err := client.Get(agentUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(fmt.Sprintf("failed to capture packet, status=%d, body=%s", resp.StatusCode, string(body)))
+	}
+	packetsInfo := PacketsInfo{}
+	if err := json.Unmarshal(body, &packetsInfo); err != nil {
+		return nil, err
+	}
+	return &packetsInfo, nil
+}
+
 type ProcessUsage struct {
 	Pid      string
 	CpuUsage string
@@ -164,25 +234,31 @@ type ProcessUsage struct {
 	Command  string
 }
 
+// GetMCISOnDemandProcessInfo 에이전트 프로세스 사용량 조회 (사용자 별 프로세스 목록)
 func GetMCISOnDemandProcessInfo(publicIp string) (map[string][]ProcessUsage, int, error) {
-
-	userProcess := map[string][]ProcessUsage{}
 	client := http.Client{
 		Timeout: AgentTimeout * time.Second,
 	}
 	agentUrl := fmt.Sprintf("http://%s:%d/cb-dragonfly/mcis/process", publicIp, types.AgentPort)
 	resp, err := client.Get(agentUrl)
 	if err != nil {
-		return map[string][]ProcessUsage{}, http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to request agent process, error=%s", err))
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to read agent process, error=%s", err))
 	}
-	body, err2 := ioutil.ReadAll(resp.Body)
-	if err2 != nil {
-		return map[string][]ProcessUsage{}, http.StatusInternalServerError, err
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, errors.New(fmt.Sprintf("failed to request agent process, status=%d, body=%s", resp.StatusCode, string(body)))
 	}
-	_ = json.Unmarshal(body, &userProcess)
 
+	userProcess := map[string][]ProcessUsage{}
+	if err := json.Unmarshal(body, &userProcess); err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to convert agent process, error=%s", err))
+	}
 	return userProcess, http.StatusOK, nil
-
 }
 
 type vmSpec struct {
@@ -192,7 +268,7 @@ type vmSpec struct {
 	ConnectionName string
 	CspSpecName    string
 	NumvCPU        int
-	MemGiB         int
+	MemGiB         float64
 	CostPerHour    float64
 }
 
@@ -203,34 +279,38 @@ type McisVMSpecs struct {
 	VmSpec         []vmSpec
 }
 
+// GetMCISSpecInfo MCIS VM 스펙 목록, 평균 vCPU, 메모리, 시간당 비용 조회 (Tumblebug)
 func GetMCISSpecInfo(nsId string, mcisId string, auth string) (McisVMSpecs, int, error) {
 	mcisVMSpecs := McisVMSpecs{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/ns/%s/mcis/%s", types.TBRestAPIURL, nsId, mcisId), nil)
-	req.Header.Add("Authorization", auth)
-	client := &http.Client{}
-	resp, _ := client.Do(req)
-	defer resp.Body.Close()
-	bytes, _ := ioutil.ReadAll(resp.Body)
-	respStr := strings.Split(string(bytes), `"specId":`)[1:]
-	if len(respStr) == 0 {
+	tbClient := tumblebug.GetInstance().WithAuth(auth)
+	vmList, err := tbClient.ListVM(nsId, mcisId)
+	if err != nil {
+		if tumblebug.IsNotFound(err) {
+			return mcisVMSpecs, http.StatusNotFound, err
+		}
+		return mcisVMSpecs, http.StatusInternalServerError, err
+	}
+	if len(vmList) == 0 {
 		return mcisVMSpecs, http.StatusOK, nil
 	}
-	//nsId = "common"
-	for _, specIdStr := range respStr {
-		specId := strings.ReplaceAll(strings.Split(specIdStr, ",")[0], `"`, "")
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/ns/%s/resources/spec/%s", types.TBRestAPIURL, nsId, specId), nil)
-		req.Header.Add("Authorization", auth)
-		resp, _ = client.Do(req)
-		bytes, _ = ioutil.ReadAll(resp.Body)
-		vmSpec := vmSpec{}
-		err = json.Unmarshal(bytes, &vmSpec)
+	for _, vm := range vmList {
+		spec, err := tbClient.GetSpec(nsId, vm.SpecId)
 		if err != nil {
-			fmt.Println(err)
+			return mcisVMSpecs, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get vm spec, vmId=%s, specId=%s, error=%s", vm.Id, vm.SpecId, err))
 		}
-		mcisVMSpecs.AvgNumvCpu += float64(vmSpec.NumvCPU)
-		mcisVMSpecs.AvgMemGiB += float64(vmSpec.MemGiB)
-		mcisVMSpecs.AvgCostPerHour += vmSpec.CostPerHour
-		mcisVMSpecs.VmSpec = append(mcisVMSpecs.VmSpec, vmSpec)
+		mcisVMSpecs.AvgNumvCpu += float64(spec.NumvCPU)
+		mcisVMSpecs.AvgMemGiB += spec.MemGiB
+		mcisVMSpecs.AvgCostPerHour += spec.CostPerHour
+		mcisVMSpecs.VmSpec = append(mcisVMSpecs.VmSpec, vmSpec{
+			Namespace:      spec.Namespace,
+			Id:             spec.Id,
+			Name:           spec.Name,
+			ConnectionName: spec.ConnectionName,
+			CspSpecName:    spec.CspSpecName,
+			NumvCPU:        spec.NumvCPU,
+			MemGiB:         spec.MemGiB,
+			CostPerHour:    spec.CostPerHour,
+		})
 	}
 	vmSpecCnt := float64(len(mcisVMSpecs.VmSpec))
 	mcisVMSpecs.AvgNumvCpu /= vmSpecCnt
@@ -0,0 +1,328 @@
+package mcis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	DefaultFlowWatchTime = 10
+	MaxFlowWatchTime     = 60
+
+	defaultFlowMaxConcurrency = 20
+
+	defaultFlowPeriod = "24h"
+)
+
+// PacketFlow 출발 VM 에서 도착 VM 으로의 패킷 흐름 (캡처 구간)
+type PacketFlow struct {
+	SrcVmId    string  `json:"src_vm_id"`
+	DstVmId    string  `json:"dst_vm_id"`
+	DstIp      string  `json:"dst_ip"`
+	SrcCsp     string  `json:"src_csp"`
+	DstCsp     string  `json:"dst_csp"`
+	CrossCloud bool    `json:"cross_cloud"`
+	Bytes      int     `json:"bytes"`
+	Packets    int     `json:"packets"`
+	Bps        float64 `json:"bps"` // 캡처 구간 평균 전송량 (byte/s)
+	Error      string  `json:"error,omitempty"`
+}
+
+// VMFlowTable VM 별 송신 패킷 흐름 테이블
+type VMFlowTable struct {
+	VmId            string       `json:"vm_id"`
+	CspType         string       `json:"csp_type"`
+	EgressBytes     int          `json:"egress_bytes"`
+	EgressPackets   int          `json:"egress_packets"`
+	CrossCloudBytes int          `json:"cross_cloud_bytes"` // 다른 CSP VM 으로의 송신량
+	Flows           []PacketFlow `json:"flows"`
+}
+
+// MCISPacketFlow MCIS 패킷 흐름 캡처 결과
+type MCISPacketFlow struct {
+	NsId      string        `json:"ns_id"`
+	McisId    string        `json:"mcis_id"`
+	Timestamp int64         `json:"timestamp"`
+	WatchTime int           `json:"watch_time"`
+	VMs       []VMFlowTable `json:"vms"`
+}
+
+// FlowEdge 조회 기간 내 VM 쌍 별 누적 패킷 흐름
+//   - 주기 캡처 구간의 샘플 합계이며, AvgBps 는 캡처 시간 합계 기준 평균 전송량입니다.
+type FlowEdge struct {
+	SrcVmId       string  `json:"src_vm_id"`
+	DstVmId       string  `json:"dst_vm_id"`
+	SrcCsp        string  `json:"src_csp"`
+	DstCsp        string  `json:"dst_csp"`
+	CrossCloud    bool    `json:"cross_cloud"`
+	Bytes         float64 `json:"bytes"`
+	Packets       float64 `json:"packets"`
+	SampledSecond float64 `json:"sampled_second"`
+	AvgBps        float64 `json:"avg_bps"`
+}
+
+// CspEgress CSP 간 송신량 요약
+type CspEgress struct {
+	SrcCsp string  `json:"src_csp"`
+	DstCsp string  `json:"dst_csp"`
+	Bytes  float64 `json:"bytes"`
+	AvgBps float64 `json:"avg_bps"` // VM 쌍 평균 전송량 합계
+}
+
+// MCISFlowMap MCIS VM 간 패킷 흐름 지도
+type MCISFlowMap struct {
+	NsId       string      `json:"ns_id"`
+	McisId     string      `json:"mcis_id"`
+	Period     string      `json:"period"`
+	Edges      []FlowEdge  `json:"edges"`
+	CspEgress  []CspEgress `json:"csp_egress"`
+	CrossCloud float64     `json:"cross_cloud_bytes"` // CSP 간 송신량 합계
+}
+
+// CaptureMCISPacketFlow MCIS 전체 에이전트 간 패킷 캡처 후 VM 별 흐름 테이블 구성
+//   - 각 에이전트가 나머지 VM 공인 IP 로 향하는 패킷을 watchTime (s) 동안 캡처합니다.
+//   - VM 쌍 수(N×(N-1))와 관계없이 동시 캡처 요청은 max_concurrency 개로 제한하며, 초과한 VM 쌍은 앞선 캡처 완료 후 캡처합니다.
+//   - store 설정 시 VM 쌍 별 캡처 결과를 저장합니다. (database: cbmonflow, measurement: packet_flow)
+func CaptureMCISPacketFlow(nsId string, mcisId string, watchTime int, store bool) (*MCISPacketFlow, int, error) {
+	if watchTime == 0 {
+		watchTime = DefaultFlowWatchTime
+	}
+	if watchTime < 0 || watchTime > MaxFlowWatchTime {
+		return nil, http.StatusBadRequest, errors.New(fmt.Sprintf("invalid watch_time %d, watch_time must be between 1 and %d", watchTime, MaxFlowWatchTime))
+	}
+
+	agentList, err := listFlowAgent(nsId, mcisId)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if len(agentList) < 2 {
+		return nil, http.StatusBadRequest, errors.New("two or more agents must be installed on mcis")
+	}
+
+	now := time.Now()
+	result := MCISPacketFlow{
+		NsId:      nsId,
+		McisId:    mcisId,
+		Timestamp: now.Unix(),
+		WatchTime: watchTime,
+		VMs:       make([]VMFlowTable, len(agentList)),
+	}
+
+	maxConcurrency := config.GetInstance().PacketFlow.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultFlowMaxConcurrency
+	}
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrency)
+	for srcIdx, srcAgent := range agentList {
+		result.VMs[srcIdx] = VMFlowTable{
+			VmId:    srcAgent.VmId,
+			CspType: srcAgent.CspType,
+			Flows:   []PacketFlow{},
+		}
+		for _, dstAgent := range agentList {
+			if dstAgent.VmId == srcAgent.VmId {
+				continue
+			}
+			result.VMs[srcIdx].Flows = append(result.VMs[srcIdx].Flows, PacketFlow{
+				SrcVmId:    srcAgent.VmId,
+				DstVmId:    dstAgent.VmId,
+				DstIp:      dstAgent.PublicIp,
+				SrcCsp:     srcAgent.CspType,
+				DstCsp:     dstAgent.CspType,
+				CrossCloud: srcAgent.CspType != dstAgent.CspType,
+			})
+		}
+		for flowIdx := range result.VMs[srcIdx].Flows {
+			semaphore <- struct{}{}
+			wg.Add(1)
+			go func(srcIp string, flow *PacketFlow) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				packetsInfo, err := capturePacket(srcIp, flow.DstIp, strconv.Itoa(watchTime))
+				if err != nil {
+					flow.Error = err.Error()
+					return
+				}
+				flow.Bytes = packetsInfo.TotalPacketBytes
+				flow.Packets = packetsInfo.PacketCnt
+				flow.Bps = util.ToFixed(float64(packetsInfo.TotalPacketBytes)/float64(watchTime), 2)
+			}(srcAgent.PublicIp, &result.VMs[srcIdx].Flows[flowIdx])
+		}
+	}
+	wg.Wait()
+
+	for idx := range result.VMs {
+		table := &result.VMs[idx]
+		for _, flow := range table.Flows {
+			if flow.Error != "" {
+				continue
+			}
+			table.EgressBytes += flow.Bytes
+			table.EgressPackets += flow.Packets
+			if flow.CrossCloud {
+				table.CrossCloudBytes += flow.Bytes
+			}
+			if store {
+				writePacketFlow(nsId, mcisId, flow, watchTime, now)
+			}
+		}
+	}
+	return &result, http.StatusOK, nil
+}
+
+// GetMCISFlowMap 조회 기간 내 저장된 패킷 흐름을 VM 쌍, CSP 쌍 별로 집계
+func GetMCISFlowMap(nsId string, mcisId string, period string) (*MCISFlowMap, int, error) {
+	if period == "" {
+		period = defaultFlowPeriod
+	}
+	if !windowRegexp.MatchString(period) {
+		return nil, http.StatusBadRequest, errors.New(fmt.Sprintf("invalid period %s, period must be like 24h", period))
+	}
+
+	tagFilter := map[string]string{types.NsId: nsId, types.McisId: mcisId}
+	groupByArr := []string{types.VmId, "targetVmId", "srcCsp", "dstCsp", "crossCloud"}
+	selectArr := []string{"sum(\"bytes\") AS \"bytes\"", "sum(\"packets\") AS \"packets\"", "sum(\"watch_time\") AS \"watch_time\""}
+	rows, err := v1.GetInstance().ReadFlow(period, tagFilter, groupByArr, selectArr...)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get packet flow, error=%s", err))
+	}
+
+	flowMap := MCISFlowMap{
+		NsId:      nsId,
+		McisId:    mcisId,
+		Period:    period,
+		Edges:     []FlowEdge{},
+		CspEgress: []CspEgress{},
+	}
+	cspEgressMap := map[string]*CspEgress{}
+	for _, row := range rows {
+		valueList := getRowValueMapList(row)
+		if len(valueList) == 0 {
+			continue
+		}
+		value := valueList[0]
+		edge := FlowEdge{
+			SrcVmId:       row.Tags[types.VmId],
+			DstVmId:       row.Tags["targetVmId"],
+			SrcCsp:        row.Tags["srcCsp"],
+			DstCsp:        row.Tags["dstCsp"],
+			CrossCloud:    row.Tags["crossCloud"] == strconv.FormatBool(true),
+			Bytes:         rowFloat(value["bytes"]),
+			Packets:       rowFloat(value["packets"]),
+			SampledSecond: rowFloat(value["watch_time"]),
+		}
+		if edge.SampledSecond > 0 {
+			edge.AvgBps = util.ToFixed(edge.Bytes/edge.SampledSecond, 2)
+		}
+		flowMap.Edges = append(flowMap.Edges, edge)
+
+		if edge.CrossCloud {
+			flowMap.CrossCloud += edge.Bytes
+		}
+		cspKey := edge.SrcCsp + "/" + edge.DstCsp
+		if _, ok := cspEgressMap[cspKey]; !ok {
+			cspEgressMap[cspKey] = &CspEgress{SrcCsp: edge.SrcCsp, DstCsp: edge.DstCsp}
+		}
+		cspEgressMap[cspKey].Bytes += edge.Bytes
+		cspEgressMap[cspKey].AvgBps += edge.AvgBps
+	}
+
+	for _, egress := range cspEgressMap {
+		egress.AvgBps = util.ToFixed(egress.AvgBps, 2)
+		flowMap.CspEgress = append(flowMap.CspEgress, *egress)
+	}
+	sort.Slice(flowMap.Edges, func(i, j int) bool {
+		return flowMap.Edges[i].Bytes > flowMap.Edges[j].Bytes
+	})
+	sort.Slice(flowMap.CspEgress, func(i, j int) bool {
+		return flowMap.CspEgress[i].Bytes > flowMap.CspEgress[j].Bytes
+	})
+	return &flowMap, http.StatusOK, nil
+}
+
+// ListFlowTarget 패킷 흐름 캡처 대상 MCIS 목록 (에이전트가 2개 이상 설치된 MCIS)
+func ListFlowTarget() (map[string][]string, error) {
+	agentList, err := common.ListAgent()
+	if err != nil {
+		return nil, err
+	}
+	agentCntMap := map[string]map[string]int{}
+	for _, agentInfo := range agentList {
+		if !isFlowAgent(agentInfo) {
+			continue
+		}
+		if _, ok := agentCntMap[agentInfo.NsId]; !ok {
+			agentCntMap[agentInfo.NsId] = map[string]int{}
+		}
+		agentCntMap[agentInfo.NsId][agentInfo.McisId]++
+	}
+	targetMap := map[string][]string{}
+	for nsId, mcisMap := range agentCntMap {
+		for mcisId, agentCnt := range mcisMap {
+			if agentCnt >= 2 {
+				targetMap[nsId] = append(targetMap[nsId], mcisId)
+			}
+		}
+	}
+	return targetMap, nil
+}
+
+func listFlowAgent(nsId string, mcisId string) ([]common.AgentInfo, error) {
+	agentList, err := common.ListAgent()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to get agent list, error=%s", err))
+	}
+	var flowAgentList []common.AgentInfo
+	for _, agentInfo := range agentList {
+		if agentInfo.NsId != nsId || agentInfo.McisId != mcisId || !isFlowAgent(agentInfo) {
+			continue
+		}
+		flowAgentList = append(flowAgentList, agentInfo)
+	}
+	sort.Slice(flowAgentList, func(i, j int) bool {
+		return flowAgentList[i].VmId < flowAgentList[j].VmId
+	})
+	return flowAgentList, nil
+}
+
+// isFlowAgent MCK8S, 비활성, 삭제된 VM 에이전트 제외
+func isFlowAgent(agentInfo common.AgentInfo) bool {
+	if util.CheckMCK8SType(agentInfo.ServiceType) || agentInfo.PublicIp == "" || agentInfo.Orphaned {
+		return false
+	}
+	return agentInfo.AgentState != string(common.Disable)
+}
+
+func writePacketFlow(nsId string, mcisId string, flow PacketFlow, watchTime int, now time.Time) {
+	tagArr := map[string]string{
+		types.NsId:   nsId,
+		types.McisId: mcisId,
+		types.VmId:   flow.SrcVmId,
+		"targetVmId": flow.DstVmId,
+		"srcCsp":     flow.SrcCsp,
+		"dstCsp":     flow.DstCsp,
+		"crossCloud": strconv.FormatBool(flow.CrossCloud),
+	}
+	fieldArr := map[string]interface{}{
+		"bytes":      flow.Bytes,
+		"packets":    flow.Packets,
+		"watch_time": watchTime,
+	}
+	if err := v1.GetWritePipeline().WritePoint(v1.FlowDatabase, v1.FlowMeasurement, tagArr, fieldArr, now); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to write packet flow, error=%s", err))
+	}
+}
@@ -0,0 +1,314 @@
+package mcis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/procname"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// 프로세스 사용량 정렬 기준
+const (
+	ProcessSortCpu = "cpu"
+	ProcessSortMem = "mem"
+)
+
+const (
+	DefaultProcessTopN = 10
+
+	defaultProcessRange = time.Hour
+	maxProcessRange     = 7 * 24 * time.Hour
+)
+
+// ProcessRecord 실행 파일 별 프로세스 사용량
+//   - pid, user, command 는 동일 실행 파일 프로세스 중 사용률이 가장 높은 프로세스 정보입니다.
+type ProcessRecord struct {
+	Exe        string  `json:"exe"`
+	Pid        string  `json:"pid"`
+	User       string  `json:"user"`
+	Command    string  `json:"command"`
+	CpuUsage   float64 `json:"cpu_usage"`   // CPU 사용률 합계 (%)
+	MemUsage   float64 `json:"mem_usage"`   // 메모리 사용률 합계 (%)
+	ProcessCnt int     `json:"process_cnt"` // 동일 실행 파일 프로세스 수
+}
+
+// ProcessSnapshot 수집 시각 별 상위 프로세스 목록
+type ProcessSnapshot struct {
+	Time      string          `json:"time"`
+	Processes []ProcessRecord `json:"processes"`
+}
+
+// ProcessStat 조회 기간 내 실행 파일 별 프로세스 사용량 통계
+type ProcessStat struct {
+	Exe         string  `json:"exe"`
+	AvgCpuUsage float64 `json:"avg_cpu_usage"`
+	MaxCpuUsage float64 `json:"max_cpu_usage"`
+	AvgMemUsage float64 `json:"avg_mem_usage"`
+	MaxMemUsage float64 `json:"max_mem_usage"`
+	SampleCnt   int     `json:"sample_cnt"` // 상위 프로세스로 수집된 횟수
+}
+
+// ProcessHistoryQuery 프로세스 이력 조회 조건
+type ProcessHistoryQuery struct {
+	NsId   string
+	McisId string
+	VmId   string
+	Start  string // RFC3339 (미입력 시 End 1시간 전)
+	End    string // RFC3339 (미입력 시 현재 시각)
+	Sort   string // cpu, mem (기본값 cpu)
+	Limit  int    // 시각 별 (통계의 경우 전체) 최대 프로세스 수 (기본값 10)
+}
+
+// CollectVMProcess 에이전트 상위 프로세스 사용량 수집 후 저장
+//   - 프로세스 사용량을 실행 파일 별로 합산한 뒤, CPU 사용률 상위 topN, 메모리 사용률 상위 topN 실행 파일의 합집합을 저장합니다.
+//   - series 증가를 방지하기 위해 실행 파일 이름만 태그로 저장하고 pid, user, command 는 필드로 저장합니다. (database: cbmonproc)
+func CollectVMProcess(agentInfo common.AgentInfo, topN int, now time.Time) error {
+	userProcess, _, err := GetMCISOnDemandProcessInfo(agentInfo.PublicIp)
+	if err != nil {
+		return err
+	}
+	if topN <= 0 {
+		topN = DefaultProcessTopN
+	}
+
+	// 동일 실행 파일 프로세스는 같은 태그, 시각의 point 로 덮어써지므로 실행 파일 별로 합산
+	exeMap := map[string]*ProcessRecord{}
+	var processList []*ProcessRecord
+	for user, usageList := range userProcess {
+		for _, usage := range usageList {
+			process := ProcessRecord{
+				Exe:      procname.Normalize(usage.Command),
+				Pid:      usage.Pid,
+				User:     user,
+				Command:  usage.Command,
+				CpuUsage: parseUsage(usage.CpuUsage),
+				MemUsage: parseUsage(usage.MemUsage),
+			}
+			exeProcess, ok := exeMap[process.Exe]
+			if !ok {
+				process.ProcessCnt = 1
+				exeMap[process.Exe] = &process
+				processList = append(processList, &process)
+				continue
+			}
+			if lessProcess(process, *exeProcess, ProcessSortCpu) {
+				exeProcess.Pid, exeProcess.User, exeProcess.Command = process.Pid, process.User, process.Command
+			}
+			exeProcess.CpuUsage += process.CpuUsage
+			exeProcess.MemUsage += process.MemUsage
+			exeProcess.ProcessCnt++
+		}
+	}
+
+	topSet := map[int]bool{}
+	for _, sortBy := range []string{ProcessSortCpu, ProcessSortMem} {
+		idxList := make([]int, len(processList))
+		for idx := range idxList {
+			idxList[idx] = idx
+		}
+		sort.SliceStable(idxList, func(i, j int) bool {
+			return lessProcess(*processList[idxList[i]], *processList[idxList[j]], sortBy)
+		})
+		for rank, idx := range idxList {
+			if rank >= topN {
+				break
+			}
+			topSet[idx] = true
+		}
+	}
+
+	for idx := range topSet {
+		process := processList[idx]
+		tagArr := map[string]string{
+			types.NsId:   agentInfo.NsId,
+			types.McisId: agentInfo.McisId,
+			types.VmId:   agentInfo.VmId,
+			"exe":        process.Exe,
+		}
+		fieldArr := map[string]interface{}{
+			"pid":         process.Pid,
+			"user":        process.User,
+			"command":     process.Command,
+			"cpu_usage":   process.CpuUsage,
+			"mem_usage":   process.MemUsage,
+			"process_cnt": process.ProcessCnt,
+		}
+		if err := v1.GetWritePipeline().WritePoint(v1.ProcessDatabase, v1.ProcessMeasurement, tagArr, fieldArr, now); err != nil {
+			return errors.New(fmt.Sprintf("failed to write process usage, error=%s", err))
+		}
+	}
+	return nil
+}
+
+// GetVMProcessHistory 조회 기간 내 수집 시각 별 상위 프로세스 목록 조회
+func GetVMProcessHistory(query ProcessHistoryQuery) ([]ProcessSnapshot, int, error) {
+	start, end, err := query.timeRange()
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if err := query.validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	rows, err := v1.GetInstance().ReadProcess(start, end, query.tagFilter(), []string{"exe"},
+		"\"pid\"", "\"user\"", "\"command\"", "\"cpu_usage\"", "\"mem_usage\"", "\"process_cnt\"")
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get process history, error=%s", err))
+	}
+
+	snapshotMap := map[string][]ProcessRecord{}
+	for _, row := range rows {
+		for _, value := range getRowValueMapList(row) {
+			timestamp := rowString(value["time"])
+			snapshotMap[timestamp] = append(snapshotMap[timestamp], ProcessRecord{
+				Exe:        row.Tags["exe"],
+				Pid:        rowString(value["pid"]),
+				User:       rowString(value["user"]),
+				Command:    rowString(value["command"]),
+				CpuUsage:   rowFloat(value["cpu_usage"]),
+				MemUsage:   rowFloat(value["mem_usage"]),
+				ProcessCnt: int(rowFloat(value["process_cnt"])),
+			})
+		}
+	}
+
+	snapshotList := make([]ProcessSnapshot, 0, len(snapshotMap))
+	for timestamp, processList := range snapshotMap {
+		sort.Slice(processList, func(i, j int) bool {
+			return lessProcess(processList[i], processList[j], query.Sort)
+		})
+		if len(processList) > query.Limit {
+			processList = processList[:query.Limit]
+		}
+		snapshotList = append(snapshotList, ProcessSnapshot{Time: timestamp, Processes: processList})
+	}
+	sort.Slice(snapshotList, func(i, j int) bool {
+		return snapshotList[i].Time < snapshotList[j].Time
+	})
+	return snapshotList, http.StatusOK, nil
+}
+
+// GetVMTopProcess 조회 기간 내 실행 파일 별 사용량 통계 상위 프로세스 조회 (pid, 인자가 바뀌어도 동일 실행 파일은 하나로 집계)
+func GetVMTopProcess(query ProcessHistoryQuery) ([]ProcessStat, int, error) {
+	start, end, err := query.timeRange()
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if err := query.validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	selectArr := []string{
+		"mean(\"cpu_usage\") AS \"avg_cpu\"", "max(\"cpu_usage\") AS \"max_cpu\"",
+		"mean(\"mem_usage\") AS \"avg_mem\"", "max(\"mem_usage\") AS \"max_mem\"", "count(\"cpu_usage\") AS \"count\"",
+	}
+	rows, err := v1.GetInstance().ReadProcess(start, end, query.tagFilter(), []string{"exe"}, selectArr...)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New(fmt.Sprintf("failed to get process statistics, error=%s", err))
+	}
+
+	statList := []ProcessStat{}
+	for _, row := range rows {
+		valueList := getRowValueMapList(row)
+		if len(valueList) == 0 {
+			continue
+		}
+		value := valueList[0]
+		statList = append(statList, ProcessStat{
+			Exe:         row.Tags["exe"],
+			AvgCpuUsage: rowFloat(value["avg_cpu"]),
+			MaxCpuUsage: rowFloat(value["max_cpu"]),
+			AvgMemUsage: rowFloat(value["avg_mem"]),
+			MaxMemUsage: rowFloat(value["max_mem"]),
+			SampleCnt:   int(rowFloat(value["count"])),
+		})
+	}
+	sort.Slice(statList, func(i, j int) bool {
+		if query.Sort == ProcessSortMem {
+			return statList[i].AvgMemUsage > statList[j].AvgMemUsage
+		}
+		return statList[i].AvgCpuUsage > statList[j].AvgCpuUsage
+	})
+	if len(statList) > query.Limit {
+		statList = statList[:query.Limit]
+	}
+	return statList, http.StatusOK, nil
+}
+
+func (q *ProcessHistoryQuery) validate() error {
+	if q.Sort == "" {
+		q.Sort = ProcessSortCpu
+	}
+	if q.Sort != ProcessSortCpu && q.Sort != ProcessSortMem {
+		return errors.New(fmt.Sprintf("invalid sort %s, sort must be cpu or mem", q.Sort))
+	}
+	if q.Limit == 0 {
+		q.Limit = DefaultProcessTopN
+	}
+	if q.Limit < 0 {
+		return errors.New(fmt.Sprintf("invalid limit %d", q.Limit))
+	}
+	return nil
+}
+
+func (q ProcessHistoryQuery) timeRange() (time.Time, time.Time, error) {
+	end := time.Now()
+	if q.End != "" {
+		var err error
+		if end, err = time.Parse(time.RFC3339, q.End); err != nil {
+			return time.Time{}, time.Time{}, errors.New(fmt.Sprintf("invalid end %s, end must be RFC3339", q.End))
+		}
+	}
+	start := end.Add(-defaultProcessRange)
+	if q.Start != "" {
+		var err error
+		if start, err = time.Parse(time.RFC3339, q.Start); err != nil {
+			return time.Time{}, time.Time{}, errors.New(fmt.Sprintf("invalid start %s, start must be RFC3339", q.Start))
+		}
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errors.New("start must be before end")
+	}
+	if end.Sub(start) > maxProcessRange {
+		return time.Time{}, time.Time{}, errors.New(fmt.Sprintf("time range must be within %s", maxProcessRange))
+	}
+	return start, end, nil
+}
+
+func (q ProcessHistoryQuery) tagFilter() map[string]string {
+	return map[string]string{
+		types.NsId:   q.NsId,
+		types.McisId: q.McisId,
+		types.VmId:   q.VmId,
+	}
+}
+
+func lessProcess(a ProcessRecord, b ProcessRecord, sortBy string) bool {
+	if sortBy == ProcessSortMem {
+		if a.MemUsage != b.MemUsage {
+			return a.MemUsage > b.MemUsage
+		}
+		return a.CpuUsage > b.CpuUsage
+	}
+	if a.CpuUsage != b.CpuUsage {
+		return a.CpuUsage > b.CpuUsage
+	}
+	return a.MemUsage > b.MemUsage
+}
+
+// parseUsage 에이전트 사용률 문자열 변환 (예: "12.5", "12.5%")
+func parseUsage(usage string) float64 {
+	usageVal, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(usage), "%"), 64)
+	if err != nil {
+		return 0
+	}
+	return usageVal
+}
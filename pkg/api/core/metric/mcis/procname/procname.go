@@ -0,0 +1,40 @@
+package procname
+
+import (
+	"path"
+	"strings"
+)
+
+// Unknown 실행 파일 이름을 확인할 수 없는 프로세스
+const Unknown = "unknown"
+
+// Normalize 프로세스 명령어에서 실행 파일 이름 추출 (프로세스 사용량 태그 값)
+//   - 인자, 실행 경로를 제외한 실행 파일 이름만 사용하여 명령어 별 series 증가를 방지합니다. (예: "/usr/bin/python3 app.py" => "python3")
+//   - 커널 스레드는 괄호, 스레드 번호를 제외합니다. (예: "[kworker/0:1]" => "kworker")
+//   - 프로세스가 변경한 명령어의 구분자 ':' 를 제외합니다. (예: "sshd: root@pts/0" => "sshd")
+func Normalize(command string) string {
+	command = strings.TrimSpace(command)
+	if strings.HasPrefix(command, "[") {
+		name := strings.TrimSuffix(strings.TrimPrefix(command, "["), "]")
+		if idx := strings.IndexAny(name, "/:"); idx >= 0 {
+			name = name[:idx]
+		}
+		return orUnknown(strings.TrimSpace(name))
+	}
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return Unknown
+	}
+	name := strings.TrimSuffix(path.Base(fields[0]), ":")
+	if name == "." || name == "/" {
+		return Unknown
+	}
+	return orUnknown(name)
+}
+
+func orUnknown(name string) string {
+	if name == "" {
+		return Unknown
+	}
+	return name
+}
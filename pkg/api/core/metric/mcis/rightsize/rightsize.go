@@ -0,0 +1,163 @@
+package rightsize
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+)
+
+// 스펙 추천 결과
+const (
+	Optimal          = "optimal"
+	OverProvisioned  = "over_provisioned"
+	UnderProvisioned = "under_provisioned"
+	InsufficientData = "insufficient_data"
+	UnknownSpec      = "unknown_spec"
+)
+
+// VMRightsizing VM 사용률, 스펙 추천 결과
+//   - cpu_utilization 은 분석 기간 내 cpu_percentile 백분위 CPU 사용률, mem_utilization 은 최대 메모리 사용률 입니다.
+//   - monthly_savings 가 음수일 경우 스펙 상향에 따른 추가 비용입니다.
+type VMRightsizing struct {
+	VmId              string  `json:"vm_id"`
+	CspType           string  `json:"csp_type"`
+	ConnectionName    string  `json:"connection_name"`
+	SpecId            string  `json:"spec_id"`
+	VCpu              int     `json:"vcpu"`
+	MemGiB            float64 `json:"mem_gib"`
+	CostPerHour       float64 `json:"cost_per_hour"`
+	CpuUtilization    float64 `json:"cpu_utilization"`
+	MemUtilization    float64 `json:"mem_utilization"`
+	Recommendation    string  `json:"recommendation"`
+	Reason            string  `json:"reason"`
+	TargetVCpu        int     `json:"target_vcpu"`
+	TargetMemGiB      float64 `json:"target_mem_gib"`
+	TargetSpecId      string  `json:"target_spec_id"`
+	TargetCostPerHour float64 `json:"target_cost_per_hour"`
+	MonthlyCost       float64 `json:"monthly_cost"`
+	TargetMonthlyCost float64 `json:"target_monthly_cost"`
+	MonthlySavings    float64 `json:"monthly_savings"`
+}
+
+// Utilization VM 분석 기간 사용률 (CPU 백분위 사용률, 최대 메모리 사용률, 조회 값이 없을 경우 nil)
+type Utilization struct {
+	Cpu    *float64
+	Memory *float64
+}
+
+// Recommend VM 사용률 기반 과다/부족 할당 판단, 추천 스펙 및 월 비용 산정
+func Recommend(vm *VMRightsizing, spec tumblebug.Spec, hasSpec bool, utilization *Utilization, specCatalog []tumblebug.Spec, rightsizingConfig config.Rightsizing) {
+	if utilization != nil {
+		if utilization.Cpu != nil {
+			vm.CpuUtilization = toFixed(*utilization.Cpu, 2)
+		}
+		if utilization.Memory != nil {
+			vm.MemUtilization = toFixed(*utilization.Memory, 2)
+		}
+	}
+	if !hasSpec {
+		vm.Recommendation = UnknownSpec
+		vm.Reason = "failed to get vm spec from tumblebug"
+		return
+	}
+	vm.ConnectionName = spec.ConnectionName
+	vm.SpecId = spec.Id
+	vm.VCpu = spec.NumvCPU
+	vm.MemGiB = spec.MemGiB
+	vm.CostPerHour = spec.CostPerHour
+	vm.MonthlyCost = toFixed(spec.CostPerHour*rightsizingConfig.HoursPerMonth, 2)
+	vm.TargetVCpu = spec.NumvCPU
+	vm.TargetMemGiB = spec.MemGiB
+	vm.TargetSpecId = spec.Id
+	vm.TargetCostPerHour = spec.CostPerHour
+	vm.TargetMonthlyCost = vm.MonthlyCost
+
+	if utilization == nil || utilization.Cpu == nil || utilization.Memory == nil {
+		vm.Recommendation = InsufficientData
+		vm.Reason = "no cpu or memory utilization in analysis window"
+		return
+	}
+
+	// 목표 사용률 기준 필요 자원 산정
+	requiredVCpu := int(RequiredResource(float64(spec.NumvCPU), vm.CpuUtilization, rightsizingConfig.TargetCpu))
+	requiredMemGiB := RequiredResource(spec.MemGiB, vm.MemUtilization, rightsizingConfig.TargetMem)
+
+	switch {
+	case vm.CpuUtilization >= rightsizingConfig.CpuHighThreshold || vm.MemUtilization >= rightsizingConfig.MemHighThreshold:
+		vm.Recommendation = UnderProvisioned
+		vm.Reason = fmt.Sprintf("cpu %.2f%%, peak memory %.2f%% exceed high threshold", vm.CpuUtilization, vm.MemUtilization)
+		vm.TargetVCpu = maxInt(requiredVCpu, spec.NumvCPU)
+		vm.TargetMemGiB = math.Max(requiredMemGiB, spec.MemGiB)
+	case (vm.CpuUtilization < rightsizingConfig.CpuLowThreshold || vm.MemUtilization < rightsizingConfig.MemLowThreshold) && (requiredVCpu < spec.NumvCPU || requiredMemGiB < spec.MemGiB):
+		vm.Recommendation = OverProvisioned
+		vm.Reason = fmt.Sprintf("cpu %.2f%%, peak memory %.2f%% below low threshold", vm.CpuUtilization, vm.MemUtilization)
+		vm.TargetVCpu = minInt(requiredVCpu, spec.NumvCPU)
+		vm.TargetMemGiB = math.Min(requiredMemGiB, spec.MemGiB)
+	default:
+		vm.Recommendation = Optimal
+		vm.Reason = "utilization is within thresholds"
+		return
+	}
+
+	targetSpec, ok := FindTargetSpec(spec, vm.TargetVCpu, vm.TargetMemGiB, vm.Recommendation == OverProvisioned, specCatalog)
+	if !ok {
+		vm.Reason += ", no matching spec in catalog"
+		return
+	}
+	vm.TargetSpecId = targetSpec.Id
+	vm.TargetCostPerHour = targetSpec.CostPerHour
+	vm.TargetMonthlyCost = toFixed(targetSpec.CostPerHour*rightsizingConfig.HoursPerMonth, 2)
+	vm.MonthlySavings = toFixed(vm.MonthlyCost-vm.TargetMonthlyCost, 2)
+}
+
+// FindTargetSpec 동일 커넥션 스펙 중 필요 자원을 만족하는 가장 저렴한 스펙 조회 (하향 추천 시 현재 스펙보다 저렴한 스펙만 대상)
+func FindTargetSpec(current tumblebug.Spec, vCpu int, memGiB float64, downsize bool, specCatalog []tumblebug.Spec) (tumblebug.Spec, bool) {
+	var target tumblebug.Spec
+	found := false
+	for _, spec := range specCatalog {
+		if spec.Id == current.Id || spec.ConnectionName != current.ConnectionName {
+			continue
+		}
+		if spec.NumvCPU < vCpu || spec.MemGiB < memGiB {
+			continue
+		}
+		if downsize && spec.CostPerHour >= current.CostPerHour {
+			continue
+		}
+		if !found || spec.CostPerHour < target.CostPerHour {
+			target = spec
+			found = true
+		}
+	}
+	return target, found
+}
+
+// RequiredResource 현재 자원량, 사용률 기준 목표 사용률을 만족하는 자원량 (정수 단위 올림, 최소 1)
+func RequiredResource(current float64, utilization float64, target float64) float64 {
+	if target <= 0 {
+		return current
+	}
+	return math.Max(math.Ceil(current*utilization/target), 1)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// toFixed 소수점 자리수 반올림
+func toFixed(num float64, precision int) float64 {
+	output := math.Pow(10, float64(precision))
+	return math.Round(num*output) / output
+}
@@ -0,0 +1,167 @@
+package mcis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/rightsize"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/servicemon"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// 스펙 추천 결과
+const (
+	RightsizingOptimal          = rightsize.Optimal
+	RightsizingOverProvisioned  = rightsize.OverProvisioned
+	RightsizingUnderProvisioned = rightsize.UnderProvisioned
+	RightsizingInsufficientData = rightsize.InsufficientData
+	RightsizingUnknownSpec      = rightsize.UnknownSpec
+)
+
+// VMRightsizing VM 사용률, 스펙 추천 결과
+type VMRightsizing = rightsize.VMRightsizing
+
+var windowRegexp = regexp.MustCompile(`^[1-9][0-9]*(m|h|d|w)$`)
+
+// MCISRightsizing MCIS 스펙 추천 결과, 월 예상 절감액
+type MCISRightsizing struct {
+	NsId                   string          `json:"ns_id"`
+	McisId                 string          `json:"mcis_id"`
+	Window                 string          `json:"window"`
+	CpuPercentile          int             `json:"cpu_percentile"`
+	VmCnt                  int             `json:"vm_cnt"`
+	OverProvisionedCnt     int             `json:"over_provisioned_cnt"`
+	UnderProvisionedCnt    int             `json:"under_provisioned_cnt"`
+	MonthlyCost            float64         `json:"monthly_cost"`
+	RecommendedMonthlyCost float64         `json:"recommended_monthly_cost"`
+	MonthlySavings         float64         `json:"monthly_savings"`
+	VmList                 []VMRightsizing `json:"vm_list"`
+	Time                   string          `json:"time"`
+}
+
+// GetMCISRightsizing MCIS VM 별 사용률 기반 스펙 추천 (분석 기간 window 미입력 시 설정 값 적용)
+//   - Tumblebug VM 스펙(vCPU, 메모리, 시간당 비용)과 저장된 CPU, 메모리 사용률을 결합해 과다/부족 할당 VM 을 판단합니다.
+//   - 추천 스펙은 동일 커넥션의 스펙 중 목표 사용률을 만족하는 가장 저렴한 스펙입니다.
+func GetMCISRightsizing(nsId string, mcisId string, auth string, window string) (*MCISRightsizing, int, error) {
+	rightsizingConfig := config.GetInstance().Rightsizing
+	if window == "" {
+		window = rightsizingConfig.Window
+	}
+	if !windowRegexp.MatchString(window) {
+		return nil, http.StatusBadRequest, errors.New(fmt.Sprintf("invalid window %s", window))
+	}
+	percentile := rightsizingConfig.CpuPercentile
+	if percentile <= 0 || percentile > 100 {
+		percentile = 95
+	}
+
+	agentList, err := listMCISAgent(nsId, mcisId)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if len(agentList) == 0 {
+		return nil, http.StatusNotFound, errors.New(fmt.Sprintf("not found agent, nsId=%s, mcisId=%s", nsId, mcisId))
+	}
+
+	// PUSH, PULL 에이전트 모두 집계 메트릭은 기본 DB 에 저장됩니다. (PULL DB 는 원본 수집 데이터 단기 보관용)
+	utilizationMap := map[string]*rightsize.Utilization{}
+	if err := readMCISUtilization(v1.DefaultDatabase, nsId, mcisId, window, percentile, utilizationMap); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	vmSpecMap := getMCISVMSpec(context.Background(), nsId, mcisId, auth)
+	specCatalog := listSpecCatalog(nsId, auth)
+
+	result := MCISRightsizing{
+		NsId:          nsId,
+		McisId:        mcisId,
+		Window:        window,
+		CpuPercentile: percentile,
+		VmCnt:         len(agentList),
+		VmList:        make([]VMRightsizing, len(agentList)),
+		Time:          time.Now().UTC().Format(time.RFC3339),
+	}
+	for idx, agent := range agentList {
+		vmRightsizing := VMRightsizing{VmId: agent.VmId, CspType: agent.CspType}
+		spec, hasSpec := vmSpecMap[agent.VmId]
+		rightsize.Recommend(&vmRightsizing, spec, hasSpec, utilizationMap[agent.VmId], specCatalog, rightsizingConfig)
+
+		switch vmRightsizing.Recommendation {
+		case RightsizingOverProvisioned:
+			result.OverProvisionedCnt++
+		case RightsizingUnderProvisioned:
+			result.UnderProvisionedCnt++
+		}
+		result.MonthlyCost += vmRightsizing.MonthlyCost
+		result.RecommendedMonthlyCost += vmRightsizing.TargetMonthlyCost
+		result.VmList[idx] = vmRightsizing
+	}
+	result.MonthlyCost = util.ToFixed(result.MonthlyCost, 2)
+	result.RecommendedMonthlyCost = util.ToFixed(result.RecommendedMonthlyCost, 2)
+	result.MonthlySavings = util.ToFixed(result.MonthlyCost-result.RecommendedMonthlyCost, 2)
+	return &result, http.StatusOK, nil
+}
+
+// readMCISUtilization VM 별 CPU 백분위 사용률, 최대 메모리 사용률 조회 후 utilizationMap(vmId 기준)에 반영
+func readMCISUtilization(database string, nsId string, mcisId string, window string, percentile int, utilizationMap map[string]*rightsize.Utilization) error {
+	statQuery := []struct {
+		measurement string
+		selectField string
+		isCpu       bool
+	}{
+		{measurement: "cpu", selectField: fmt.Sprintf("percentile(\"cpu_utilization\", %d) AS \"cpu_utilization\"", percentile), isCpu: true},
+		{measurement: "mem", selectField: "max(\"mem_utilization\") AS \"mem_utilization\""},
+	}
+	for _, query := range statQuery {
+		rows, err := v1.GetInstance().ReadMCISStatMetric(database, query.measurement, nsId, mcisId, window, query.selectField)
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to get %s utilization, error=%s", query.measurement, err))
+		}
+		for _, row := range rows {
+			values, _ := getLatestRowValues(row)
+			if values == nil {
+				continue
+			}
+			var value float64
+			var ok bool
+			if query.isCpu {
+				value, ok = servicemon.ToFloat(values["cpu_utilization"])
+			} else {
+				value, ok = servicemon.ToFloat(values["mem_utilization"])
+			}
+			if !ok {
+				continue
+			}
+			vmId := row.Tags[types.VmId]
+			utilization, exist := utilizationMap[vmId]
+			if !exist {
+				utilization = &rightsize.Utilization{}
+				utilizationMap[vmId] = utilization
+			}
+			if query.isCpu {
+				utilization.Cpu = &value
+			} else {
+				utilization.Memory = &value
+			}
+		}
+	}
+	return nil
+}
+
+// listSpecCatalog Tumblebug 네임스페이스 스펙 목록 조회 (조회 실패 시 nil 반환)
+func listSpecCatalog(nsId string, auth string) []tumblebug.Spec {
+	specList, err := tumblebug.GetInstance().WithAuth(auth).ListSpec(nsId)
+	if err != nil {
+		util.GetLogger().Warn(fmt.Sprintf("failed to get spec list from tumblebug, nsId=%s, error=%s", nsId, err))
+		return nil
+	}
+	return specList
+}
@@ -0,0 +1,339 @@
+package mcis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb1-client/models"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/servicemon"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	StoredSource   = servicemon.StoredSource
+	RealtimeSource = servicemon.RealtimeSource
+
+	VMHealthy   = servicemon.VMHealthy
+	VMUnhealthy = servicemon.VMUnhealthy
+	VMNoData    = servicemon.VMNoData
+
+	// 저장 메트릭 조회 범위 (최신 값 기준)
+	serviceMetricDuration = "5m"
+	// 실시간 조회 기본 제한 시간 (s)
+	DefaultRealtimeTimeout = 10
+	// 실시간 조회 시 누적 카운터(diskio, network) 초당 변화량 계산 간격
+	realtimeSampleInterval = time.Second
+)
+
+type (
+	VMServiceMetric    = servicemon.VMServiceMetric
+	VMServiceMonInfo   = servicemon.VMServiceMonInfo
+	MCISServiceSummary = servicemon.MCISServiceSummary
+	MCISServiceMonInfo = servicemon.MCISServiceMonInfo
+)
+
+// mcisLatestMetricQuery VM 최신 메트릭 조회 대상 (측정 항목, 필드, 초당 변화량 여부)
+var mcisLatestMetricQuery = []struct {
+	measurement string
+	perSec      bool
+	fields      []string
+}{
+	{measurement: "cpu", fields: []string{"cpu_utilization"}},
+	{measurement: "mem", fields: []string{"mem_total", "mem_used", "mem_utilization"}},
+	{measurement: "disk", fields: []string{"disk_total", "disk_used", "disk_utilization"}},
+	{measurement: "diskio", perSec: true, fields: []string{"kb_read", "kb_written"}},
+	{measurement: "net", perSec: true, fields: []string{"bytes_in", "bytes_out"}},
+}
+
+// GetMCISMonInfo MCIS 서비스 모니터링 정보 조회 (저장된 최신 메트릭 기준)
+//   - auth 는 Tumblebug VM 스펙(vCPU) 조회 인증 정보이며, 스펙 조회 실패 시 vCPU 정보 없이 조회합니다.
+func GetMCISMonInfo(nsId string, mcisId string, auth string) (*MCISServiceMonInfo, int, error) {
+	agentList, err := listMCISAgent(nsId, mcisId)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if len(agentList) == 0 {
+		return nil, http.StatusNotFound, errors.New(fmt.Sprintf("not found agent, nsId=%s, mcisId=%s", nsId, mcisId))
+	}
+
+	// PUSH, PULL 에이전트 모두 집계 메트릭은 기본 DB 에 저장됩니다. (PULL DB 는 원본 수집 데이터 단기 보관용)
+	metricMap := map[string]*VMServiceMetric{}
+	if err := readMCISLatestMetric(v1.DefaultDatabase, nsId, mcisId, metricMap); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	vmList := make([]VMServiceMonInfo, len(agentList))
+	for idx, agent := range agentList {
+		vmList[idx] = newVMServiceMonInfo(agent)
+		vmList[idx].Metric = metricMap[agent.VmId]
+	}
+	return servicemon.MakeMCISServiceMonInfo(nsId, mcisId, StoredSource, vmList, getMCISVMVCpu(context.Background(), nsId, mcisId, auth)), http.StatusOK, nil
+}
+
+// GetMCISRealtimeMonInfo MCIS 서비스 실시간 모니터링 정보 조회
+//   - 활성화 상태의 에이전트에 병렬로 온디멘드 메트릭을 조회하며, timeout(s) 내에 응답하지 않은 VM 은 에러로 표시합니다.
+//   - timeout(s) 내에 VM 스펙 조회가 끝나지 않은 경우 vCPU 정보 없이 응답합니다.
+func GetMCISRealtimeMonInfo(nsId string, mcisId string, auth string, timeout int) (*MCISServiceMonInfo, int, error) {
+	if timeout <= 0 {
+		timeout = DefaultRealtimeTimeout
+	}
+	agentList, err := listMCISAgent(nsId, mcisId)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if len(agentList) == 0 {
+		return nil, http.StatusNotFound, errors.New(fmt.Sprintf("not found agent, nsId=%s, mcisId=%s", nsId, mcisId))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	// VM 스펙 조회도 에이전트 조회와 병렬 처리
+	vCpuResult := make(chan map[string]int, 1)
+	go func() {
+		vCpuResult <- getMCISVMVCpu(ctx, nsId, mcisId, auth)
+	}()
+
+	vmList := make([]VMServiceMonInfo, len(agentList))
+	var wg sync.WaitGroup
+	for idx, agent := range agentList {
+		vmList[idx] = newVMServiceMonInfo(agent)
+		if agent.AgentState != string(common.Enable) {
+			vmList[idx].Error = "agent is disabled"
+			continue
+		}
+		if agent.PublicIp == "" {
+			vmList[idx].Error = "agent public ip is empty"
+			continue
+		}
+		wg.Add(1)
+		go func(vmInfo *VMServiceMonInfo) {
+			defer wg.Done()
+			metric, err := getVMRealtimeMetric(ctx, vmInfo.PublicIp)
+			if err != nil {
+				vmInfo.Error = err.Error()
+				return
+			}
+			vmInfo.Metric = metric
+		}(&vmList[idx])
+	}
+	wg.Wait()
+
+	return servicemon.MakeMCISServiceMonInfo(nsId, mcisId, RealtimeSource, vmList, servicemon.WaitVCpu(ctx, vCpuResult)), http.StatusOK, nil
+}
+
+func listMCISAgent(nsId string, mcisId string) ([]common.AgentInfo, error) {
+	agentList, err := common.ListAgent()
+	if err != nil {
+		return nil, err
+	}
+	var mcisAgentList []common.AgentInfo
+	for _, agent := range agentList {
+		if util.CheckMCISType(agent.ServiceType) && agent.NsId == nsId && agent.McisId == mcisId {
+			mcisAgentList = append(mcisAgentList, agent)
+		}
+	}
+	sort.Slice(mcisAgentList, func(i, j int) bool {
+		return mcisAgentList[i].VmId < mcisAgentList[j].VmId
+	})
+	return mcisAgentList, nil
+}
+
+func newVMServiceMonInfo(agent common.AgentInfo) VMServiceMonInfo {
+	return VMServiceMonInfo{
+		VmId:        agent.VmId,
+		CspType:     agent.CspType,
+		PublicIp:    agent.PublicIp,
+		AgentType:   agent.AgentType,
+		AgentState:  agent.AgentState,
+		AgentHealth: agent.AgentHealth,
+		Liveness:    agent.Liveness,
+		LastSeen:    agent.LastSeen,
+	}
+}
+
+// readMCISLatestMetric 측정 항목 별 VM 최신 메트릭 조회 후 metricMap(vmId 기준)에 반영
+func readMCISLatestMetric(database string, nsId string, mcisId string, metricMap map[string]*VMServiceMetric) error {
+	for _, query := range mcisLatestMetricQuery {
+		rows, err := v1.GetInstance().ReadMCISLatestMetric(database, query.measurement, nsId, mcisId, serviceMetricDuration, query.perSec, query.fields...)
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to get latest %s metric, error=%s", query.measurement, err))
+		}
+		for _, row := range rows {
+			vmId := row.Tags[types.VmId]
+			values, valueTime := getLatestRowValues(row)
+			if values == nil {
+				continue
+			}
+			metric, ok := metricMap[vmId]
+			if !ok {
+				metric = &VMServiceMetric{}
+				metricMap[vmId] = metric
+			}
+			servicemon.SetMetricValues(metric, values)
+			if query.measurement == "cpu" || metric.Time == "" {
+				metric.Time = valueTime
+			}
+		}
+	}
+	return nil
+}
+
+// getLatestRowValues 시리즈의 마지막 값 조회 (컬럼 이름 기준 맵, 시각)
+func getLatestRowValues(row models.Row) (map[string]interface{}, string) {
+	if len(row.Values) == 0 {
+		return nil, ""
+	}
+	lastValue := row.Values[len(row.Values)-1]
+	values := make(map[string]interface{}, len(row.Columns))
+	var valueTime string
+	for idx, column := range row.Columns {
+		if idx >= len(lastValue) {
+			break
+		}
+		if column == "time" {
+			valueTime = fmt.Sprint(lastValue[idx])
+			continue
+		}
+		values[column] = lastValue[idx]
+	}
+	return values, valueTime
+}
+
+// getVMRealtimeMetric 에이전트 온디멘드 메트릭 조회
+//   - diskio, network 누적 카운터는 realtimeSampleInterval 간격으로 두 번 조회해 초당 변화량을 계산합니다.
+func getVMRealtimeMetric(ctx context.Context, publicIP string) (*VMServiceMetric, error) {
+	metric := VMServiceMetric{}
+	counterMetrics := []types.Metric{types.DiskIO, types.Network}
+
+	firstCounters := map[types.Metric]map[string]interface{}{}
+	for _, counterMetric := range counterMetrics {
+		values, err := getVMRealtimeMetricValues(ctx, counterMetric, publicIP)
+		if err != nil {
+			return nil, err
+		}
+		firstCounters[counterMetric] = values
+	}
+	firstAt := time.Now()
+
+	for _, gaugeMetric := range []types.Metric{types.Cpu, types.Memory, types.Disk} {
+		values, err := getVMRealtimeMetricValues(ctx, gaugeMetric, publicIP)
+		if err != nil {
+			return nil, err
+		}
+		servicemon.SetMetricValues(&metric, values)
+	}
+
+	if wait := realtimeSampleInterval - time.Since(firstAt); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New(fmt.Sprintf("failed to get realtime metric, error=%s", ctx.Err()))
+		case <-time.After(wait):
+		}
+	}
+	elapsed := time.Since(firstAt).Seconds()
+	for _, counterMetric := range counterMetrics {
+		values, err := getVMRealtimeMetricValues(ctx, counterMetric, publicIP)
+		if err != nil {
+			return nil, err
+		}
+		perSecValues := map[string]interface{}{}
+		for key, val := range values {
+			lastVal, ok := servicemon.ToFloat(val)
+			if !ok {
+				continue
+			}
+			firstVal, ok := servicemon.ToFloat(firstCounters[counterMetric][key])
+			if !ok || lastVal < firstVal {
+				continue
+			}
+			perSecValues[key] = (lastVal - firstVal) / elapsed
+		}
+		servicemon.SetMetricValues(&metric, perSecValues)
+	}
+	metric.Time = time.Now().UTC().Format(time.RFC3339)
+	return &metric, nil
+}
+
+func getVMRealtimeMetricValues(ctx context.Context, metric types.Metric, publicIP string) (map[string]interface{}, error) {
+	agentUrl := fmt.Sprintf("http://%s:%d/cb-dragonfly/metric/%s", publicIP, types.AgentPort, metric.ToAgentMetricKey())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, agentUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to get %s metric from agent, error=%s", metric, err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(fmt.Sprintf("failed to get %s metric from agent, status=%d", metric, resp.StatusCode))
+	}
+
+	var metricData = map[string]collector.TelegrafMetric{}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &metricData); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to convert %s metric, error=%s", metric, err))
+	}
+	resultMetric, err := collector.ConvertMonMetric(metric, metricData[metric.ToAgentMetricKey()])
+	if err != nil {
+		return nil, err
+	}
+	values, _ := resultMetric["values"].(map[string]interface{})
+	return values, nil
+}
+
+// getMCISVMSpec Tumblebug MCIS VM 별 스펙 조회 (vmId 기준, 조회 실패 시 nil 반환)
+//   - ctx 가 종료되면 남은 스펙 조회를 중단하고 조회된 VM 스펙만 반환합니다.
+func getMCISVMSpec(ctx context.Context, nsId string, mcisId string, auth string) map[string]tumblebug.Spec {
+	tbClient := tumblebug.GetInstance().WithAuth(auth)
+	vmList, err := tbClient.ListVM(nsId, mcisId)
+	if err != nil {
+		util.GetLogger().Warn(fmt.Sprintf("failed to get mcis vm spec from tumblebug, nsId=%s, mcisId=%s, error=%s", nsId, mcisId, err))
+		return nil
+	}
+
+	vmSpecMap := map[string]tumblebug.Spec{}
+	for _, vm := range vmList {
+		if ctx.Err() != nil {
+			util.GetLogger().Warn(fmt.Sprintf("stop getting mcis vm spec from tumblebug, nsId=%s, mcisId=%s, error=%s", nsId, mcisId, ctx.Err()))
+			break
+		}
+		spec, err := tbClient.GetSpec(nsId, vm.SpecId)
+		if err != nil {
+			util.GetLogger().Warn(fmt.Sprintf("failed to get vm spec from tumblebug, specId=%s, error=%s", vm.SpecId, err))
+			continue
+		}
+		vmSpecMap[vm.Id] = *spec
+	}
+	return vmSpecMap
+}
+
+// getMCISVMVCpu Tumblebug MCIS VM 별 vCPU 수 조회 (vmId 기준, 조회 실패 시 nil 반환)
+func getMCISVMVCpu(ctx context.Context, nsId string, mcisId string, auth string) map[string]int {
+	vmSpecMap := getMCISVMSpec(ctx, nsId, mcisId, auth)
+	if vmSpecMap == nil {
+		return nil
+	}
+	vmVCpuMap := make(map[string]int, len(vmSpecMap))
+	for vmId, spec := range vmSpecMap {
+		vmVCpuMap[vmId] = spec.NumvCPU
+	}
+	return vmVCpuMap
+}
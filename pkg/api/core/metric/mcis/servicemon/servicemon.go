@@ -0,0 +1,231 @@
+package servicemon
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common/liveness"
+)
+
+const (
+	StoredSource   = "stored"
+	RealtimeSource = "realtime"
+
+	VMHealthy   = "healthy"
+	VMUnhealthy = "unhealthy"
+	VMNoData    = "no_data"
+)
+
+// 에이전트 설치 상태, 헬스 상태 값 (agent/common 의 Disable, Unhealthy)
+const (
+	agentDisable   = "disable"
+	agentUnhealthy = "unhealthy"
+)
+
+// VMServiceMetric VM 최신 메트릭
+//   - disk_read_bps, disk_write_bps, net_in_bps, net_out_bps 는 초당 변화량 (byte/s) 입니다.
+type VMServiceMetric struct {
+	CpuUtilization  float64 `json:"cpu_utilization"`
+	MemTotal        float64 `json:"mem_total"`
+	MemUsed         float64 `json:"mem_used"`
+	MemUtilization  float64 `json:"mem_utilization"`
+	DiskTotal       float64 `json:"disk_total"`
+	DiskUsed        float64 `json:"disk_used"`
+	DiskUtilization float64 `json:"disk_utilization"`
+	DiskReadBps     float64 `json:"disk_read_bps"`
+	DiskWriteBps    float64 `json:"disk_write_bps"`
+	NetInBps        float64 `json:"net_in_bps"`
+	NetOutBps       float64 `json:"net_out_bps"`
+	Time            string  `json:"time"`
+}
+
+// VMServiceMonInfo MCIS VM 별 에이전트 상태, 최신 메트릭
+type VMServiceMonInfo struct {
+	VmId        string           `json:"vm_id"`
+	CspType     string           `json:"csp_type"`
+	PublicIp    string           `json:"public_ip"`
+	AgentType   string           `json:"agent_type"`
+	AgentState  string           `json:"agent_state"`
+	AgentHealth string           `json:"agent_health"`
+	Liveness    string           `json:"liveness"`
+	LastSeen    int64            `json:"last_seen"`
+	VCpu        int              `json:"vcpu"`
+	Status      string           `json:"status"`
+	Metric      *VMServiceMetric `json:"metric,omitempty"`
+	Error       string           `json:"error,omitempty"`
+}
+
+// MCISServiceSummary MCIS 전체 자원 사용량 합계
+//   - vcpu_used 는 VM 별 CPU 사용률과 vCPU 수의 곱의 합계이며, 스펙 정보가 없는 VM 은 제외합니다.
+//   - cpu_utilization 은 메트릭이 있는 VM 의 평균 CPU 사용률입니다.
+type MCISServiceSummary struct {
+	VCpuProvisioned int     `json:"vcpu_provisioned"`
+	VCpuUsed        float64 `json:"vcpu_used"`
+	CpuUtilization  float64 `json:"cpu_utilization"`
+	MemTotal        float64 `json:"mem_total"`
+	MemUsed         float64 `json:"mem_used"`
+	MemUtilization  float64 `json:"mem_utilization"`
+	DiskTotal       float64 `json:"disk_total"`
+	DiskUsed        float64 `json:"disk_used"`
+	DiskUtilization float64 `json:"disk_utilization"`
+	DiskReadBps     float64 `json:"disk_read_bps"`
+	DiskWriteBps    float64 `json:"disk_write_bps"`
+	NetInBps        float64 `json:"net_in_bps"`
+	NetOutBps       float64 `json:"net_out_bps"`
+}
+
+// MCISServiceMonInfo MCIS 서비스 모니터링 정보
+type MCISServiceMonInfo struct {
+	NsId         string             `json:"ns_id"`
+	McisId       string             `json:"mcis_id"`
+	Source       string             `json:"source"`
+	VmCnt        int                `json:"vm_cnt"`
+	HealthyVmCnt int                `json:"healthy_vm_cnt"`
+	Summary      MCISServiceSummary `json:"summary"`
+	VmList       []VMServiceMonInfo `json:"vm_list"`
+	Time         string             `json:"time"`
+}
+
+// SetMetricValues 메트릭 필드 값 설정 (저장 메트릭, 온디멘드 메트릭 공통 필드 이름 기준)
+func SetMetricValues(metric *VMServiceMetric, values map[string]interface{}) {
+	for key, val := range values {
+		floatVal, ok := ToFloat(val)
+		if !ok {
+			continue
+		}
+		switch key {
+		case "cpu_utilization":
+			metric.CpuUtilization = floatVal
+		case "mem_total":
+			metric.MemTotal = floatVal
+		case "mem_used":
+			metric.MemUsed = floatVal
+		case "mem_utilization":
+			metric.MemUtilization = floatVal
+		case "disk_total":
+			metric.DiskTotal = floatVal
+		case "disk_used":
+			metric.DiskUsed = floatVal
+		case "disk_utilization":
+			metric.DiskUtilization = floatVal
+		case "kb_read":
+			metric.DiskReadBps = floatVal
+		case "kb_written":
+			metric.DiskWriteBps = floatVal
+		case "bytes_in":
+			metric.NetInBps = floatVal
+		case "bytes_out":
+			metric.NetOutBps = floatVal
+		}
+	}
+}
+
+// ToFloat 메트릭 값 실수 변환 (숫자 타입이 아닐 경우 false 반환)
+func ToFloat(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		floatVal, err := v.Float64()
+		return floatVal, err == nil
+	}
+	return 0, false
+}
+
+// WaitVCpu VM 별 vCPU 수 조회 결과 대기
+//   - ctx 가 먼저 종료되면 조회 결과를 기다리지 않고 nil 을 반환하며, 이 경우 vCPU 정보 없이 응답합니다.
+//   - 조회 고루틴이 대기 없이 종료될 수 있도록 result 는 버퍼 크기 1 이상의 채널을 사용해야 합니다.
+func WaitVCpu(ctx context.Context, result <-chan map[string]int) map[string]int {
+	select {
+	case vmVCpuMap := <-result:
+		return vmVCpuMap
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// MakeMCISServiceMonInfo VM 별 상태 판정, MCIS 전체 합계 계산
+//   - vmVCpuMap 은 VM 별 vCPU 수(vmId 기준)이며, 스펙 정보가 없는 VM 은 vCPU 합계에서 제외합니다.
+func MakeMCISServiceMonInfo(nsId string, mcisId string, source string, vmList []VMServiceMonInfo, vmVCpuMap map[string]int) *MCISServiceMonInfo {
+	monInfo := MCISServiceMonInfo{
+		NsId:   nsId,
+		McisId: mcisId,
+		Source: source,
+		VmCnt:  len(vmList),
+		VmList: vmList,
+		Time:   time.Now().UTC().Format(time.RFC3339),
+	}
+
+	summary := &monInfo.Summary
+	metricCnt := 0
+	for idx := range vmList {
+		vmInfo := &vmList[idx]
+		if vCpu, ok := vmVCpuMap[vmInfo.VmId]; ok {
+			vmInfo.VCpu = vCpu
+			summary.VCpuProvisioned += vCpu
+		}
+		vmInfo.Status = GetVMServiceStatus(*vmInfo, source)
+		if vmInfo.Status == VMHealthy {
+			monInfo.HealthyVmCnt++
+		}
+
+		metric := vmInfo.Metric
+		if metric == nil {
+			continue
+		}
+		metricCnt++
+		summary.VCpuUsed += metric.CpuUtilization / 100 * float64(vmInfo.VCpu)
+		summary.CpuUtilization += metric.CpuUtilization
+		summary.MemTotal += metric.MemTotal
+		summary.MemUsed += metric.MemUsed
+		summary.DiskTotal += metric.DiskTotal
+		summary.DiskUsed += metric.DiskUsed
+		summary.DiskReadBps += metric.DiskReadBps
+		summary.DiskWriteBps += metric.DiskWriteBps
+		summary.NetInBps += metric.NetInBps
+		summary.NetOutBps += metric.NetOutBps
+	}
+	if metricCnt > 0 {
+		summary.CpuUtilization = toFixed(summary.CpuUtilization/float64(metricCnt), 2)
+	}
+	if summary.MemTotal > 0 {
+		summary.MemUtilization = toFixed(summary.MemUsed/summary.MemTotal*100, 2)
+	}
+	if summary.DiskTotal > 0 {
+		summary.DiskUtilization = toFixed(summary.DiskUsed/summary.DiskTotal*100, 2)
+	}
+	summary.VCpuUsed = toFixed(summary.VCpuUsed, 2)
+	return &monInfo
+}
+
+// GetVMServiceStatus VM 상태 판정
+//   - no_data: 최신 메트릭 없음 (실시간 조회의 경우 에이전트 응답 실패 포함)
+//   - unhealthy: 에이전트 비활성화, 에이전트 헬스 비정상, 하트비트 기준 비정상 (저장 메트릭 조회 시)
+func GetVMServiceStatus(vmInfo VMServiceMonInfo, source string) string {
+	if vmInfo.Metric == nil {
+		return VMNoData
+	}
+	if vmInfo.AgentState == agentDisable || vmInfo.AgentHealth == agentUnhealthy {
+		return VMUnhealthy
+	}
+	if source == StoredSource && vmInfo.Liveness != "" && vmInfo.Liveness != string(liveness.Healthy) {
+		return VMUnhealthy
+	}
+	return VMHealthy
+}
+
+// toFixed 소수점 자리수 반올림
+func toFixed(num float64, precision int) float64 {
+	output := math.Pow(10, float64(precision))
+	return math.Round(num*output) / output
+}
@@ -0,0 +1,33 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/procname"
+)
+
+func TestNormalizeProcessName(t *testing.T) {
+	cases := []struct {
+		name    string
+		command string
+		want    string
+	}{
+		{name: "plain", command: "nginx", want: "nginx"},
+		{name: "absolute path with args", command: "/usr/bin/python3 /opt/app/main.py --port 8080", want: "python3"},
+		{name: "relative path", command: "./bin/server -c conf.yaml", want: "server"},
+		{name: "kernel thread", command: "[kworker/0:1-events]", want: "kworker"},
+		{name: "kernel thread without suffix", command: "[kthreadd]", want: "kthreadd"},
+		{name: "retitled process", command: "sshd: root@pts/0", want: "sshd"},
+		{name: "surrounding spaces", command: "  java -jar app.jar ", want: "java"},
+		{name: "empty", command: "", want: procname.Unknown},
+		{name: "empty kernel thread", command: "[]", want: procname.Unknown},
+		{name: "root path", command: "/", want: procname.Unknown},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := procname.Normalize(tc.command); got != tc.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tc.command, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/rightsize"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+)
+
+var rightsizingConfig = config.Rightsizing{
+	CpuHighThreshold: 80,
+	CpuLowThreshold:  20,
+	MemHighThreshold: 90,
+	MemLowThreshold:  30,
+	TargetCpu:        60,
+	TargetMem:        70,
+	HoursPerMonth:    730,
+}
+
+var specCatalog = []tumblebug.Spec{
+	{Id: "aws-2-8", ConnectionName: "aws", NumvCPU: 2, MemGiB: 8, CostPerHour: 0.1},
+	{Id: "aws-2-16", ConnectionName: "aws", NumvCPU: 2, MemGiB: 16, CostPerHour: 0.15},
+	{Id: "aws-4-8", ConnectionName: "aws", NumvCPU: 4, MemGiB: 8, CostPerHour: 0.2},
+	{Id: "aws-4-16", ConnectionName: "aws", NumvCPU: 4, MemGiB: 16, CostPerHour: 0.3},
+	{Id: "aws-8-32", ConnectionName: "aws", NumvCPU: 8, MemGiB: 32, CostPerHour: 0.4},
+	{Id: "gcp-2-16", ConnectionName: "gcp", NumvCPU: 2, MemGiB: 16, CostPerHour: 0.05},
+}
+
+func getSpec(specId string) tumblebug.Spec {
+	for _, spec := range specCatalog {
+		if spec.Id == specId {
+			return spec
+		}
+	}
+	return tumblebug.Spec{}
+}
+
+func newUtilization(cpu float64, memory float64) *rightsize.Utilization {
+	return &rightsize.Utilization{Cpu: &cpu, Memory: &memory}
+}
+
+func TestRecommend(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		specId                 string
+		hasSpec                bool
+		utilization            *rightsize.Utilization
+		catalog                []tumblebug.Spec
+		expectedRecommendation string
+		expectedTargetSpecId   string
+		expectedTargetVCpu     int
+		expectedTargetMemGiB   float64
+		expectedSavings        float64
+		expectedNoCandidate    bool
+	}{
+		{
+			name:                   "over provisioned",
+			specId:                 "aws-8-32",
+			hasSpec:                true,
+			utilization:            newUtilization(10, 20),
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.OverProvisioned,
+			expectedTargetSpecId:   "aws-2-16",
+			expectedTargetVCpu:     2,
+			expectedTargetMemGiB:   10,
+			expectedSavings:        182.5,
+		},
+		{
+			name:                   "under provisioned",
+			specId:                 "aws-2-8",
+			hasSpec:                true,
+			utilization:            newUtilization(90, 50),
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.UnderProvisioned,
+			expectedTargetSpecId:   "aws-4-8",
+			expectedTargetVCpu:     3,
+			expectedTargetMemGiB:   8,
+			expectedSavings:        -73,
+		},
+		{
+			name:                   "over provisioned without cheaper candidate",
+			specId:                 "aws-8-32",
+			hasSpec:                true,
+			utilization:            newUtilization(10, 20),
+			catalog:                []tumblebug.Spec{getSpec("aws-8-32"), {Id: "aws-16-64", ConnectionName: "aws", NumvCPU: 16, MemGiB: 64, CostPerHour: 0.8}, getSpec("gcp-2-16")},
+			expectedRecommendation: rightsize.OverProvisioned,
+			expectedTargetSpecId:   "aws-8-32",
+			expectedTargetVCpu:     2,
+			expectedTargetMemGiB:   10,
+			expectedNoCandidate:    true,
+		},
+		{
+			name:                   "under provisioned without catalog",
+			specId:                 "aws-2-8",
+			hasSpec:                true,
+			utilization:            newUtilization(95, 95),
+			catalog:                nil,
+			expectedRecommendation: rightsize.UnderProvisioned,
+			expectedTargetSpecId:   "aws-2-8",
+			expectedTargetVCpu:     4,
+			expectedTargetMemGiB:   11,
+			expectedNoCandidate:    true,
+		},
+		{
+			name:                   "cpu at high threshold",
+			specId:                 "aws-2-8",
+			hasSpec:                true,
+			utilization:            newUtilization(80, 50),
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.UnderProvisioned,
+			expectedTargetSpecId:   "aws-4-8",
+			expectedTargetVCpu:     3,
+			expectedTargetMemGiB:   8,
+			expectedSavings:        -73,
+		},
+		{
+			name:                   "memory at high threshold",
+			specId:                 "aws-4-8",
+			hasSpec:                true,
+			utilization:            newUtilization(50, 90),
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.UnderProvisioned,
+			expectedTargetSpecId:   "aws-4-16",
+			expectedTargetVCpu:     4,
+			expectedTargetMemGiB:   11,
+			expectedSavings:        -73,
+		},
+		{
+			name:                   "at low threshold",
+			specId:                 "aws-4-16",
+			hasSpec:                true,
+			utilization:            newUtilization(20, 30),
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.Optimal,
+			expectedTargetSpecId:   "aws-4-16",
+			expectedTargetVCpu:     4,
+			expectedTargetMemGiB:   16,
+		},
+		{
+			name:                   "below low threshold with minimum resource",
+			specId:                 "tiny",
+			hasSpec:                true,
+			utilization:            newUtilization(10, 10),
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.Optimal,
+			expectedTargetSpecId:   "tiny",
+			expectedTargetVCpu:     1,
+			expectedTargetMemGiB:   1,
+		},
+		{
+			name:                   "insufficient data",
+			specId:                 "aws-4-16",
+			hasSpec:                true,
+			utilization:            &rightsize.Utilization{},
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.InsufficientData,
+			expectedTargetSpecId:   "aws-4-16",
+			expectedTargetVCpu:     4,
+			expectedTargetMemGiB:   16,
+		},
+		{
+			name:                   "unknown spec",
+			hasSpec:                false,
+			utilization:            newUtilization(10, 10),
+			catalog:                specCatalog,
+			expectedRecommendation: rightsize.UnknownSpec,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := getSpec(tc.specId)
+			if tc.specId == "tiny" {
+				spec = tumblebug.Spec{Id: "tiny", ConnectionName: "aws", NumvCPU: 1, MemGiB: 1, CostPerHour: 0.01}
+			}
+			vm := rightsize.VMRightsizing{VmId: "vm"}
+			rightsize.Recommend(&vm, spec, tc.hasSpec, tc.utilization, tc.catalog, rightsizingConfig)
+
+			if vm.Recommendation != tc.expectedRecommendation {
+				t.Fatalf("expected recommendation %s, got %s (%s)", tc.expectedRecommendation, vm.Recommendation, vm.Reason)
+			}
+			if vm.TargetSpecId != tc.expectedTargetSpecId || vm.TargetVCpu != tc.expectedTargetVCpu || vm.TargetMemGiB != tc.expectedTargetMemGiB {
+				t.Errorf("expected target (%s, %d, %.0f), got (%s, %d, %.0f)", tc.expectedTargetSpecId, tc.expectedTargetVCpu, tc.expectedTargetMemGiB, vm.TargetSpecId, vm.TargetVCpu, vm.TargetMemGiB)
+			}
+			if vm.MonthlySavings != tc.expectedSavings {
+				t.Errorf("expected monthly savings %.2f, got %.2f", tc.expectedSavings, vm.MonthlySavings)
+			}
+			if noCandidate := strings.HasSuffix(vm.Reason, "no matching spec in catalog"); noCandidate != tc.expectedNoCandidate {
+				t.Errorf("expected no candidate %t, got reason %s", tc.expectedNoCandidate, vm.Reason)
+			}
+		})
+	}
+}
+
+func TestFindTargetSpec(t *testing.T) {
+	testCases := []struct {
+		name     string
+		current  string
+		vCpu     int
+		memGiB   float64
+		downsize bool
+		expected string
+	}{
+		{name: "cheapest matching spec", current: "aws-8-32", vCpu: 2, memGiB: 10, downsize: true, expected: "aws-2-16"},
+		{name: "exact resource match", current: "aws-8-32", vCpu: 2, memGiB: 8, downsize: true, expected: "aws-2-8"},
+		{name: "upsize ignores cost", current: "aws-2-8", vCpu: 4, memGiB: 12, downsize: false, expected: "aws-4-16"},
+		{name: "downsize requires cheaper spec", current: "aws-2-8", vCpu: 2, memGiB: 8, downsize: true, expected: ""},
+		{name: "no spec with enough resource", current: "aws-2-8", vCpu: 16, memGiB: 8, downsize: false, expected: ""},
+		{name: "other connection excluded", current: "gcp-2-16", vCpu: 1, memGiB: 1, downsize: true, expected: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target, ok := rightsize.FindTargetSpec(getSpec(tc.current), tc.vCpu, tc.memGiB, tc.downsize, specCatalog)
+			if ok != (tc.expected != "") || target.Id != tc.expected {
+				t.Errorf("expected target %q, got %q (%t)", tc.expected, target.Id, ok)
+			}
+		})
+	}
+}
+
+func TestRequiredResource(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     float64
+		utilization float64
+		target      float64
+		expected    float64
+	}{
+		{name: "scale down", current: 8, utilization: 10, target: 60, expected: 2},
+		{name: "scale up", current: 2, utilization: 90, target: 60, expected: 3},
+		{name: "exact target", current: 4, utilization: 60, target: 60, expected: 4},
+		{name: "minimum one", current: 4, utilization: 0, target: 60, expected: 1},
+		{name: "no target", current: 4, utilization: 90, target: 0, expected: 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if required := rightsize.RequiredResource(tc.current, tc.utilization, tc.target); required != tc.expected {
+				t.Errorf("expected %.0f, got %.0f", tc.expected, required)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis/servicemon"
+)
+
+func TestGetVMServiceStatus(t *testing.T) {
+	metric := &servicemon.VMServiceMetric{CpuUtilization: 10}
+	testCases := []struct {
+		name     string
+		vmInfo   servicemon.VMServiceMonInfo
+		source   string
+		expected string
+	}{
+		{name: "no metric", vmInfo: servicemon.VMServiceMonInfo{AgentState: "enable"}, source: servicemon.StoredSource, expected: servicemon.VMNoData},
+		{name: "healthy", vmInfo: servicemon.VMServiceMonInfo{AgentState: "enable", AgentHealth: "healthy", Liveness: "healthy", Metric: metric}, source: servicemon.StoredSource, expected: servicemon.VMHealthy},
+		{name: "disabled agent", vmInfo: servicemon.VMServiceMonInfo{AgentState: "disable", Metric: metric}, source: servicemon.StoredSource, expected: servicemon.VMUnhealthy},
+		{name: "unhealthy agent", vmInfo: servicemon.VMServiceMonInfo{AgentState: "enable", AgentHealth: "unhealthy", Metric: metric}, source: servicemon.RealtimeSource, expected: servicemon.VMUnhealthy},
+		{name: "stored degraded liveness", vmInfo: servicemon.VMServiceMonInfo{AgentState: "enable", Liveness: "degraded", Metric: metric}, source: servicemon.StoredSource, expected: servicemon.VMUnhealthy},
+		{name: "realtime ignores liveness", vmInfo: servicemon.VMServiceMonInfo{AgentState: "enable", Liveness: "degraded", Metric: metric}, source: servicemon.RealtimeSource, expected: servicemon.VMHealthy},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if status := servicemon.GetVMServiceStatus(tc.vmInfo, tc.source); status != tc.expected {
+				t.Errorf("expected status %s, got %s", tc.expected, status)
+			}
+		})
+	}
+}
+
+func TestMakeMCISServiceMonInfo(t *testing.T) {
+	newVMList := func() []servicemon.VMServiceMonInfo {
+		return []servicemon.VMServiceMonInfo{
+			{VmId: "vm-1", AgentState: "enable", Metric: &servicemon.VMServiceMetric{CpuUtilization: 50, MemTotal: 100, MemUsed: 40, DiskTotal: 200, DiskUsed: 50, NetInBps: 10}},
+			{VmId: "vm-2", AgentState: "enable", Metric: &servicemon.VMServiceMetric{CpuUtilization: 20, MemTotal: 100, MemUsed: 20, DiskTotal: 200, DiskUsed: 150, NetInBps: 5}},
+			{VmId: "vm-3", AgentState: "enable"},
+		}
+	}
+	monInfo := servicemon.MakeMCISServiceMonInfo("ns", "mcis", servicemon.StoredSource, newVMList(), map[string]int{"vm-1": 4, "vm-3": 2})
+
+	if monInfo.VmCnt != 3 || monInfo.HealthyVmCnt != 2 {
+		t.Errorf("expected 3 vms, 2 healthy, got %d, %d", monInfo.VmCnt, monInfo.HealthyVmCnt)
+	}
+	expected := servicemon.MCISServiceSummary{
+		VCpuProvisioned: 6,
+		VCpuUsed:        2,
+		CpuUtilization:  35,
+		MemTotal:        200,
+		MemUsed:         60,
+		MemUtilization:  30,
+		DiskTotal:       400,
+		DiskUsed:        200,
+		DiskUtilization: 50,
+		NetInBps:        15,
+	}
+	if monInfo.Summary != expected {
+		t.Errorf("expected summary %+v, got %+v", expected, monInfo.Summary)
+	}
+	if monInfo.VmList[1].VCpu != 0 || monInfo.VmList[1].Status != servicemon.VMHealthy {
+		t.Errorf("expected vm without spec to keep metric status, got %+v", monInfo.VmList[1])
+	}
+	if monInfo.VmList[2].Status != servicemon.VMNoData {
+		t.Errorf("expected vm without metric to be no_data, got %s", monInfo.VmList[2].Status)
+	}
+
+	// 스펙 조회 실패 시 vCPU 정보 없이 합계 계산
+	monInfo = servicemon.MakeMCISServiceMonInfo("ns", "mcis", servicemon.StoredSource, newVMList(), nil)
+	if monInfo.Summary.VCpuProvisioned != 0 || monInfo.Summary.VCpuUsed != 0 {
+		t.Errorf("expected empty vcpu summary without spec, got %+v", monInfo.Summary)
+	}
+}
+
+func TestSetMetricValues(t *testing.T) {
+	metric := servicemon.VMServiceMetric{}
+	servicemon.SetMetricValues(&metric, map[string]interface{}{
+		"cpu_utilization": 12.5,
+		"mem_total":       int64(1024),
+		"kb_read":         uint64(3),
+		"bytes_out":       float32(4),
+		"disk_used":       "invalid",
+		"unknown":         1.0,
+	})
+	expected := servicemon.VMServiceMetric{CpuUtilization: 12.5, MemTotal: 1024, DiskReadBps: 3, NetOutBps: 4}
+	if metric != expected {
+		t.Errorf("expected metric %+v, got %+v", expected, metric)
+	}
+}
+
+func TestWaitVCpu(t *testing.T) {
+	t.Run("result before timeout", func(t *testing.T) {
+		result := make(chan map[string]int, 1)
+		result <- map[string]int{"vm-1": 2}
+		if vmVCpuMap := servicemon.WaitVCpu(context.Background(), result); vmVCpuMap["vm-1"] != 2 {
+			t.Errorf("expected vcpu result, got %v", vmVCpuMap)
+		}
+	})
+
+	t.Run("timeout before result", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		result := make(chan map[string]int, 1)
+		go func() {
+			time.Sleep(time.Second)
+			result <- map[string]int{"vm-1": 2}
+		}()
+		startAt := time.Now()
+		if vmVCpuMap := servicemon.WaitVCpu(ctx, result); vmVCpuMap != nil {
+			t.Errorf("expected no vcpu result after timeout, got %v", vmVCpuMap)
+		}
+		if elapsed := time.Since(startAt); elapsed > 500*time.Millisecond {
+			t.Errorf("expected wait to return on timeout, took %s", elapsed)
+		}
+	})
+}
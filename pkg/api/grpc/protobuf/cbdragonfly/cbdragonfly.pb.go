@@ -25,6 +25,14 @@ It has these top-level messages:
 	NetworkOnDemandInfoResponse
 	NetworkOnDemandInfo
 	MCISMonInfoResponse
+	MCISServiceMonQryRequest
+	MCISServiceMonInfoResponse
+	MCISServiceSummary
+	VMServiceMonInfo
+	VMServiceMetric
+	MCISRightsizingQryRequest
+	MCISRightsizingResponse
+	VMRightsizing
 	CpuInfoResponse
 	CpuInfo
 	CpuFreqInfoResponse
@@ -39,6 +47,11 @@ It has these top-level messages:
 	MonitoringConfigResponse
 	MonitoringConfigInfo
 	InstallAgentRequest
+	AgentMetadataListRequest
+	AgentMetadataListResponse
+	AgentMetadataInfo
+	AgentMetadataSummary
+	AgentHealthCount
 */
 package cbdragonfly
 
@@ -129,7 +142,7 @@ type VMMonQryRequest struct {
 	PeriodType         string `protobuf:"bytes,4,opt,name=period_type,json=periodType" json:"period_type,omitempty"`
 	StatisticsCriteria string `protobuf:"bytes,5,opt,name=statistics_criteria,json=statisticsCriteria" json:"statistics_criteria,omitempty"`
 	Duration           string `protobuf:"bytes,6,opt,name=duration" json:"duration,omitempty"`
-	ServiceType        string `protobuf:"bytes,7,opt,name=service_type,json=duration" json:"service_type,omitempty"`
+	ServiceType        string `protobuf:"bytes,7,opt,name=service_type" json:"service_type,omitempty"`
 }
 
 func (m *VMMonQryRequest) Reset()                    { *m = VMMonQryRequest{} }
@@ -813,712 +826,1848 @@ func (m *MCISMonInfoResponse) GetSpecId() string {
 	return ""
 }
 
-type CpuInfoResponse struct {
-	Name   string     `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Tags   *Tags      `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
-	Values []*CpuInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+type MCISServiceMonQryRequest struct {
+	NsId          string `protobuf:"bytes,1,opt,name=ns_id" json:"ns_id,omitempty"`
+	McisId        string `protobuf:"bytes,2,opt,name=mcis_id" json:"mcis_id,omitempty"`
+	Authorization string `protobuf:"bytes,3,opt,name=authorization" json:"authorization,omitempty"`
+	Timeout       int32  `protobuf:"varint,4,opt,name=timeout" json:"timeout,omitempty"`
 }
 
-func (m *CpuInfoResponse) Reset()                    { *m = CpuInfoResponse{} }
-func (m *CpuInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*CpuInfoResponse) ProtoMessage()               {}
-func (*CpuInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (m *MCISServiceMonQryRequest) Reset()                    { *m = MCISServiceMonQryRequest{} }
+func (m *MCISServiceMonQryRequest) String() string            { return proto.CompactTextString(m) }
+func (*MCISServiceMonQryRequest) ProtoMessage()               {}
+func (*MCISServiceMonQryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
-func (m *CpuInfoResponse) GetName() string {
+func (m *MCISServiceMonQryRequest) GetNsId() string {
 	if m != nil {
-		return m.Name
+		return m.NsId
 	}
 	return ""
 }
 
-func (m *CpuInfoResponse) GetTags() *Tags {
+func (m *MCISServiceMonQryRequest) GetMcisId() string {
 	if m != nil {
-		return m.Tags
+		return m.McisId
 	}
-	return nil
+	return ""
 }
 
-func (m *CpuInfoResponse) GetValues() []*CpuInfo {
+func (m *MCISServiceMonQryRequest) GetAuthorization() string {
 	if m != nil {
-		return m.Values
+		return m.Authorization
 	}
-	return nil
+	return ""
 }
 
-type CpuInfo struct {
-	CpuUtilization float64 `protobuf:"fixed64,1,opt,name=cpu_utilization" json:"cpu_utilization,omitempty"`
-	CpuSystem      float64 `protobuf:"fixed64,2,opt,name=cpu_system" json:"cpu_system,omitempty"`
-	CpuIdle        float64 `protobuf:"fixed64,3,opt,name=cpu_idle" json:"cpu_idle,omitempty"`
-	CpuIowait      float64 `protobuf:"fixed64,4,opt,name=cpu_iowait" json:"cpu_iowait,omitempty"`
-	CpuHintr       float64 `protobuf:"fixed64,5,opt,name=cpu_hintr" json:"cpu_hintr,omitempty"`
-	CpuSintr       float64 `protobuf:"fixed64,6,opt,name=cpu_sintr" json:"cpu_sintr,omitempty"`
-	CpuUser        float64 `protobuf:"fixed64,7,opt,name=cpu_user" json:"cpu_user,omitempty"`
-	CpuNice        float64 `protobuf:"fixed64,8,opt,name=cpu_nice" json:"cpu_nice,omitempty"`
-	CpuSteal       float64 `protobuf:"fixed64,9,opt,name=cpu_steal" json:"cpu_steal,omitempty"`
-	CpuGuest       float64 `protobuf:"fixed64,10,opt,name=cpu_guest" json:"cpu_guest,omitempty"`
-	CpuGuestNice   float64 `protobuf:"fixed64,11,opt,name=cpu_guest_nice" json:"cpu_guest_nice,omitempty"`
-	Time           string  `protobuf:"bytes,12,opt,name=time" json:"time,omitempty"`
+func (m *MCISServiceMonQryRequest) GetTimeout() int32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
 }
 
-func (m *CpuInfo) Reset()                    { *m = CpuInfo{} }
-func (m *CpuInfo) String() string            { return proto.CompactTextString(m) }
-func (*CpuInfo) ProtoMessage()               {}
-func (*CpuInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+type MCISServiceMonInfoResponse struct {
+	NsId         string              `protobuf:"bytes,1,opt,name=ns_id" json:"ns_id,omitempty"`
+	McisId       string              `protobuf:"bytes,2,opt,name=mcis_id" json:"mcis_id,omitempty"`
+	Source       string              `protobuf:"bytes,3,opt,name=source" json:"source,omitempty"`
+	VmCnt        int32               `protobuf:"varint,4,opt,name=vm_cnt" json:"vm_cnt,omitempty"`
+	HealthyVmCnt int32               `protobuf:"varint,5,opt,name=healthy_vm_cnt" json:"healthy_vm_cnt,omitempty"`
+	Summary      *MCISServiceSummary `protobuf:"bytes,6,opt,name=summary" json:"summary,omitempty"`
+	VmList       []*VMServiceMonInfo `protobuf:"bytes,7,rep,name=vm_list" json:"vm_list,omitempty"`
+	Time         string              `protobuf:"bytes,8,opt,name=time" json:"time,omitempty"`
+}
 
-func (m *CpuInfo) GetCpuUtilization() float64 {
+func (m *MCISServiceMonInfoResponse) Reset()                    { *m = MCISServiceMonInfoResponse{} }
+func (m *MCISServiceMonInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*MCISServiceMonInfoResponse) ProtoMessage()               {}
+func (*MCISServiceMonInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *MCISServiceMonInfoResponse) GetNsId() string {
 	if m != nil {
-		return m.CpuUtilization
+		return m.NsId
 	}
-	return 0
+	return ""
 }
 
-func (m *CpuInfo) GetCpuSystem() float64 {
+func (m *MCISServiceMonInfoResponse) GetMcisId() string {
 	if m != nil {
-		return m.CpuSystem
+		return m.McisId
 	}
-	return 0
+	return ""
 }
 
-func (m *CpuInfo) GetCpuIdle() float64 {
+func (m *MCISServiceMonInfoResponse) GetSource() string {
 	if m != nil {
-		return m.CpuIdle
+		return m.Source
 	}
-	return 0
+	return ""
 }
 
-func (m *CpuInfo) GetCpuIowait() float64 {
+func (m *MCISServiceMonInfoResponse) GetVmCnt() int32 {
 	if m != nil {
-		return m.CpuIowait
+		return m.VmCnt
 	}
 	return 0
 }
 
-func (m *CpuInfo) GetCpuHintr() float64 {
+func (m *MCISServiceMonInfoResponse) GetHealthyVmCnt() int32 {
 	if m != nil {
-		return m.CpuHintr
+		return m.HealthyVmCnt
 	}
 	return 0
 }
 
-func (m *CpuInfo) GetCpuSintr() float64 {
+func (m *MCISServiceMonInfoResponse) GetSummary() *MCISServiceSummary {
 	if m != nil {
-		return m.CpuSintr
+		return m.Summary
 	}
-	return 0
+	return nil
 }
 
-func (m *CpuInfo) GetCpuUser() float64 {
+func (m *MCISServiceMonInfoResponse) GetVmList() []*VMServiceMonInfo {
 	if m != nil {
-		return m.CpuUser
+		return m.VmList
 	}
-	return 0
+	return nil
 }
 
-func (m *CpuInfo) GetCpuNice() float64 {
+func (m *MCISServiceMonInfoResponse) GetTime() string {
 	if m != nil {
-		return m.CpuNice
+		return m.Time
 	}
-	return 0
+	return ""
 }
 
-func (m *CpuInfo) GetCpuSteal() float64 {
+type MCISServiceSummary struct {
+	VcpuProvisioned int32   `protobuf:"varint,1,opt,name=vcpu_provisioned" json:"vcpu_provisioned,omitempty"`
+	VcpuUsed        float64 `protobuf:"fixed64,2,opt,name=vcpu_used" json:"vcpu_used,omitempty"`
+	CpuUtilization  float64 `protobuf:"fixed64,3,opt,name=cpu_utilization" json:"cpu_utilization,omitempty"`
+	MemTotal        float64 `protobuf:"fixed64,4,opt,name=mem_total" json:"mem_total,omitempty"`
+	MemUsed         float64 `protobuf:"fixed64,5,opt,name=mem_used" json:"mem_used,omitempty"`
+	MemUtilization  float64 `protobuf:"fixed64,6,opt,name=mem_utilization" json:"mem_utilization,omitempty"`
+	DiskTotal       float64 `protobuf:"fixed64,7,opt,name=disk_total" json:"disk_total,omitempty"`
+	DiskUsed        float64 `protobuf:"fixed64,8,opt,name=disk_used" json:"disk_used,omitempty"`
+	DiskUtilization float64 `protobuf:"fixed64,9,opt,name=disk_utilization" json:"disk_utilization,omitempty"`
+	DiskReadBps     float64 `protobuf:"fixed64,10,opt,name=disk_read_bps" json:"disk_read_bps,omitempty"`
+	DiskWriteBps    float64 `protobuf:"fixed64,11,opt,name=disk_write_bps" json:"disk_write_bps,omitempty"`
+	NetInBps        float64 `protobuf:"fixed64,12,opt,name=net_in_bps" json:"net_in_bps,omitempty"`
+	NetOutBps       float64 `protobuf:"fixed64,13,opt,name=net_out_bps" json:"net_out_bps,omitempty"`
+}
+
+func (m *MCISServiceSummary) Reset()                    { *m = MCISServiceSummary{} }
+func (m *MCISServiceSummary) String() string            { return proto.CompactTextString(m) }
+func (*MCISServiceSummary) ProtoMessage()               {}
+func (*MCISServiceSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *MCISServiceSummary) GetVcpuProvisioned() int32 {
 	if m != nil {
-		return m.CpuSteal
+		return m.VcpuProvisioned
 	}
 	return 0
 }
 
-func (m *CpuInfo) GetCpuGuest() float64 {
+func (m *MCISServiceSummary) GetVcpuUsed() float64 {
 	if m != nil {
-		return m.CpuGuest
+		return m.VcpuUsed
 	}
 	return 0
 }
 
-func (m *CpuInfo) GetCpuGuestNice() float64 {
+func (m *MCISServiceSummary) GetCpuUtilization() float64 {
 	if m != nil {
-		return m.CpuGuestNice
+		return m.CpuUtilization
 	}
 	return 0
 }
 
-func (m *CpuInfo) GetTime() string {
+func (m *MCISServiceSummary) GetMemTotal() float64 {
 	if m != nil {
-		return m.Time
+		return m.MemTotal
 	}
-	return ""
-}
-
-type CpuFreqInfoResponse struct {
-	Name   string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Tags   *Tags          `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
-	Values []*CpuFreqInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+	return 0
 }
 
-func (m *CpuFreqInfoResponse) Reset()                    { *m = CpuFreqInfoResponse{} }
-func (m *CpuFreqInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*CpuFreqInfoResponse) ProtoMessage()               {}
-func (*CpuFreqInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
-
-func (m *CpuFreqInfoResponse) GetName() string {
+func (m *MCISServiceSummary) GetMemUsed() float64 {
 	if m != nil {
-		return m.Name
+		return m.MemUsed
 	}
-	return ""
+	return 0
 }
 
-func (m *CpuFreqInfoResponse) GetTags() *Tags {
+func (m *MCISServiceSummary) GetMemUtilization() float64 {
 	if m != nil {
-		return m.Tags
+		return m.MemUtilization
 	}
-	return nil
+	return 0
 }
 
-func (m *CpuFreqInfoResponse) GetValues() []*CpuFreqInfo {
+func (m *MCISServiceSummary) GetDiskTotal() float64 {
 	if m != nil {
-		return m.Values
+		return m.DiskTotal
 	}
-	return nil
-}
-
-type CpuFreqInfo struct {
-	CpuSpeed float64 `protobuf:"fixed64,1,opt,name=cpu_speed" json:"cpu_speed,omitempty"`
-	Time     string  `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+	return 0
 }
 
-func (m *CpuFreqInfo) Reset()                    { *m = CpuFreqInfo{} }
-func (m *CpuFreqInfo) String() string            { return proto.CompactTextString(m) }
-func (*CpuFreqInfo) ProtoMessage()               {}
-func (*CpuFreqInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
-
-func (m *CpuFreqInfo) GetCpuSpeed() float64 {
+func (m *MCISServiceSummary) GetDiskUsed() float64 {
 	if m != nil {
-		return m.CpuSpeed
+		return m.DiskUsed
 	}
 	return 0
 }
 
-func (m *CpuFreqInfo) GetTime() string {
+func (m *MCISServiceSummary) GetDiskUtilization() float64 {
 	if m != nil {
-		return m.Time
+		return m.DiskUtilization
 	}
-	return ""
+	return 0
 }
 
-type MemoryInfoResponse struct {
-	Name   string        `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Tags   *Tags         `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
-	Values []*MemoryInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+func (m *MCISServiceSummary) GetDiskReadBps() float64 {
+	if m != nil {
+		return m.DiskReadBps
+	}
+	return 0
 }
 
-func (m *MemoryInfoResponse) Reset()                    { *m = MemoryInfoResponse{} }
-func (m *MemoryInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*MemoryInfoResponse) ProtoMessage()               {}
-func (*MemoryInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
-
-func (m *MemoryInfoResponse) GetName() string {
+func (m *MCISServiceSummary) GetDiskWriteBps() float64 {
 	if m != nil {
-		return m.Name
+		return m.DiskWriteBps
 	}
-	return ""
+	return 0
 }
 
-func (m *MemoryInfoResponse) GetTags() *Tags {
+func (m *MCISServiceSummary) GetNetInBps() float64 {
 	if m != nil {
-		return m.Tags
+		return m.NetInBps
 	}
-	return nil
+	return 0
 }
 
-func (m *MemoryInfoResponse) GetValues() []*MemoryInfo {
+func (m *MCISServiceSummary) GetNetOutBps() float64 {
 	if m != nil {
-		return m.Values
+		return m.NetOutBps
 	}
-	return nil
+	return 0
 }
 
-type MemoryInfo struct {
-	MemUtilization float64 `protobuf:"fixed64,1,opt,name=mem_utilization" json:"mem_utilization,omitempty"`
-	MemTotal       float64 `protobuf:"fixed64,2,opt,name=mem_total" json:"mem_total,omitempty"`
-	MemUsed        float64 `protobuf:"fixed64,3,opt,name=mem_used" json:"mem_used,omitempty"`
-	MemFree        float64 `protobuf:"fixed64,4,opt,name=mem_free" json:"mem_free,omitempty"`
-	MemShared      float64 `protobuf:"fixed64,5,opt,name=mem_shared" json:"mem_shared,omitempty"`
-	MemBuffers     float64 `protobuf:"fixed64,6,opt,name=mem_buffers" json:"mem_buffers,omitempty"`
-	MemCached      float64 `protobuf:"fixed64,7,opt,name=mem_cached" json:"mem_cached,omitempty"`
-	Time           string  `protobuf:"bytes,8,opt,name=time" json:"time,omitempty"`
+type VMServiceMonInfo struct {
+	VmId        string           `protobuf:"bytes,1,opt,name=vm_id" json:"vm_id,omitempty"`
+	CspType     string           `protobuf:"bytes,2,opt,name=csp_type" json:"csp_type,omitempty"`
+	PublicIp    string           `protobuf:"bytes,3,opt,name=public_ip" json:"public_ip,omitempty"`
+	AgentType   string           `protobuf:"bytes,4,opt,name=agent_type" json:"agent_type,omitempty"`
+	AgentState  string           `protobuf:"bytes,5,opt,name=agent_state" json:"agent_state,omitempty"`
+	AgentHealth string           `protobuf:"bytes,6,opt,name=agent_health" json:"agent_health,omitempty"`
+	Liveness    string           `protobuf:"bytes,7,opt,name=liveness" json:"liveness,omitempty"`
+	LastSeen    int64            `protobuf:"varint,8,opt,name=last_seen" json:"last_seen,omitempty"`
+	Vcpu        int32            `protobuf:"varint,9,opt,name=vcpu" json:"vcpu,omitempty"`
+	Status      string           `protobuf:"bytes,10,opt,name=status" json:"status,omitempty"`
+	Metric      *VMServiceMetric `protobuf:"bytes,11,opt,name=metric" json:"metric,omitempty"`
+	Error       string           `protobuf:"bytes,12,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *MemoryInfo) Reset()                    { *m = MemoryInfo{} }
-func (m *MemoryInfo) String() string            { return proto.CompactTextString(m) }
-func (*MemoryInfo) ProtoMessage()               {}
-func (*MemoryInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *VMServiceMonInfo) Reset()                    { *m = VMServiceMonInfo{} }
+func (m *VMServiceMonInfo) String() string            { return proto.CompactTextString(m) }
+func (*VMServiceMonInfo) ProtoMessage()               {}
+func (*VMServiceMonInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
-func (m *MemoryInfo) GetMemUtilization() float64 {
+func (m *VMServiceMonInfo) GetVmId() string {
 	if m != nil {
-		return m.MemUtilization
+		return m.VmId
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryInfo) GetMemTotal() float64 {
+func (m *VMServiceMonInfo) GetCspType() string {
 	if m != nil {
-		return m.MemTotal
+		return m.CspType
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryInfo) GetMemUsed() float64 {
+func (m *VMServiceMonInfo) GetPublicIp() string {
 	if m != nil {
-		return m.MemUsed
+		return m.PublicIp
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryInfo) GetMemFree() float64 {
+func (m *VMServiceMonInfo) GetAgentType() string {
 	if m != nil {
-		return m.MemFree
+		return m.AgentType
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryInfo) GetMemShared() float64 {
+func (m *VMServiceMonInfo) GetAgentState() string {
 	if m != nil {
-		return m.MemShared
+		return m.AgentState
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryInfo) GetMemBuffers() float64 {
+func (m *VMServiceMonInfo) GetAgentHealth() string {
 	if m != nil {
-		return m.MemBuffers
+		return m.AgentHealth
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryInfo) GetMemCached() float64 {
+func (m *VMServiceMonInfo) GetLiveness() string {
 	if m != nil {
-		return m.MemCached
+		return m.Liveness
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryInfo) GetTime() string {
+func (m *VMServiceMonInfo) GetLastSeen() int64 {
 	if m != nil {
-		return m.Time
+		return m.LastSeen
 	}
-	return ""
+	return 0
 }
 
-type DiskInfoResponse struct {
-	Name   string      `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Tags   *Tags       `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
-	Values []*DiskInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+func (m *VMServiceMonInfo) GetVcpu() int32 {
+	if m != nil {
+		return m.Vcpu
+	}
+	return 0
 }
 
-func (m *DiskInfoResponse) Reset()                    { *m = DiskInfoResponse{} }
-func (m *DiskInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*DiskInfoResponse) ProtoMessage()               {}
-func (*DiskInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
-
-func (m *DiskInfoResponse) GetName() string {
+func (m *VMServiceMonInfo) GetStatus() string {
 	if m != nil {
-		return m.Name
+		return m.Status
 	}
 	return ""
 }
 
-func (m *DiskInfoResponse) GetTags() *Tags {
+func (m *VMServiceMonInfo) GetMetric() *VMServiceMetric {
 	if m != nil {
-		return m.Tags
+		return m.Metric
 	}
 	return nil
 }
 
-func (m *DiskInfoResponse) GetValues() []*DiskInfo {
+func (m *VMServiceMonInfo) GetError() string {
 	if m != nil {
-		return m.Values
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-type DiskInfo struct {
-	Free        float64 `protobuf:"fixed64,1,opt,name=free" json:"free,omitempty"`
-	ReadBytes   float64 `protobuf:"fixed64,2,opt,name=read_bytes" json:"read_bytes,omitempty"`
-	ReadTime    float64 `protobuf:"fixed64,3,opt,name=read_time" json:"read_time,omitempty"`
-	Reads       float64 `protobuf:"fixed64,4,opt,name=reads" json:"reads,omitempty"`
-	Total       float64 `protobuf:"fixed64,5,opt,name=total" json:"total,omitempty"`
-	Used        float64 `protobuf:"fixed64,6,opt,name=used" json:"used,omitempty"`
-	UsedPercent float64 `protobuf:"fixed64,7,opt,name=used_percent" json:"used_percent,omitempty"`
-	WriteBytes  float64 `protobuf:"fixed64,8,opt,name=write_bytes" json:"write_bytes,omitempty"`
-	WriteTime   float64 `protobuf:"fixed64,9,opt,name=write_time" json:"write_time,omitempty"`
-	Writes      float64 `protobuf:"fixed64,10,opt,name=writes" json:"writes,omitempty"`
-	Time        string  `protobuf:"bytes,11,opt,name=time" json:"time,omitempty"`
+type VMServiceMetric struct {
+	CpuUtilization  float64 `protobuf:"fixed64,1,opt,name=cpu_utilization" json:"cpu_utilization,omitempty"`
+	MemTotal        float64 `protobuf:"fixed64,2,opt,name=mem_total" json:"mem_total,omitempty"`
+	MemUsed         float64 `protobuf:"fixed64,3,opt,name=mem_used" json:"mem_used,omitempty"`
+	MemUtilization  float64 `protobuf:"fixed64,4,opt,name=mem_utilization" json:"mem_utilization,omitempty"`
+	DiskTotal       float64 `protobuf:"fixed64,5,opt,name=disk_total" json:"disk_total,omitempty"`
+	DiskUsed        float64 `protobuf:"fixed64,6,opt,name=disk_used" json:"disk_used,omitempty"`
+	DiskUtilization float64 `protobuf:"fixed64,7,opt,name=disk_utilization" json:"disk_utilization,omitempty"`
+	DiskReadBps     float64 `protobuf:"fixed64,8,opt,name=disk_read_bps" json:"disk_read_bps,omitempty"`
+	DiskWriteBps    float64 `protobuf:"fixed64,9,opt,name=disk_write_bps" json:"disk_write_bps,omitempty"`
+	NetInBps        float64 `protobuf:"fixed64,10,opt,name=net_in_bps" json:"net_in_bps,omitempty"`
+	NetOutBps       float64 `protobuf:"fixed64,11,opt,name=net_out_bps" json:"net_out_bps,omitempty"`
+	Time            string  `protobuf:"bytes,12,opt,name=time" json:"time,omitempty"`
 }
 
-func (m *DiskInfo) Reset()                    { *m = DiskInfo{} }
-func (m *DiskInfo) String() string            { return proto.CompactTextString(m) }
-func (*DiskInfo) ProtoMessage()               {}
-func (*DiskInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (m *VMServiceMetric) Reset()                    { *m = VMServiceMetric{} }
+func (m *VMServiceMetric) String() string            { return proto.CompactTextString(m) }
+func (*VMServiceMetric) ProtoMessage()               {}
+func (*VMServiceMetric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
-func (m *DiskInfo) GetFree() float64 {
+func (m *VMServiceMetric) GetCpuUtilization() float64 {
 	if m != nil {
-		return m.Free
+		return m.CpuUtilization
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetReadBytes() float64 {
+func (m *VMServiceMetric) GetMemTotal() float64 {
 	if m != nil {
-		return m.ReadBytes
+		return m.MemTotal
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetReadTime() float64 {
+func (m *VMServiceMetric) GetMemUsed() float64 {
 	if m != nil {
-		return m.ReadTime
+		return m.MemUsed
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetReads() float64 {
+func (m *VMServiceMetric) GetMemUtilization() float64 {
 	if m != nil {
-		return m.Reads
+		return m.MemUtilization
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetTotal() float64 {
+func (m *VMServiceMetric) GetDiskTotal() float64 {
 	if m != nil {
-		return m.Total
+		return m.DiskTotal
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetUsed() float64 {
+func (m *VMServiceMetric) GetDiskUsed() float64 {
 	if m != nil {
-		return m.Used
+		return m.DiskUsed
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetUsedPercent() float64 {
+func (m *VMServiceMetric) GetDiskUtilization() float64 {
 	if m != nil {
-		return m.UsedPercent
+		return m.DiskUtilization
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetWriteBytes() float64 {
+func (m *VMServiceMetric) GetDiskReadBps() float64 {
 	if m != nil {
-		return m.WriteBytes
+		return m.DiskReadBps
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetWriteTime() float64 {
+func (m *VMServiceMetric) GetDiskWriteBps() float64 {
 	if m != nil {
-		return m.WriteTime
+		return m.DiskWriteBps
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetWrites() float64 {
+func (m *VMServiceMetric) GetNetInBps() float64 {
 	if m != nil {
-		return m.Writes
+		return m.NetInBps
 	}
 	return 0
 }
 
-func (m *DiskInfo) GetTime() string {
+func (m *VMServiceMetric) GetNetOutBps() float64 {
+	if m != nil {
+		return m.NetOutBps
+	}
+	return 0
+}
+
+func (m *VMServiceMetric) GetTime() string {
 	if m != nil {
 		return m.Time
 	}
 	return ""
 }
 
-type NetworkInfoResponse struct {
-	Name   string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Tags   *Tags          `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
-	Values []*NetworkInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+type MCISRightsizingQryRequest struct {
+	NsId          string `protobuf:"bytes,1,opt,name=ns_id" json:"ns_id,omitempty"`
+	McisId        string `protobuf:"bytes,2,opt,name=mcis_id" json:"mcis_id,omitempty"`
+	Authorization string `protobuf:"bytes,3,opt,name=authorization" json:"authorization,omitempty"`
+	Window        string `protobuf:"bytes,4,opt,name=window" json:"window,omitempty"`
 }
 
-func (m *NetworkInfoResponse) Reset()                    { *m = NetworkInfoResponse{} }
-func (m *NetworkInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*NetworkInfoResponse) ProtoMessage()               {}
-func (*NetworkInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (m *MCISRightsizingQryRequest) Reset()                    { *m = MCISRightsizingQryRequest{} }
+func (m *MCISRightsizingQryRequest) String() string            { return proto.CompactTextString(m) }
+func (*MCISRightsizingQryRequest) ProtoMessage()               {}
+func (*MCISRightsizingQryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
-func (m *NetworkInfoResponse) GetName() string {
+func (m *MCISRightsizingQryRequest) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *MCISRightsizingQryRequest) GetMcisId() string {
+	if m != nil {
+		return m.McisId
+	}
+	return ""
+}
+
+func (m *MCISRightsizingQryRequest) GetAuthorization() string {
+	if m != nil {
+		return m.Authorization
+	}
+	return ""
+}
+
+func (m *MCISRightsizingQryRequest) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
+type MCISRightsizingResponse struct {
+	NsId                   string           `protobuf:"bytes,1,opt,name=ns_id" json:"ns_id,omitempty"`
+	McisId                 string           `protobuf:"bytes,2,opt,name=mcis_id" json:"mcis_id,omitempty"`
+	Window                 string           `protobuf:"bytes,3,opt,name=window" json:"window,omitempty"`
+	CpuPercentile          int32            `protobuf:"varint,4,opt,name=cpu_percentile" json:"cpu_percentile,omitempty"`
+	VmCnt                  int32            `protobuf:"varint,5,opt,name=vm_cnt" json:"vm_cnt,omitempty"`
+	OverProvisionedCnt     int32            `protobuf:"varint,6,opt,name=over_provisioned_cnt" json:"over_provisioned_cnt,omitempty"`
+	UnderProvisionedCnt    int32            `protobuf:"varint,7,opt,name=under_provisioned_cnt" json:"under_provisioned_cnt,omitempty"`
+	MonthlyCost            float64          `protobuf:"fixed64,8,opt,name=monthly_cost" json:"monthly_cost,omitempty"`
+	RecommendedMonthlyCost float64          `protobuf:"fixed64,9,opt,name=recommended_monthly_cost" json:"recommended_monthly_cost,omitempty"`
+	MonthlySavings         float64          `protobuf:"fixed64,10,opt,name=monthly_savings" json:"monthly_savings,omitempty"`
+	VmList                 []*VMRightsizing `protobuf:"bytes,11,rep,name=vm_list" json:"vm_list,omitempty"`
+	Time                   string           `protobuf:"bytes,12,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *MCISRightsizingResponse) Reset()                    { *m = MCISRightsizingResponse{} }
+func (m *MCISRightsizingResponse) String() string            { return proto.CompactTextString(m) }
+func (*MCISRightsizingResponse) ProtoMessage()               {}
+func (*MCISRightsizingResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *MCISRightsizingResponse) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *MCISRightsizingResponse) GetMcisId() string {
+	if m != nil {
+		return m.McisId
+	}
+	return ""
+}
+
+func (m *MCISRightsizingResponse) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
+func (m *MCISRightsizingResponse) GetCpuPercentile() int32 {
+	if m != nil {
+		return m.CpuPercentile
+	}
+	return 0
+}
+
+func (m *MCISRightsizingResponse) GetVmCnt() int32 {
+	if m != nil {
+		return m.VmCnt
+	}
+	return 0
+}
+
+func (m *MCISRightsizingResponse) GetOverProvisionedCnt() int32 {
+	if m != nil {
+		return m.OverProvisionedCnt
+	}
+	return 0
+}
+
+func (m *MCISRightsizingResponse) GetUnderProvisionedCnt() int32 {
+	if m != nil {
+		return m.UnderProvisionedCnt
+	}
+	return 0
+}
+
+func (m *MCISRightsizingResponse) GetMonthlyCost() float64 {
+	if m != nil {
+		return m.MonthlyCost
+	}
+	return 0
+}
+
+func (m *MCISRightsizingResponse) GetRecommendedMonthlyCost() float64 {
+	if m != nil {
+		return m.RecommendedMonthlyCost
+	}
+	return 0
+}
+
+func (m *MCISRightsizingResponse) GetMonthlySavings() float64 {
+	if m != nil {
+		return m.MonthlySavings
+	}
+	return 0
+}
+
+func (m *MCISRightsizingResponse) GetVmList() []*VMRightsizing {
+	if m != nil {
+		return m.VmList
+	}
+	return nil
+}
+
+func (m *MCISRightsizingResponse) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type VMRightsizing struct {
+	VmId              string  `protobuf:"bytes,1,opt,name=vm_id" json:"vm_id,omitempty"`
+	CspType           string  `protobuf:"bytes,2,opt,name=csp_type" json:"csp_type,omitempty"`
+	ConnectionName    string  `protobuf:"bytes,3,opt,name=connection_name" json:"connection_name,omitempty"`
+	SpecId            string  `protobuf:"bytes,4,opt,name=spec_id" json:"spec_id,omitempty"`
+	Vcpu              int32   `protobuf:"varint,5,opt,name=vcpu" json:"vcpu,omitempty"`
+	MemGib            float64 `protobuf:"fixed64,6,opt,name=mem_gib" json:"mem_gib,omitempty"`
+	CostPerHour       float64 `protobuf:"fixed64,7,opt,name=cost_per_hour" json:"cost_per_hour,omitempty"`
+	CpuUtilization    float64 `protobuf:"fixed64,8,opt,name=cpu_utilization" json:"cpu_utilization,omitempty"`
+	MemUtilization    float64 `protobuf:"fixed64,9,opt,name=mem_utilization" json:"mem_utilization,omitempty"`
+	Recommendation    string  `protobuf:"bytes,10,opt,name=recommendation" json:"recommendation,omitempty"`
+	Reason            string  `protobuf:"bytes,11,opt,name=reason" json:"reason,omitempty"`
+	TargetVcpu        int32   `protobuf:"varint,12,opt,name=target_vcpu" json:"target_vcpu,omitempty"`
+	TargetMemGib      float64 `protobuf:"fixed64,13,opt,name=target_mem_gib" json:"target_mem_gib,omitempty"`
+	TargetSpecId      string  `protobuf:"bytes,14,opt,name=target_spec_id" json:"target_spec_id,omitempty"`
+	TargetCostPerHour float64 `protobuf:"fixed64,15,opt,name=target_cost_per_hour" json:"target_cost_per_hour,omitempty"`
+	MonthlyCost       float64 `protobuf:"fixed64,16,opt,name=monthly_cost" json:"monthly_cost,omitempty"`
+	TargetMonthlyCost float64 `protobuf:"fixed64,17,opt,name=target_monthly_cost" json:"target_monthly_cost,omitempty"`
+	MonthlySavings    float64 `protobuf:"fixed64,18,opt,name=monthly_savings" json:"monthly_savings,omitempty"`
+}
+
+func (m *VMRightsizing) Reset()                    { *m = VMRightsizing{} }
+func (m *VMRightsizing) String() string            { return proto.CompactTextString(m) }
+func (*VMRightsizing) ProtoMessage()               {}
+func (*VMRightsizing) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *VMRightsizing) GetVmId() string {
+	if m != nil {
+		return m.VmId
+	}
+	return ""
+}
+
+func (m *VMRightsizing) GetCspType() string {
+	if m != nil {
+		return m.CspType
+	}
+	return ""
+}
+
+func (m *VMRightsizing) GetConnectionName() string {
+	if m != nil {
+		return m.ConnectionName
+	}
+	return ""
+}
+
+func (m *VMRightsizing) GetSpecId() string {
+	if m != nil {
+		return m.SpecId
+	}
+	return ""
+}
+
+func (m *VMRightsizing) GetVcpu() int32 {
+	if m != nil {
+		return m.Vcpu
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetMemGib() float64 {
+	if m != nil {
+		return m.MemGib
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetCostPerHour() float64 {
+	if m != nil {
+		return m.CostPerHour
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetCpuUtilization() float64 {
+	if m != nil {
+		return m.CpuUtilization
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetMemUtilization() float64 {
+	if m != nil {
+		return m.MemUtilization
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetRecommendation() string {
+	if m != nil {
+		return m.Recommendation
+	}
+	return ""
+}
+
+func (m *VMRightsizing) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *VMRightsizing) GetTargetVcpu() int32 {
+	if m != nil {
+		return m.TargetVcpu
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetTargetMemGib() float64 {
+	if m != nil {
+		return m.TargetMemGib
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetTargetSpecId() string {
+	if m != nil {
+		return m.TargetSpecId
+	}
+	return ""
+}
+
+func (m *VMRightsizing) GetTargetCostPerHour() float64 {
+	if m != nil {
+		return m.TargetCostPerHour
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetMonthlyCost() float64 {
+	if m != nil {
+		return m.MonthlyCost
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetTargetMonthlyCost() float64 {
+	if m != nil {
+		return m.TargetMonthlyCost
+	}
+	return 0
+}
+
+func (m *VMRightsizing) GetMonthlySavings() float64 {
+	if m != nil {
+		return m.MonthlySavings
+	}
+	return 0
+}
+
+type CpuInfoResponse struct {
+	Name   string     `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Tags   *Tags      `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
+	Values []*CpuInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *CpuInfoResponse) Reset()                    { *m = CpuInfoResponse{} }
+func (m *CpuInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*CpuInfoResponse) ProtoMessage()               {}
+func (*CpuInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *CpuInfoResponse) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *NetworkInfoResponse) GetTags() *Tags {
+func (m *CpuInfoResponse) GetTags() *Tags {
 	if m != nil {
 		return m.Tags
 	}
 	return nil
 }
 
-func (m *NetworkInfoResponse) GetValues() []*NetworkInfo {
+func (m *CpuInfoResponse) GetValues() []*CpuInfo {
 	if m != nil {
 		return m.Values
 	}
 	return nil
 }
 
-type NetworkInfo struct {
-	BytesIn  float64 `protobuf:"fixed64,1,opt,name=bytes_in" json:"bytes_in,omitempty"`
-	BytesOut float64 `protobuf:"fixed64,2,opt,name=bytes_out" json:"bytes_out,omitempty"`
-	PktsIn   float64 `protobuf:"fixed64,3,opt,name=pkts_in" json:"pkts_in,omitempty"`
-	PktsOut  float64 `protobuf:"fixed64,4,opt,name=pkts_out" json:"pkts_out,omitempty"`
-	Time     string  `protobuf:"bytes,5,opt,name=time" json:"time,omitempty"`
+type CpuInfo struct {
+	CpuUtilization float64 `protobuf:"fixed64,1,opt,name=cpu_utilization" json:"cpu_utilization,omitempty"`
+	CpuSystem      float64 `protobuf:"fixed64,2,opt,name=cpu_system" json:"cpu_system,omitempty"`
+	CpuIdle        float64 `protobuf:"fixed64,3,opt,name=cpu_idle" json:"cpu_idle,omitempty"`
+	CpuIowait      float64 `protobuf:"fixed64,4,opt,name=cpu_iowait" json:"cpu_iowait,omitempty"`
+	CpuHintr       float64 `protobuf:"fixed64,5,opt,name=cpu_hintr" json:"cpu_hintr,omitempty"`
+	CpuSintr       float64 `protobuf:"fixed64,6,opt,name=cpu_sintr" json:"cpu_sintr,omitempty"`
+	CpuUser        float64 `protobuf:"fixed64,7,opt,name=cpu_user" json:"cpu_user,omitempty"`
+	CpuNice        float64 `protobuf:"fixed64,8,opt,name=cpu_nice" json:"cpu_nice,omitempty"`
+	CpuSteal       float64 `protobuf:"fixed64,9,opt,name=cpu_steal" json:"cpu_steal,omitempty"`
+	CpuGuest       float64 `protobuf:"fixed64,10,opt,name=cpu_guest" json:"cpu_guest,omitempty"`
+	CpuGuestNice   float64 `protobuf:"fixed64,11,opt,name=cpu_guest_nice" json:"cpu_guest_nice,omitempty"`
+	Time           string  `protobuf:"bytes,12,opt,name=time" json:"time,omitempty"`
 }
 
-func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
-func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
-func (*NetworkInfo) ProtoMessage()               {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (m *CpuInfo) Reset()                    { *m = CpuInfo{} }
+func (m *CpuInfo) String() string            { return proto.CompactTextString(m) }
+func (*CpuInfo) ProtoMessage()               {}
+func (*CpuInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
-func (m *NetworkInfo) GetBytesIn() float64 {
+func (m *CpuInfo) GetCpuUtilization() float64 {
 	if m != nil {
-		return m.BytesIn
+		return m.CpuUtilization
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuSystem() float64 {
+	if m != nil {
+		return m.CpuSystem
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetBytesOut() float64 {
+func (m *CpuInfo) GetCpuIdle() float64 {
+	if m != nil {
+		return m.CpuIdle
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuIowait() float64 {
+	if m != nil {
+		return m.CpuIowait
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuHintr() float64 {
+	if m != nil {
+		return m.CpuHintr
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuSintr() float64 {
+	if m != nil {
+		return m.CpuSintr
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuUser() float64 {
+	if m != nil {
+		return m.CpuUser
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuNice() float64 {
+	if m != nil {
+		return m.CpuNice
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuSteal() float64 {
+	if m != nil {
+		return m.CpuSteal
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuGuest() float64 {
+	if m != nil {
+		return m.CpuGuest
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetCpuGuestNice() float64 {
+	if m != nil {
+		return m.CpuGuestNice
+	}
+	return 0
+}
+
+func (m *CpuInfo) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type CpuFreqInfoResponse struct {
+	Name   string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Tags   *Tags          `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
+	Values []*CpuFreqInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *CpuFreqInfoResponse) Reset()                    { *m = CpuFreqInfoResponse{} }
+func (m *CpuFreqInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*CpuFreqInfoResponse) ProtoMessage()               {}
+func (*CpuFreqInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *CpuFreqInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CpuFreqInfoResponse) GetTags() *Tags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *CpuFreqInfoResponse) GetValues() []*CpuFreqInfo {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type CpuFreqInfo struct {
+	CpuSpeed float64 `protobuf:"fixed64,1,opt,name=cpu_speed" json:"cpu_speed,omitempty"`
+	Time     string  `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *CpuFreqInfo) Reset()                    { *m = CpuFreqInfo{} }
+func (m *CpuFreqInfo) String() string            { return proto.CompactTextString(m) }
+func (*CpuFreqInfo) ProtoMessage()               {}
+func (*CpuFreqInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *CpuFreqInfo) GetCpuSpeed() float64 {
+	if m != nil {
+		return m.CpuSpeed
+	}
+	return 0
+}
+
+func (m *CpuFreqInfo) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type MemoryInfoResponse struct {
+	Name   string        `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Tags   *Tags         `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
+	Values []*MemoryInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *MemoryInfoResponse) Reset()                    { *m = MemoryInfoResponse{} }
+func (m *MemoryInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*MemoryInfoResponse) ProtoMessage()               {}
+func (*MemoryInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *MemoryInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MemoryInfoResponse) GetTags() *Tags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *MemoryInfoResponse) GetValues() []*MemoryInfo {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type MemoryInfo struct {
+	MemUtilization float64 `protobuf:"fixed64,1,opt,name=mem_utilization" json:"mem_utilization,omitempty"`
+	MemTotal       float64 `protobuf:"fixed64,2,opt,name=mem_total" json:"mem_total,omitempty"`
+	MemUsed        float64 `protobuf:"fixed64,3,opt,name=mem_used" json:"mem_used,omitempty"`
+	MemFree        float64 `protobuf:"fixed64,4,opt,name=mem_free" json:"mem_free,omitempty"`
+	MemShared      float64 `protobuf:"fixed64,5,opt,name=mem_shared" json:"mem_shared,omitempty"`
+	MemBuffers     float64 `protobuf:"fixed64,6,opt,name=mem_buffers" json:"mem_buffers,omitempty"`
+	MemCached      float64 `protobuf:"fixed64,7,opt,name=mem_cached" json:"mem_cached,omitempty"`
+	Time           string  `protobuf:"bytes,8,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *MemoryInfo) Reset()                    { *m = MemoryInfo{} }
+func (m *MemoryInfo) String() string            { return proto.CompactTextString(m) }
+func (*MemoryInfo) ProtoMessage()               {}
+func (*MemoryInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *MemoryInfo) GetMemUtilization() float64 {
+	if m != nil {
+		return m.MemUtilization
+	}
+	return 0
+}
+
+func (m *MemoryInfo) GetMemTotal() float64 {
+	if m != nil {
+		return m.MemTotal
+	}
+	return 0
+}
+
+func (m *MemoryInfo) GetMemUsed() float64 {
+	if m != nil {
+		return m.MemUsed
+	}
+	return 0
+}
+
+func (m *MemoryInfo) GetMemFree() float64 {
+	if m != nil {
+		return m.MemFree
+	}
+	return 0
+}
+
+func (m *MemoryInfo) GetMemShared() float64 {
+	if m != nil {
+		return m.MemShared
+	}
+	return 0
+}
+
+func (m *MemoryInfo) GetMemBuffers() float64 {
+	if m != nil {
+		return m.MemBuffers
+	}
+	return 0
+}
+
+func (m *MemoryInfo) GetMemCached() float64 {
+	if m != nil {
+		return m.MemCached
+	}
+	return 0
+}
+
+func (m *MemoryInfo) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type DiskInfoResponse struct {
+	Name   string      `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Tags   *Tags       `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
+	Values []*DiskInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *DiskInfoResponse) Reset()                    { *m = DiskInfoResponse{} }
+func (m *DiskInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*DiskInfoResponse) ProtoMessage()               {}
+func (*DiskInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *DiskInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DiskInfoResponse) GetTags() *Tags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *DiskInfoResponse) GetValues() []*DiskInfo {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type DiskInfo struct {
+	Free        float64 `protobuf:"fixed64,1,opt,name=free" json:"free,omitempty"`
+	ReadBytes   float64 `protobuf:"fixed64,2,opt,name=read_bytes" json:"read_bytes,omitempty"`
+	ReadTime    float64 `protobuf:"fixed64,3,opt,name=read_time" json:"read_time,omitempty"`
+	Reads       float64 `protobuf:"fixed64,4,opt,name=reads" json:"reads,omitempty"`
+	Total       float64 `protobuf:"fixed64,5,opt,name=total" json:"total,omitempty"`
+	Used        float64 `protobuf:"fixed64,6,opt,name=used" json:"used,omitempty"`
+	UsedPercent float64 `protobuf:"fixed64,7,opt,name=used_percent" json:"used_percent,omitempty"`
+	WriteBytes  float64 `protobuf:"fixed64,8,opt,name=write_bytes" json:"write_bytes,omitempty"`
+	WriteTime   float64 `protobuf:"fixed64,9,opt,name=write_time" json:"write_time,omitempty"`
+	Writes      float64 `protobuf:"fixed64,10,opt,name=writes" json:"writes,omitempty"`
+	Time        string  `protobuf:"bytes,11,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *DiskInfo) Reset()                    { *m = DiskInfo{} }
+func (m *DiskInfo) String() string            { return proto.CompactTextString(m) }
+func (*DiskInfo) ProtoMessage()               {}
+func (*DiskInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *DiskInfo) GetFree() float64 {
+	if m != nil {
+		return m.Free
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetReadBytes() float64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetReadTime() float64 {
+	if m != nil {
+		return m.ReadTime
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetReads() float64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetUsed() float64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetUsedPercent() float64 {
+	if m != nil {
+		return m.UsedPercent
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetWriteBytes() float64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetWriteTime() float64 {
+	if m != nil {
+		return m.WriteTime
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetWrites() float64 {
+	if m != nil {
+		return m.Writes
+	}
+	return 0
+}
+
+func (m *DiskInfo) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type NetworkInfoResponse struct {
+	Name   string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Tags   *Tags          `protobuf:"bytes,2,opt,name=tags" json:"tags,omitempty"`
+	Values []*NetworkInfo `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *NetworkInfoResponse) Reset()                    { *m = NetworkInfoResponse{} }
+func (m *NetworkInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfoResponse) ProtoMessage()               {}
+func (*NetworkInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *NetworkInfoResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetworkInfoResponse) GetTags() *Tags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *NetworkInfoResponse) GetValues() []*NetworkInfo {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type NetworkInfo struct {
+	BytesIn  float64 `protobuf:"fixed64,1,opt,name=bytes_in" json:"bytes_in,omitempty"`
+	BytesOut float64 `protobuf:"fixed64,2,opt,name=bytes_out" json:"bytes_out,omitempty"`
+	PktsIn   float64 `protobuf:"fixed64,3,opt,name=pkts_in" json:"pkts_in,omitempty"`
+	PktsOut  float64 `protobuf:"fixed64,4,opt,name=pkts_out" json:"pkts_out,omitempty"`
+	Time     string  `protobuf:"bytes,5,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()               {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *NetworkInfo) GetBytesIn() float64 {
+	if m != nil {
+		return m.BytesIn
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetBytesOut() float64 {
+	if m != nil {
+		return m.BytesOut
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetPktsIn() float64 {
+	if m != nil {
+		return m.PktsIn
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetPktsOut() float64 {
+	if m != nil {
+		return m.PktsOut
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type MonitoringConfigRequest struct {
+	Item *MonitoringConfigInfo `protobuf:"bytes,1,opt,name=item,json=common" json:"item,omitempty"`
+}
+
+func (m *MonitoringConfigRequest) Reset()                    { *m = MonitoringConfigRequest{} }
+func (m *MonitoringConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*MonitoringConfigRequest) ProtoMessage()               {}
+func (*MonitoringConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *MonitoringConfigRequest) GetItem() *MonitoringConfigInfo {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type MonitoringConfigResponse struct {
+	Item *MonitoringConfigInfo `protobuf:"bytes,1,opt,name=item,json=common" json:"item,omitempty"`
+}
+
+func (m *MonitoringConfigResponse) Reset()                    { *m = MonitoringConfigResponse{} }
+func (m *MonitoringConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*MonitoringConfigResponse) ProtoMessage()               {}
+func (*MonitoringConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *MonitoringConfigResponse) GetItem() *MonitoringConfigInfo {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type MonitoringConfigInfo struct {
+	// 	MCISAgentInterval
+	McisAgentInterval int32 `protobuf:"varint,1,opt,name=mcis_agent_interval" json:"mcis_agent_interval,omitempty"`
+	// 	MCISCollectorInterval
+	McisCollectorInterval int32  `protobuf:"varint,2,opt,name=mcis_collector_interval" json:"mcis_collector_interval,omitempty"`
+	MaxHostCount          int32  `protobuf:"varint,3,opt,name=max_host_count" json:"max_host_count,omitempty"`
+	MonitoringPolicy      string `protobuf:"bytes,4,opt,name=monitoring_policy" json:"monitoring_policy,omitempty"`
+}
+
+func (m *MonitoringConfigInfo) Reset()                    { *m = MonitoringConfigInfo{} }
+func (m *MonitoringConfigInfo) String() string            { return proto.CompactTextString(m) }
+func (*MonitoringConfigInfo) ProtoMessage()               {}
+func (*MonitoringConfigInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *MonitoringConfigInfo) GetMcisAgentInterval() int32 {
+	if m != nil {
+		return m.McisAgentInterval
+	}
+	return 0
+}
+
+func (m *MonitoringConfigInfo) GetMcisCollectorInterval() int32 {
+	if m != nil {
+		return m.McisCollectorInterval
+	}
+	return 0
+}
+
+func (m *MonitoringConfigInfo) GetMaxHostCount() int32 {
+	if m != nil {
+		return m.MaxHostCount
+	}
+	return 0
+}
+
+func (m *MonitoringConfigInfo) GetMonitoringPolicy() string {
+	if m != nil {
+		return m.MonitoringPolicy
+	}
+	return ""
+}
+
+type InstallAgentRequest struct {
+	NsId         string `protobuf:"bytes,1,opt,name=ns_id" json:"ns_id,omitempty"`
+	McisId       string `protobuf:"bytes,2,opt,name=mcis_id" json:"mcis_id,omitempty"`
+	VmId         string `protobuf:"bytes,3,opt,name=vm_id" json:"vm_id,omitempty"`
+	PublicIp     string `protobuf:"bytes,4,opt,name=public_ip" json:"public_ip,omitempty"`
+	UserName     string `protobuf:"bytes,5,opt,name=user_name" json:"user_name,omitempty"`
+	SshKey       string `protobuf:"bytes,6,opt,name=ssh_key" json:"ssh_key,omitempty"`
+	CspType      string `protobuf:"bytes,7,opt,name=csp_type" json:"csp_type,omitempty"`
+	Port         string `protobuf:"bytes,8,opt,name=port" json:"port,omitempty"`
+	ServiceType  string `protobuf:"bytes,9,opt,name=service_type" json:"service_type,omitempty"`
+	Mck8SId      string `protobuf:"bytes,10,opt,name=mck8s_id" json:"mck8s_id,omitempty"`
+	ApiserverUrl string `protobuf:"bytes,11,opt,name=apiserver_url" json:"apiserver_url,omitempty"`
+	ServerCa     string `protobuf:"bytes,12,opt,name=server_ca" json:"server_ca,omitempty"`
+	ClientCa     string `protobuf:"bytes,13,opt,name=client_ca" json:"client_ca,omitempty"`
+	ClientKey    string `protobuf:"bytes,14,opt,name=client_key" json:"client_key,omitempty"`
+	ClientToken  string `protobuf:"bytes,15,opt,name=client_token" json:"client_token,omitempty"`
+	Profile      string `protobuf:"bytes,16,opt,name=profile" json:"profile,omitempty"`
+	AgentType    string `protobuf:"bytes,17,opt,name=agent_type" json:"agent_type,omitempty"`
+	CredentialId string `protobuf:"bytes,18,opt,name=credential_id" json:"credential_id,omitempty"`
+}
+
+func (m *InstallAgentRequest) Reset()                    { *m = InstallAgentRequest{} }
+func (m *InstallAgentRequest) String() string            { return proto.CompactTextString(m) }
+func (*InstallAgentRequest) ProtoMessage()               {}
+func (*InstallAgentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *InstallAgentRequest) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetMcisId() string {
+	if m != nil {
+		return m.McisId
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetVmId() string {
+	if m != nil {
+		return m.VmId
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetPublicIp() string {
+	if m != nil {
+		return m.PublicIp
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetSshKey() string {
+	if m != nil {
+		return m.SshKey
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetCspType() string {
+	if m != nil {
+		return m.CspType
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetMck8SId() string {
+	if m != nil {
+		return m.Mck8SId
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetApiserverUrl() string {
+	if m != nil {
+		return m.ApiserverUrl
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetServerCa() string {
+	if m != nil {
+		return m.ServerCa
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetClientCa() string {
+	if m != nil {
+		return m.ClientCa
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetClientKey() string {
+	if m != nil {
+		return m.ClientKey
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetClientToken() string {
+	if m != nil {
+		return m.ClientToken
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetAgentType() string {
+	if m != nil {
+		return m.AgentType
+	}
+	return ""
+}
+
+func (m *InstallAgentRequest) GetCredentialId() string {
+	if m != nil {
+		return m.CredentialId
+	}
+	return ""
+}
+
+type AgentMetadataListRequest struct {
+	NsId        string `protobuf:"bytes,1,opt,name=ns_id" json:"ns_id,omitempty"`
+	McisId      string `protobuf:"bytes,2,opt,name=mcis_id" json:"mcis_id,omitempty"`
+	CspType     string `protobuf:"bytes,3,opt,name=csp_type" json:"csp_type,omitempty"`
+	ServiceType string `protobuf:"bytes,4,opt,name=service_type" json:"service_type,omitempty"`
+	AgentState  string `protobuf:"bytes,5,opt,name=agent_state" json:"agent_state,omitempty"`
+	AgentHealth string `protobuf:"bytes,6,opt,name=agent_health" json:"agent_health,omitempty"`
+	AgentType   string `protobuf:"bytes,7,opt,name=agent_type" json:"agent_type,omitempty"`
+	Liveness    string `protobuf:"bytes,8,opt,name=liveness" json:"liveness,omitempty"`
+	Keyword     string `protobuf:"bytes,9,opt,name=keyword" json:"keyword,omitempty"`
+	SortBy      string `protobuf:"bytes,10,opt,name=sort_by" json:"sort_by,omitempty"`
+	Order       string `protobuf:"bytes,11,opt,name=order" json:"order,omitempty"`
+	Page        int32  `protobuf:"varint,12,opt,name=page" json:"page,omitempty"`
+	Size        int32  `protobuf:"varint,13,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *AgentMetadataListRequest) Reset()                    { *m = AgentMetadataListRequest{} }
+func (m *AgentMetadataListRequest) String() string            { return proto.CompactTextString(m) }
+func (*AgentMetadataListRequest) ProtoMessage()               {}
+func (*AgentMetadataListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *AgentMetadataListRequest) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *AgentMetadataListRequest) GetMcisId() string {
+	if m != nil {
+		return m.McisId
+	}
+	return ""
+}
+
+func (m *AgentMetadataListRequest) GetCspType() string {
+	if m != nil {
+		return m.CspType
+	}
+	return ""
+}
+
+func (m *AgentMetadataListRequest) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
+func (m *AgentMetadataListRequest) GetAgentState() string {
+	if m != nil {
+		return m.AgentState
+	}
+	return ""
+}
+
+func (m *AgentMetadataListRequest) GetAgentHealth() string {
 	if m != nil {
-		return m.BytesOut
+		return m.AgentHealth
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetPktsIn() float64 {
+func (m *AgentMetadataListRequest) GetAgentType() string {
 	if m != nil {
-		return m.PktsIn
+		return m.AgentType
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetPktsOut() float64 {
+func (m *AgentMetadataListRequest) GetLiveness() string {
 	if m != nil {
-		return m.PktsOut
+		return m.Liveness
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetTime() string {
+func (m *AgentMetadataListRequest) GetKeyword() string {
 	if m != nil {
-		return m.Time
+		return m.Keyword
 	}
 	return ""
 }
 
-type MonitoringConfigRequest struct {
-	Item *MonitoringConfigInfo `protobuf:"bytes,1,opt,name=item,json=common" json:"item,omitempty"`
+func (m *AgentMetadataListRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
 }
 
-func (m *MonitoringConfigRequest) Reset()                    { *m = MonitoringConfigRequest{} }
-func (m *MonitoringConfigRequest) String() string            { return proto.CompactTextString(m) }
-func (*MonitoringConfigRequest) ProtoMessage()               {}
-func (*MonitoringConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
-
-func (m *MonitoringConfigRequest) GetItem() *MonitoringConfigInfo {
+func (m *AgentMetadataListRequest) GetOrder() string {
 	if m != nil {
-		return m.Item
+		return m.Order
 	}
-	return nil
+	return ""
 }
 
-type MonitoringConfigResponse struct {
-	Item *MonitoringConfigInfo `protobuf:"bytes,1,opt,name=item,json=common" json:"item,omitempty"`
+func (m *AgentMetadataListRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
 }
 
-func (m *MonitoringConfigResponse) Reset()                    { *m = MonitoringConfigResponse{} }
-func (m *MonitoringConfigResponse) String() string            { return proto.CompactTextString(m) }
-func (*MonitoringConfigResponse) ProtoMessage()               {}
-func (*MonitoringConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
-
-func (m *MonitoringConfigResponse) GetItem() *MonitoringConfigInfo {
+func (m *AgentMetadataListRequest) GetSize() int32 {
 	if m != nil {
-		return m.Item
+		return m.Size
 	}
-	return nil
+	return 0
 }
 
-type MonitoringConfigInfo struct {
-	// 	MCISAgentInterval
-	McisAgentInterval int32 `protobuf:"varint,1,opt,name=mcis_agent_interval" json:"mcis_agent_interval,omitempty"`
-	// 	MCISCollectorInterval
-	McisCollectorInterval int32  `protobuf:"varint,2,opt,name=mcis_collector_interval" json:"mcis_collector_interval,omitempty"`
-	MaxHostCount          int32  `protobuf:"varint,3,opt,name=max_host_count" json:"max_host_count,omitempty"`
-	MonitoringPolicy      string `protobuf:"bytes,4,opt,name=monitoring_policy" json:"monitoring_policy,omitempty"`
+type AgentMetadataListResponse struct {
+	Total   int32                 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	Page    int32                 `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
+	Size    int32                 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Items   []*AgentMetadataInfo  `protobuf:"bytes,4,rep,name=items" json:"items,omitempty"`
+	Summary *AgentMetadataSummary `protobuf:"bytes,5,opt,name=summary" json:"summary,omitempty"`
 }
 
-func (m *MonitoringConfigInfo) Reset()                    { *m = MonitoringConfigInfo{} }
-func (m *MonitoringConfigInfo) String() string            { return proto.CompactTextString(m) }
-func (*MonitoringConfigInfo) ProtoMessage()               {}
-func (*MonitoringConfigInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (m *AgentMetadataListResponse) Reset()                    { *m = AgentMetadataListResponse{} }
+func (m *AgentMetadataListResponse) String() string            { return proto.CompactTextString(m) }
+func (*AgentMetadataListResponse) ProtoMessage()               {}
+func (*AgentMetadataListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
-func (m *MonitoringConfigInfo) GetMcisAgentInterval() int32 {
+func (m *AgentMetadataListResponse) GetTotal() int32 {
 	if m != nil {
-		return m.McisAgentInterval
+		return m.Total
 	}
 	return 0
 }
 
-func (m *MonitoringConfigInfo) GetMcisCollectorInterval() int32 {
+func (m *AgentMetadataListResponse) GetPage() int32 {
 	if m != nil {
-		return m.McisCollectorInterval
+		return m.Page
 	}
 	return 0
 }
 
-func (m *MonitoringConfigInfo) GetMaxHostCount() int32 {
+func (m *AgentMetadataListResponse) GetSize() int32 {
 	if m != nil {
-		return m.MaxHostCount
+		return m.Size
 	}
 	return 0
 }
 
-func (m *MonitoringConfigInfo) GetMonitoringPolicy() string {
+func (m *AgentMetadataListResponse) GetItems() []*AgentMetadataInfo {
 	if m != nil {
-		return m.MonitoringPolicy
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-type InstallAgentRequest struct {
-	NsId         string `protobuf:"bytes,1,opt,name=ns_id" json:"ns_id,omitempty"`
-	McisId       string `protobuf:"bytes,2,opt,name=mcis_id" json:"mcis_id,omitempty"`
-	VmId         string `protobuf:"bytes,3,opt,name=vm_id" json:"vm_id,omitempty"`
-	PublicIp     string `protobuf:"bytes,4,opt,name=public_ip" json:"public_ip,omitempty"`
-	UserName     string `protobuf:"bytes,5,opt,name=user_name" json:"user_name,omitempty"`
-	SshKey       string `protobuf:"bytes,6,opt,name=ssh_key" json:"ssh_key,omitempty"`
-	CspType      string `protobuf:"bytes,7,opt,name=csp_type" json:"csp_type,omitempty"`
-	Port         string `protobuf:"bytes,8,opt,name=port" json:"port,omitempty"`
-	ServiceType  string `protobuf:"bytes,9,opt,name=service_type" json:"service_type,omitempty"`
-	Mck8SId      string `protobuf:"bytes,10,opt,name=mck8s_id" json:"mck8s_id,omitempty"`
-	ApiserverUrl string `protobuf:"bytes,11,opt,name=apiserver_url" json:"apiserver_url,omitempty"`
-	ServerCa     string `protobuf:"bytes,12,opt,name=server_ca" json:"server_ca,omitempty"`
-	ClientCa     string `protobuf:"bytes,13,opt,name=client_ca" json:"client_ca,omitempty"`
-	ClientKey    string `protobuf:"bytes,14,opt,name=client_key" json:"client_key,omitempty"`
-	ClientToken  string `protobuf:"bytes,15,opt,name=client_token" json:"client_token,omitempty"`
+func (m *AgentMetadataListResponse) GetSummary() *AgentMetadataSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
 }
 
-func (m *InstallAgentRequest) Reset()                    { *m = InstallAgentRequest{} }
-func (m *InstallAgentRequest) String() string            { return proto.CompactTextString(m) }
-func (*InstallAgentRequest) ProtoMessage()               {}
-func (*InstallAgentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+type AgentMetadataInfo struct {
+	ServiceType           string `protobuf:"bytes,1,opt,name=service_type" json:"service_type,omitempty"`
+	NsId                  string `protobuf:"bytes,2,opt,name=ns_id" json:"ns_id,omitempty"`
+	McisId                string `protobuf:"bytes,3,opt,name=mcis_id" json:"mcis_id,omitempty"`
+	VmId                  string `protobuf:"bytes,4,opt,name=vm_id" json:"vm_id,omitempty"`
+	CspType               string `protobuf:"bytes,5,opt,name=cspType" json:"cspType,omitempty"`
+	AgentType             string `protobuf:"bytes,6,opt,name=agent_type" json:"agent_type,omitempty"`
+	AgentState            string `protobuf:"bytes,7,opt,name=agent_state" json:"agent_state,omitempty"`
+	AgentHealth           string `protobuf:"bytes,8,opt,name=agent_health" json:"agent_health,omitempty"`
+	AgentUnhealthyRespCnt int32  `protobuf:"varint,9,opt,name=agent_unhealthy_resp_cnt" json:"agent_unhealthy_resp_cnt,omitempty"`
+	PublicIp              string `protobuf:"bytes,10,opt,name=public_ip" json:"public_ip,omitempty"`
+	Mck8SId               string `protobuf:"bytes,11,opt,name=mck8s_id" json:"mck8s_id,omitempty"`
+	LastSeen              int64  `protobuf:"varint,12,opt,name=last_seen" json:"last_seen,omitempty"`
+	Liveness              string `protobuf:"bytes,13,opt,name=liveness" json:"liveness,omitempty"`
+	Profile               string `protobuf:"bytes,14,opt,name=profile" json:"profile,omitempty"`
+	Region                string `protobuf:"bytes,15,opt,name=region" json:"region,omitempty"`
+	Transport             string `protobuf:"bytes,16,opt,name=transport" json:"transport,omitempty"`
+	Orphaned              bool   `protobuf:"varint,17,opt,name=orphaned" json:"orphaned,omitempty"`
+	OrphanedAt            int64  `protobuf:"varint,18,opt,name=orphaned_at" json:"orphaned_at,omitempty"`
+}
+
+func (m *AgentMetadataInfo) Reset()                    { *m = AgentMetadataInfo{} }
+func (m *AgentMetadataInfo) String() string            { return proto.CompactTextString(m) }
+func (*AgentMetadataInfo) ProtoMessage()               {}
+func (*AgentMetadataInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *AgentMetadataInfo) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
 
-func (m *InstallAgentRequest) GetNsId() string {
+func (m *AgentMetadataInfo) GetNsId() string {
 	if m != nil {
 		return m.NsId
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetMcisId() string {
+func (m *AgentMetadataInfo) GetMcisId() string {
 	if m != nil {
 		return m.McisId
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetVmId() string {
+func (m *AgentMetadataInfo) GetVmId() string {
 	if m != nil {
 		return m.VmId
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetPublicIp() string {
+func (m *AgentMetadataInfo) GetCspType() string {
 	if m != nil {
-		return m.PublicIp
+		return m.CspType
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetUserName() string {
+func (m *AgentMetadataInfo) GetAgentType() string {
 	if m != nil {
-		return m.UserName
+		return m.AgentType
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetSshKey() string {
+func (m *AgentMetadataInfo) GetAgentState() string {
 	if m != nil {
-		return m.SshKey
+		return m.AgentState
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetCspType() string {
+func (m *AgentMetadataInfo) GetAgentHealth() string {
 	if m != nil {
-		return m.CspType
+		return m.AgentHealth
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetPort() string {
+func (m *AgentMetadataInfo) GetAgentUnhealthyRespCnt() int32 {
 	if m != nil {
-		return m.Port
+		return m.AgentUnhealthyRespCnt
 	}
-	return ""
+	return 0
 }
 
-func (m *InstallAgentRequest) GetServiceType() string {
+func (m *AgentMetadataInfo) GetPublicIp() string {
 	if m != nil {
-		return m.ServiceType
+		return m.PublicIp
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetMck8SId() string {
+func (m *AgentMetadataInfo) GetMck8SId() string {
 	if m != nil {
 		return m.Mck8SId
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetApiserverUrl() string {
+func (m *AgentMetadataInfo) GetLastSeen() int64 {
 	if m != nil {
-		return m.ApiserverUrl
+		return m.LastSeen
 	}
-	return ""
+	return 0
 }
 
-func (m *InstallAgentRequest) GetServerCa() string {
+func (m *AgentMetadataInfo) GetLiveness() string {
 	if m != nil {
-		return m.ServerCa
+		return m.Liveness
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetClientCa() string {
+func (m *AgentMetadataInfo) GetProfile() string {
 	if m != nil {
-		return m.ClientCa
+		return m.Profile
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetClientKey() string {
+func (m *AgentMetadataInfo) GetRegion() string {
 	if m != nil {
-		return m.ClientKey
+		return m.Region
 	}
 	return ""
 }
 
-func (m *InstallAgentRequest) GetClientToken() string {
+func (m *AgentMetadataInfo) GetTransport() string {
 	if m != nil {
-		return m.ClientToken
+		return m.Transport
 	}
 	return ""
 }
 
+func (m *AgentMetadataInfo) GetOrphaned() bool {
+	if m != nil {
+		return m.Orphaned
+	}
+	return false
+}
+
+func (m *AgentMetadataInfo) GetOrphanedAt() int64 {
+	if m != nil {
+		return m.OrphanedAt
+	}
+	return 0
+}
+
+type AgentMetadataSummary struct {
+	Total     int32                        `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	Healthy   int32                        `protobuf:"varint,2,opt,name=healthy" json:"healthy,omitempty"`
+	Unhealthy int32                        `protobuf:"varint,3,opt,name=unhealthy" json:"unhealthy,omitempty"`
+	Csp       map[string]*AgentHealthCount `protobuf:"bytes,4,rep,name=csp" json:"csp,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *AgentMetadataSummary) Reset()                    { *m = AgentMetadataSummary{} }
+func (m *AgentMetadataSummary) String() string            { return proto.CompactTextString(m) }
+func (*AgentMetadataSummary) ProtoMessage()               {}
+func (*AgentMetadataSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *AgentMetadataSummary) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *AgentMetadataSummary) GetHealthy() int32 {
+	if m != nil {
+		return m.Healthy
+	}
+	return 0
+}
+
+func (m *AgentMetadataSummary) GetUnhealthy() int32 {
+	if m != nil {
+		return m.Unhealthy
+	}
+	return 0
+}
+
+func (m *AgentMetadataSummary) GetCsp() map[string]*AgentHealthCount {
+	if m != nil {
+		return m.Csp
+	}
+	return nil
+}
+
+type AgentHealthCount struct {
+	Total     int32 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	Healthy   int32 `protobuf:"varint,2,opt,name=healthy" json:"healthy,omitempty"`
+	Unhealthy int32 `protobuf:"varint,3,opt,name=unhealthy" json:"unhealthy,omitempty"`
+}
+
+func (m *AgentHealthCount) Reset()                    { *m = AgentHealthCount{} }
+func (m *AgentHealthCount) String() string            { return proto.CompactTextString(m) }
+func (*AgentHealthCount) ProtoMessage()               {}
+func (*AgentHealthCount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *AgentHealthCount) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *AgentHealthCount) GetHealthy() int32 {
+	if m != nil {
+		return m.Healthy
+	}
+	return 0
+}
+
+func (m *AgentHealthCount) GetUnhealthy() int32 {
+	if m != nil {
+		return m.Unhealthy
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "cbdragonfly.Empty")
 	proto.RegisterType((*MessageResponse)(nil), "cbdragonfly.MessageResponse")
@@ -1537,6 +2686,14 @@ func init() {
 	proto.RegisterType((*NetworkOnDemandInfoResponse)(nil), "cbdragonfly.NetworkOnDemandInfoResponse")
 	proto.RegisterType((*NetworkOnDemandInfo)(nil), "cbdragonfly.NetworkOnDemandInfo")
 	proto.RegisterType((*MCISMonInfoResponse)(nil), "cbdragonfly.MCISMonInfoResponse")
+	proto.RegisterType((*MCISServiceMonQryRequest)(nil), "cbdragonfly.MCISServiceMonQryRequest")
+	proto.RegisterType((*MCISServiceMonInfoResponse)(nil), "cbdragonfly.MCISServiceMonInfoResponse")
+	proto.RegisterType((*MCISServiceSummary)(nil), "cbdragonfly.MCISServiceSummary")
+	proto.RegisterType((*VMServiceMonInfo)(nil), "cbdragonfly.VMServiceMonInfo")
+	proto.RegisterType((*VMServiceMetric)(nil), "cbdragonfly.VMServiceMetric")
+	proto.RegisterType((*MCISRightsizingQryRequest)(nil), "cbdragonfly.MCISRightsizingQryRequest")
+	proto.RegisterType((*MCISRightsizingResponse)(nil), "cbdragonfly.MCISRightsizingResponse")
+	proto.RegisterType((*VMRightsizing)(nil), "cbdragonfly.VMRightsizing")
 	proto.RegisterType((*CpuInfoResponse)(nil), "cbdragonfly.CpuInfoResponse")
 	proto.RegisterType((*CpuInfo)(nil), "cbdragonfly.CpuInfo")
 	proto.RegisterType((*CpuFreqInfoResponse)(nil), "cbdragonfly.CpuFreqInfoResponse")
@@ -1551,6 +2708,11 @@ func init() {
 	proto.RegisterType((*MonitoringConfigResponse)(nil), "cbdragonfly.MonitoringConfigResponse")
 	proto.RegisterType((*MonitoringConfigInfo)(nil), "cbdragonfly.MonitoringConfigInfo")
 	proto.RegisterType((*InstallAgentRequest)(nil), "cbdragonfly.InstallAgentRequest")
+	proto.RegisterType((*AgentMetadataListRequest)(nil), "cbdragonfly.AgentMetadataListRequest")
+	proto.RegisterType((*AgentMetadataListResponse)(nil), "cbdragonfly.AgentMetadataListResponse")
+	proto.RegisterType((*AgentMetadataInfo)(nil), "cbdragonfly.AgentMetadataInfo")
+	proto.RegisterType((*AgentMetadataSummary)(nil), "cbdragonfly.AgentMetadataSummary")
+	proto.RegisterType((*AgentHealthCount)(nil), "cbdragonfly.AgentHealthCount")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1566,6 +2728,11 @@ const _ = grpc.SupportPackageIsVersion4
 type MONClient interface {
 	// TODO: MCIS 모니터링 조회
 	GetMCISMonInfo(ctx context.Context, in *VMMCISMonQryRequest, opts ...grpc.CallOption) (*MCISMonInfoResponse, error)
+	// MCIS 서비스 모니터링 조회
+	GetMCISServiceMonInfo(ctx context.Context, in *MCISServiceMonQryRequest, opts ...grpc.CallOption) (*MCISServiceMonInfoResponse, error)
+	GetMCISServiceRealtimeMonInfo(ctx context.Context, in *MCISServiceMonQryRequest, opts ...grpc.CallOption) (*MCISServiceMonInfoResponse, error)
+	// MCIS 스펙 추천 조회
+	GetMCISRightsizing(ctx context.Context, in *MCISRightsizingQryRequest, opts ...grpc.CallOption) (*MCISRightsizingResponse, error)
 	// VM 온디멘드 모니터링 조회
 	GetVMOnDemandMonCpuInfo(ctx context.Context, in *VMOnDemandMonQryRequest, opts ...grpc.CallOption) (*CpuOnDemandInfoResponse, error)
 	GetVMOnDemandMonCpuFreqInfo(ctx context.Context, in *VMOnDemandMonQryRequest, opts ...grpc.CallOption) (*CpuFreqOnDemandInfoResponse, error)
@@ -1582,6 +2749,7 @@ type MONClient interface {
 	GetMonConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MonitoringConfigResponse, error)
 	ResetMonConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MonitoringConfigResponse, error)
 	InstallAgent(ctx context.Context, in *InstallAgentRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ListAgentMetadata(ctx context.Context, in *AgentMetadataListRequest, opts ...grpc.CallOption) (*AgentMetadataListResponse, error)
 }
 
 type mONClient struct {
@@ -1601,6 +2769,33 @@ func (c *mONClient) GetMCISMonInfo(ctx context.Context, in *VMMCISMonQryRequest,
 	return out, nil
 }
 
+func (c *mONClient) GetMCISServiceMonInfo(ctx context.Context, in *MCISServiceMonQryRequest, opts ...grpc.CallOption) (*MCISServiceMonInfoResponse, error) {
+	out := new(MCISServiceMonInfoResponse)
+	err := grpc.Invoke(ctx, "/cbdragonfly.MON/GetMCISServiceMonInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mONClient) GetMCISServiceRealtimeMonInfo(ctx context.Context, in *MCISServiceMonQryRequest, opts ...grpc.CallOption) (*MCISServiceMonInfoResponse, error) {
+	out := new(MCISServiceMonInfoResponse)
+	err := grpc.Invoke(ctx, "/cbdragonfly.MON/GetMCISServiceRealtimeMonInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mONClient) GetMCISRightsizing(ctx context.Context, in *MCISRightsizingQryRequest, opts ...grpc.CallOption) (*MCISRightsizingResponse, error) {
+	out := new(MCISRightsizingResponse)
+	err := grpc.Invoke(ctx, "/cbdragonfly.MON/GetMCISRightsizing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mONClient) GetVMOnDemandMonCpuInfo(ctx context.Context, in *VMOnDemandMonQryRequest, opts ...grpc.CallOption) (*CpuOnDemandInfoResponse, error) {
 	out := new(CpuOnDemandInfoResponse)
 	err := grpc.Invoke(ctx, "/cbdragonfly.MON/GetVMOnDemandMonCpuInfo", in, out, c.cc, opts...)
@@ -1727,11 +2922,25 @@ func (c *mONClient) InstallAgent(ctx context.Context, in *InstallAgentRequest, o
 	return out, nil
 }
 
+func (c *mONClient) ListAgentMetadata(ctx context.Context, in *AgentMetadataListRequest, opts ...grpc.CallOption) (*AgentMetadataListResponse, error) {
+	out := new(AgentMetadataListResponse)
+	err := grpc.Invoke(ctx, "/cbdragonfly.MON/ListAgentMetadata", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MON service
 
 type MONServer interface {
 	// TODO: MCIS 모니터링 조회
 	GetMCISMonInfo(context.Context, *VMMCISMonQryRequest) (*MCISMonInfoResponse, error)
+	// MCIS 서비스 모니터링 조회
+	GetMCISServiceMonInfo(context.Context, *MCISServiceMonQryRequest) (*MCISServiceMonInfoResponse, error)
+	GetMCISServiceRealtimeMonInfo(context.Context, *MCISServiceMonQryRequest) (*MCISServiceMonInfoResponse, error)
+	// MCIS 스펙 추천 조회
+	GetMCISRightsizing(context.Context, *MCISRightsizingQryRequest) (*MCISRightsizingResponse, error)
 	// VM 온디멘드 모니터링 조회
 	GetVMOnDemandMonCpuInfo(context.Context, *VMOnDemandMonQryRequest) (*CpuOnDemandInfoResponse, error)
 	GetVMOnDemandMonCpuFreqInfo(context.Context, *VMOnDemandMonQryRequest) (*CpuFreqOnDemandInfoResponse, error)
@@ -1748,6 +2957,7 @@ type MONServer interface {
 	GetMonConfig(context.Context, *Empty) (*MonitoringConfigResponse, error)
 	ResetMonConfig(context.Context, *Empty) (*MonitoringConfigResponse, error)
 	InstallAgent(context.Context, *InstallAgentRequest) (*MessageResponse, error)
+	ListAgentMetadata(context.Context, *AgentMetadataListRequest) (*AgentMetadataListResponse, error)
 }
 
 func RegisterMONServer(s *grpc.Server, srv MONServer) {
@@ -1772,6 +2982,60 @@ func _MON_GetMCISMonInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MON_GetMCISServiceMonInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MCISServiceMonQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MONServer).GetMCISServiceMonInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbdragonfly.MON/GetMCISServiceMonInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MONServer).GetMCISServiceMonInfo(ctx, req.(*MCISServiceMonQryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MON_GetMCISServiceRealtimeMonInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MCISServiceMonQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MONServer).GetMCISServiceRealtimeMonInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbdragonfly.MON/GetMCISServiceRealtimeMonInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MONServer).GetMCISServiceRealtimeMonInfo(ctx, req.(*MCISServiceMonQryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MON_GetMCISRightsizing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MCISRightsizingQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MONServer).GetMCISRightsizing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbdragonfly.MON/GetMCISRightsizing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MONServer).GetMCISRightsizing(ctx, req.(*MCISRightsizingQryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MON_GetVMOnDemandMonCpuInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VMOnDemandMonQryRequest)
 	if err := dec(in); err != nil {
@@ -2024,6 +3288,24 @@ func _MON_InstallAgent_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MON_ListAgentMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentMetadataListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MONServer).ListAgentMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbdragonfly.MON/ListAgentMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MONServer).ListAgentMetadata(ctx, req.(*AgentMetadataListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MON_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cbdragonfly.MON",
 	HandlerType: (*MONServer)(nil),
@@ -2032,6 +3314,18 @@ var _MON_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMCISMonInfo",
 			Handler:    _MON_GetMCISMonInfo_Handler,
 		},
+		{
+			MethodName: "GetMCISServiceMonInfo",
+			Handler:    _MON_GetMCISServiceMonInfo_Handler,
+		},
+		{
+			MethodName: "GetMCISServiceRealtimeMonInfo",
+			Handler:    _MON_GetMCISServiceRealtimeMonInfo_Handler,
+		},
+		{
+			MethodName: "GetMCISRightsizing",
+			Handler:    _MON_GetMCISRightsizing_Handler,
+		},
 		{
 			MethodName: "GetVMOnDemandMonCpuInfo",
 			Handler:    _MON_GetVMOnDemandMonCpuInfo_Handler,
@@ -2088,6 +3382,10 @@ var _MON_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InstallAgent",
 			Handler:    _MON_InstallAgent_Handler,
 		},
+		{
+			MethodName: "ListAgentMetadata",
+			Handler:    _MON_ListAgentMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cbdragonfly/cbdragonfly.proto",
@@ -2096,161 +3394,274 @@ var _MON_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("cbdragonfly/cbdragonfly.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x8f, 0x1b, 0x59,
-	0x11, 0x8f, 0x3d, 0xe3, 0xf9, 0x78, 0x9e, 0xcd, 0x24, 0x3d, 0x59, 0xc6, 0x3b, 0x49, 0xe8, 0xec,
-	0x63, 0x57, 0x1b, 0xb4, 0xb0, 0x11, 0xc9, 0x22, 0x10, 0x2b, 0x88, 0xc8, 0x04, 0xa2, 0x2c, 0x78,
-	0x43, 0x5e, 0xb2, 0xd9, 0x03, 0x42, 0x56, 0x4f, 0xfb, 0x8d, 0xd3, 0x1a, 0xbb, 0xbb, 0xb7, 0xbb,
-	0x9d, 0x60, 0xb8, 0x22, 0x04, 0x12, 0xe2, 0xc2, 0x85, 0x23, 0x57, 0x2e, 0x7b, 0xe0, 0xc0, 0x5f,
-	0xc0, 0x05, 0x71, 0x40, 0x5c, 0x38, 0xf6, 0x1f, 0xe0, 0x1b, 0xbe, 0xc2, 0x01, 0x55, 0xbd, 0x8f,
-	0x7a, 0xdd, 0xf6, 0xcc, 0x3a, 0x7c, 0x28, 0x0c, 0xc9, 0xc9, 0xaf, 0x7e, 0xf5, 0xaa, 0xea, 0x75,
-	0x55, 0xbd, 0x7a, 0xaf, 0xda, 0xcd, 0x2e, 0x87, 0x07, 0xfd, 0x2c, 0x18, 0x24, 0xf1, 0xe1, 0x70,
-	0x72, 0xcd, 0x19, 0xbf, 0x93, 0x66, 0x49, 0x91, 0x78, 0x6d, 0x07, 0xda, 0xbb, 0x30, 0x48, 0x06,
-	0x09, 0xe2, 0xd7, 0x60, 0xa4, 0xa6, 0xf0, 0x75, 0xd6, 0xfa, 0xd6, 0x28, 0x2d, 0x26, 0xfc, 0x7d,
-	0xb6, 0xdd, 0x95, 0x79, 0x1e, 0x0c, 0xa4, 0x90, 0x79, 0x9a, 0xc4, 0xb9, 0xf4, 0xbe, 0xc2, 0xd6,
-	0x47, 0x0a, 0xea, 0x34, 0xae, 0x34, 0xae, 0x6e, 0xde, 0xba, 0x3c, 0x2d, 0x7d, 0x03, 0xcd, 0x4a,
-	0xff, 0xec, 0x24, 0x18, 0x0d, 0xbf, 0xc6, 0x35, 0xc0, 0x85, 0x61, 0xf1, 0x5f, 0x37, 0xd8, 0xea,
-	0xc3, 0x60, 0x90, 0x7b, 0x5f, 0x60, 0xad, 0x38, 0xef, 0x45, 0x7d, 0x2d, 0xbf, 0x3b, 0x2d, 0xfd,
-	0xd5, 0x38, 0xbf, 0xdb, 0x9f, 0x95, 0x7e, 0x5b, 0x09, 0x03, 0xc5, 0x05, 0x82, 0xde, 0xbb, 0x6c,
-	0x7d, 0x14, 0x46, 0x38, 0xbf, 0x89, 0xf3, 0x2f, 0x4e, 0x4b, 0x7f, 0x0d, 0x20, 0x94, 0x78, 0x45,
-	0x9b, 0x43, 0x9a, 0x0b, 0xcd, 0x00, 0x1b, 0x4f, 0x46, 0x20, 0xb3, 0x42, 0x36, 0x9e, 0x8c, 0x5c,
-	0x1b, 0x40, 0x71, 0x81, 0x20, 0xff, 0xeb, 0x0a, 0xdb, 0x7e, 0xd4, 0xed, 0x26, 0xf1, 0xfd, 0x6c,
-	0x22, 0xe4, 0xc7, 0x63, 0x99, 0x17, 0xde, 0xb5, 0xea, 0x2a, 0x5f, 0x9b, 0x96, 0xbe, 0x02, 0x66,
-	0xa5, 0xbf, 0x65, 0x96, 0xd9, 0x8b, 0xfa, 0x5c, 0x28, 0x18, 0x1d, 0x53, 0x59, 0xa8, 0x72, 0x8c,
-	0x82, 0x1c, 0xc7, 0x28, 0x00, 0x1c, 0xa3, 0x46, 0x60, 0xc9, 0x5d, 0x2b, 0x5a, 0x42, 0x80, 0x2c,
-	0x21, 0xc9, 0x85, 0x82, 0xbd, 0xdb, 0xac, 0x9d, 0xca, 0x2c, 0x4a, 0xfa, 0xbd, 0x62, 0x92, 0xca,
-	0xce, 0x2a, 0x8a, 0x7d, 0x6e, 0x5a, 0xfa, 0x4c, 0xc1, 0x0f, 0x27, 0x29, 0x44, 0xe2, 0xbc, 0x92,
-	0x25, 0x8c, 0x0b, 0x67, 0x82, 0xd7, 0x67, 0x3b, 0x79, 0x11, 0x14, 0x51, 0x5e, 0x44, 0x61, 0xde,
-	0x0b, 0xb3, 0xa8, 0x90, 0x59, 0x14, 0x74, 0x5a, 0xa8, 0xed, 0xc6, 0xb4, 0xf4, 0x3d, 0x62, 0xef,
-	0x6b, 0xee, 0xac, 0xf4, 0x5f, 0x53, 0x5a, 0xe7, 0x79, 0x5c, 0x2c, 0x10, 0xf0, 0xde, 0x63, 0x1b,
-	0xfd, 0x71, 0x16, 0x14, 0x51, 0x12, 0x77, 0xd6, 0x50, 0xb5, 0x3f, 0x2d, 0x7d, 0x8b, 0xcd, 0x4a,
-	0x7f, 0x5b, 0x29, 0x34, 0x08, 0x17, 0x96, 0xe9, 0xdd, 0x64, 0x5b, 0xb9, 0xcc, 0x9e, 0x44, 0xa1,
-	0x54, 0x4f, 0xba, 0xfe, 0x8c, 0x0a, 0xf8, 0xdf, 0x1a, 0x6c, 0xf7, 0x51, 0xf7, 0x5e, 0x7c, 0x5b,
-	0x8e, 0x82, 0xb8, 0x7f, 0x6a, 0x02, 0xfc, 0x1e, 0xdb, 0x08, 0x06, 0x32, 0x2e, 0x7a, 0x51, 0xaa,
-	0xa3, 0x8b, 0xcf, 0x6c, 0x30, 0x7a, 0x66, 0x83, 0x70, 0x61, 0x99, 0xfc, 0xf7, 0x4d, 0xb6, 0xf3,
-	0xa8, 0xdb, 0xdd, 0xbf, 0xfb, 0xe0, 0x85, 0x78, 0x5e, 0xef, 0x0e, 0x6b, 0x8f, 0x64, 0x91, 0x45,
-	0x61, 0x2f, 0x0e, 0x46, 0x52, 0xe7, 0xef, 0x9b, 0xd3, 0xd2, 0x77, 0xe1, 0x59, 0xe9, 0x7b, 0xa6,
-	0x30, 0x59, 0x90, 0x0b, 0x77, 0x0a, 0xff, 0x69, 0x93, 0xed, 0xee, 0xa7, 0x63, 0x93, 0x2d, 0x77,
-	0xe3, 0xc3, 0xc4, 0x56, 0xbd, 0xb7, 0xd9, 0x2a, 0x6a, 0x77, 0x4b, 0x96, 0x52, 0x6b, 0x4a, 0x16,
-	0xea, 0x43, 0xd0, 0xfb, 0x06, 0x5b, 0x2d, 0x82, 0x41, 0x8e, 0x5e, 0x6b, 0x5f, 0x3f, 0xff, 0x8e,
-	0x5b, 0x83, 0xa1, 0x02, 0x2a, 0x79, 0x98, 0x42, 0xf2, 0x40, 0x71, 0x81, 0x20, 0x18, 0x2b, 0xa2,
-	0x91, 0x74, 0x6b, 0x17, 0xd0, 0xce, 0xe4, 0x08, 0x8d, 0xc1, 0x8f, 0x77, 0x9f, 0xad, 0x3d, 0x09,
-	0x86, 0x63, 0x99, 0xa3, 0xe7, 0xda, 0xd7, 0x2f, 0x55, 0xcc, 0xd5, 0x9e, 0x47, 0x15, 0x4f, 0x35,
-	0x9f, 0x8a, 0xa7, 0xa2, 0xb9, 0xd0, 0x0c, 0xfe, 0x93, 0x35, 0xb6, 0x5d, 0x13, 0xf4, 0x3e, 0x62,
-	0xdb, 0x61, 0x3a, 0xee, 0x8d, 0x8b, 0x68, 0x18, 0xfd, 0x48, 0x6d, 0x67, 0xf0, 0x45, 0xe3, 0xd6,
-	0x17, 0xa7, 0xa5, 0x5f, 0x67, 0xcd, 0x4a, 0xff, 0x33, 0x4a, 0x75, 0x8d, 0xc1, 0x45, 0x7d, 0xaa,
-	0xb7, 0xcf, 0x18, 0x40, 0xf9, 0x24, 0x2f, 0xe4, 0x08, 0x5d, 0xd6, 0x50, 0xb5, 0x8c, 0x50, 0xaa,
-	0x65, 0x84, 0x71, 0xe1, 0x4c, 0x80, 0x04, 0x02, 0x2a, 0xea, 0x0f, 0x95, 0xd7, 0x1a, 0x2a, 0x81,
-	0x0c, 0x46, 0x09, 0x64, 0x10, 0x2e, 0x2c, 0xd3, 0xac, 0x20, 0x4a, 0x9e, 0x06, 0x51, 0x81, 0x5e,
-	0x74, 0x56, 0xa0, 0xd0, 0xea, 0x0a, 0x14, 0xa6, 0x57, 0xa0, 0x08, 0xef, 0x26, 0xdb, 0x04, 0xea,
-	0x71, 0x14, 0x17, 0x19, 0xe6, 0x60, 0xe3, 0xd6, 0xeb, 0xd3, 0xd2, 0x27, 0x70, 0x56, 0xfa, 0xe7,
-	0x48, 0x05, 0x42, 0x5c, 0x10, 0xdb, 0x28, 0xc8, 0x51, 0xc1, 0x5a, 0x55, 0x41, 0x3e, 0xaf, 0x20,
-	0x77, 0x14, 0xe0, 0xd8, 0xf8, 0x60, 0x9c, 0xcb, 0x0c, 0x0b, 0xa5, 0xe3, 0x03, 0xc0, 0xaa, 0x3e,
-	0x00, 0x44, 0xfb, 0x00, 0x86, 0x46, 0x38, 0x8e, 0x42, 0xd9, 0xd9, 0xa8, 0x0a, 0x03, 0x56, 0x15,
-	0x06, 0x44, 0x0b, 0xc3, 0xd0, 0x2e, 0xbd, 0x90, 0xc1, 0xb0, 0xb3, 0x59, 0x5b, 0x3a, 0x80, 0xb5,
-	0xa5, 0x03, 0x64, 0x96, 0x0e, 0x63, 0xa3, 0x60, 0x00, 0x75, 0xaa, 0xc3, 0xaa, 0x0a, 0x10, 0xac,
-	0x2a, 0x40, 0x48, 0x2b, 0xc0, 0xb1, 0xf7, 0x80, 0x9d, 0xb5, 0x84, 0x7a, 0x88, 0x36, 0x6a, 0x79,
-	0x7b, 0x5a, 0xfa, 0x35, 0xce, 0xac, 0xf4, 0x5f, 0xad, 0xa9, 0xd2, 0x0f, 0x54, 0x9b, 0xc8, 0x7f,
-	0xd9, 0x64, 0x17, 0xf7, 0xd3, 0xf1, 0xb7, 0x33, 0xf9, 0xf1, 0x29, 0xab, 0x09, 0x1f, 0xd6, 0x6a,
-	0xc2, 0x95, 0x7a, 0x4d, 0xa8, 0x3f, 0xd3, 0x72, 0x75, 0xe1, 0x11, 0xdb, 0x59, 0x20, 0x6b, 0xc3,
-	0x9f, 0x4a, 0xd9, 0xd7, 0x45, 0x81, 0xc2, 0x0f, 0x60, 0x2d, 0xfc, 0x00, 0x99, 0xf0, 0xe3, 0xf8,
-	0x17, 0x4d, 0xb6, 0xd7, 0x95, 0xa3, 0x24, 0x9b, 0x9c, 0x32, 0x3f, 0x3f, 0xac, 0xf9, 0xd9, 0xaf,
-	0x98, 0x9b, 0x7f, 0xa4, 0xe5, 0xdc, 0xfc, 0xf7, 0x15, 0xe6, 0xcd, 0xcb, 0x42, 0x05, 0x1e, 0xc9,
-	0xd1, 0x71, 0x15, 0xb8, 0xc6, 0xa2, 0x0a, 0x5c, 0x63, 0x70, 0x51, 0x9f, 0x0a, 0xf1, 0x03, 0xa8,
-	0x48, 0x8a, 0x60, 0xa8, 0x0b, 0x30, 0xc6, 0xcf, 0x82, 0x14, 0x3f, 0x0b, 0x71, 0x41, 0x6c, 0x28,
-	0x1e, 0xa8, 0x33, 0x97, 0x7d, 0xb7, 0xfa, 0x1a, 0x8c, 0x8a, 0x87, 0x41, 0xb8, 0xb0, 0x4c, 0x23,
-	0x7c, 0x98, 0x49, 0xa9, 0x6b, 0xaf, 0x15, 0x06, 0xac, 0x2a, 0x0c, 0x88, 0x16, 0x86, 0x21, 0x94,
-	0x6e, 0x18, 0xe7, 0x8f, 0x83, 0x4c, 0xf6, 0x75, 0xd9, 0xc5, 0xd2, 0x4d, 0x28, 0x95, 0x6e, 0xc2,
-	0xb8, 0x70, 0x26, 0xa8, 0x0b, 0xc4, 0xa8, 0x77, 0x30, 0x3e, 0x3c, 0x94, 0x59, 0xae, 0x6b, 0xaf,
-	0xbe, 0x40, 0x58, 0xd8, 0xbd, 0x40, 0x58, 0x10, 0x2f, 0x10, 0x96, 0x32, 0xab, 0x09, 0x83, 0xf0,
-	0xb1, 0xec, 0xeb, 0x1a, 0x6c, 0x57, 0xa3, 0xd0, 0xea, 0x6a, 0x14, 0xa6, 0x57, 0xa3, 0x89, 0x9f,
-	0x35, 0x59, 0xe7, 0x76, 0x94, 0x1f, 0x9d, 0xb2, 0xad, 0x20, 0x6a, 0x5b, 0xe1, 0x72, 0xc5, 0x5c,
-	0xfd, 0x81, 0x96, 0xdb, 0x08, 0xbf, 0x5b, 0x65, 0xe7, 0xea, 0x92, 0x90, 0xad, 0xfd, 0x28, 0x3f,
-	0x52, 0x09, 0xe3, 0x54, 0x1b, 0x0b, 0x52, 0xb6, 0x5a, 0x88, 0x0b, 0x62, 0x43, 0x94, 0x90, 0x70,
-	0xf3, 0x1d, 0xa3, 0x44, 0x28, 0x45, 0x89, 0x30, 0x2e, 0x9c, 0x09, 0x76, 0x15, 0x4e, 0xce, 0xd3,
-	0x2a, 0x74, 0xd2, 0xbb, 0xab, 0x50, 0x59, 0x4f, 0x6c, 0xef, 0xfb, 0xec, 0x9c, 0x22, 0x9c, 0xed,
-	0xac, 0xd2, 0xff, 0xda, 0xb4, 0xf4, 0xe7, 0x78, 0xb3, 0xd2, 0xdf, 0x75, 0xd5, 0xb9, 0x1b, 0x7a,
-	0x6e, 0x32, 0xdc, 0xdc, 0x8f, 0x0e, 0x7a, 0x99, 0x0c, 0xcc, 0x9e, 0xc0, 0x9b, 0xbb, 0x86, 0xe8,
-	0xe6, 0xae, 0x01, 0x2e, 0x0c, 0x0b, 0x7c, 0x73, 0x74, 0xd0, 0x7b, 0x9a, 0x45, 0x45, 0x21, 0x63,
-	0xbd, 0x13, 0xd0, 0x37, 0x84, 0x92, 0x6f, 0x08, 0xe3, 0xc2, 0x99, 0x00, 0x3b, 0x3a, 0x49, 0x73,
-	0x65, 0xde, 0xb9, 0x88, 0x18, 0x8c, 0x76, 0xb4, 0x41, 0xb8, 0xb0, 0x4c, 0x70, 0x2c, 0x8c, 0x41,
-	0x97, 0xb9, 0x89, 0xa0, 0x63, 0x2d, 0x48, 0x8e, 0xb5, 0x10, 0x17, 0xc4, 0xc6, 0x53, 0xfb, 0x03,
-	0x59, 0x3c, 0x4d, 0xb2, 0xa3, 0xff, 0xab, 0x53, 0x7b, 0xc1, 0x33, 0x2d, 0xb7, 0x8b, 0x7e, 0xde,
-	0x64, 0x3b, 0x0b, 0x84, 0x21, 0x4c, 0x07, 0x93, 0x42, 0xe6, 0xbd, 0xc8, 0x1c, 0x24, 0x18, 0x26,
-	0x83, 0x51, 0x98, 0x0c, 0xc2, 0x85, 0x65, 0x42, 0x98, 0xd4, 0x38, 0x19, 0x17, 0xee, 0x99, 0x61,
-	0x41, 0x0a, 0x93, 0x85, 0xb8, 0x20, 0x36, 0xa4, 0x68, 0x7a, 0x54, 0xa0, 0xf1, 0x15, 0x4a, 0x51,
-	0x0d, 0x51, 0x8a, 0x6a, 0x80, 0x0b, 0xc3, 0x82, 0x65, 0xe3, 0x10, 0x0c, 0x3b, 0xe7, 0x85, 0xc1,
-	0x68, 0xd9, 0x06, 0xe1, 0xc2, 0x32, 0xf9, 0xaf, 0x9a, 0x6c, 0x47, 0x77, 0xc6, 0x95, 0xa4, 0xb8,
-	0xc1, 0xd6, 0x32, 0x99, 0x8f, 0x87, 0x85, 0x4e, 0x0b, 0x74, 0xac, 0x42, 0xc8, 0xb1, 0x8a, 0xe6,
-	0x42, 0x33, 0x20, 0xb8, 0xe3, 0x38, 0x2a, 0x74, 0x73, 0x8c, 0xc1, 0x05, 0x9a, 0x82, 0x0b, 0x14,
-	0x17, 0x08, 0xc2, 0xe4, 0xbe, 0xcc, 0x43, 0x37, 0x13, 0x80, 0xa6, 0xc9, 0x40, 0x71, 0x81, 0x20,
-	0x38, 0x47, 0x0e, 0x83, 0x14, 0x6a, 0xcb, 0x2a, 0x75, 0xde, 0x1a, 0x22, 0xe7, 0x68, 0x80, 0x0b,
-	0xc3, 0xf2, 0xde, 0x65, 0xeb, 0x79, 0x2a, 0x43, 0xe8, 0xbd, 0x5b, 0xf4, 0x20, 0x00, 0x45, 0xce,
-	0xcb, 0x32, 0x45, 0x73, 0xa1, 0x19, 0xfc, 0x8f, 0x0d, 0xec, 0xf7, 0x9e, 0xdf, 0x36, 0xb9, 0x6b,
-	0x33, 0x7f, 0xe5, 0xca, 0xca, 0xd5, 0xf6, 0xf5, 0x0b, 0xf5, 0xfb, 0xea, 0xf2, 0xd9, 0xfe, 0xdb,
-	0x35, 0xb6, 0xae, 0x05, 0x5e, 0xf6, 0xac, 0x2f, 0x7b, 0xd6, 0x17, 0xac, 0x67, 0xb5, 0x07, 0xce,
-	0xd6, 0x12, 0x07, 0x0e, 0xff, 0x4b, 0xc3, 0x36, 0x74, 0xcf, 0x6f, 0xef, 0x77, 0x6b, 0x7b, 0xbf,
-	0xb3, 0xa8, 0x57, 0x5d, 0x7e, 0xff, 0xff, 0x98, 0xb5, 0x1d, 0x99, 0x7f, 0xbb, 0x37, 0xb5, 0x0e,
-	0x6d, 0x2e, 0xe3, 0xd0, 0x3f, 0x37, 0x4c, 0xe7, 0xf6, 0xfc, 0xfc, 0xf9, 0xdd, 0x9a, 0x3f, 0x77,
-	0x17, 0xf4, 0xa4, 0xcb, 0xbb, 0xf3, 0x37, 0xab, 0x8c, 0x91, 0xcc, 0xcb, 0x1e, 0xf4, 0x85, 0xe8,
-	0x41, 0x6d, 0xd2, 0x6f, 0x2c, 0x93, 0xf4, 0x7f, 0x6a, 0xa8, 0x2e, 0xed, 0xf9, 0xa5, 0xfc, 0xfb,
-	0xb5, 0x94, 0x7f, 0x75, 0xae, 0xf7, 0x7c, 0x86, 0xdb, 0x72, 0x8b, 0x6d, 0x18, 0x09, 0x78, 0x0a,
-	0xa7, 0xcd, 0xc4, 0x55, 0xe8, 0x7c, 0xd0, 0xab, 0x50, 0xb9, 0xb0, 0x6a, 0xf2, 0x00, 0x3a, 0x98,
-	0x1e, 0xde, 0x71, 0xdd, 0x4b, 0x01, 0xa1, 0xe4, 0x79, 0xc2, 0xb8, 0x70, 0x26, 0xc0, 0x3e, 0x40,
-	0xca, 0x76, 0x0d, 0x7a, 0x1f, 0x58, 0x90, 0xf6, 0x81, 0x85, 0xb8, 0x20, 0xb6, 0x77, 0x8d, 0xb5,
-	0x80, 0xc8, 0x75, 0x1e, 0xe3, 0x7f, 0x2f, 0x08, 0xd0, 0x7f, 0x2f, 0x48, 0x72, 0xa1, 0x60, 0x10,
-	0x50, 0xbb, 0xae, 0x45, 0x02, 0x66, 0xc7, 0x69, 0x01, 0xbd, 0xdb, 0x14, 0x8c, 0xd7, 0x5e, 0xd8,
-	0x65, 0x6b, 0xe4, 0x14, 0xbd, 0xc3, 0xcc, 0xb5, 0x17, 0x77, 0x17, 0x82, 0xde, 0x77, 0xd8, 0x16,
-	0xfc, 0xf6, 0x52, 0x99, 0x85, 0x32, 0x2e, 0x74, 0x42, 0xbe, 0x35, 0x2d, 0xfd, 0x0a, 0x3e, 0x2b,
-	0xfd, 0x1d, 0x12, 0x36, 0x28, 0x17, 0x95, 0x49, 0xb0, 0x49, 0xb0, 0xc7, 0xd3, 0x2e, 0xde, 0xa0,
-	0x4d, 0xe2, 0xc0, 0xb4, 0x49, 0x1c, 0x90, 0x0b, 0x77, 0x0a, 0x84, 0x4a, 0x91, 0xe8, 0xe6, 0x4d,
-	0x0a, 0x15, 0xa1, 0x14, 0x2a, 0xc2, 0xb8, 0x70, 0x26, 0x40, 0xcf, 0x80, 0x54, 0xae, 0x4f, 0x7f,
-	0x4c, 0x2f, 0x85, 0x50, 0x7a, 0x29, 0x9a, 0x0b, 0xcd, 0xb0, 0x3b, 0xab, 0xbd, 0xec, 0xf9, 0xac,
-	0x3b, 0xb7, 0xff, 0xd5, 0xf3, 0xd9, 0x59, 0xde, 0x72, 0xfb, 0xeb, 0x93, 0x26, 0x6b, 0x3b, 0x42,
-	0x2f, 0x62, 0x17, 0x6a, 0x93, 0xa0, 0xb5, 0x4c, 0x12, 0x24, 0x6c, 0xb7, 0x9b, 0xc4, 0x51, 0x91,
-	0x64, 0x51, 0x3c, 0xd8, 0x4f, 0xe2, 0xc3, 0x68, 0x60, 0xfe, 0xd1, 0x7d, 0xc8, 0x56, 0x23, 0x68,
-	0x40, 0x1a, 0x18, 0xda, 0xd7, 0xab, 0x07, 0x7d, 0x4d, 0x86, 0x22, 0x14, 0x26, 0xa3, 0x11, 0x1e,
-	0xce, 0x3a, 0x42, 0x8a, 0xe6, 0x42, 0x33, 0x78, 0xca, 0x3a, 0xf3, 0x06, 0x75, 0xe6, 0xfd, 0x77,
-	0x2c, 0x7e, 0xb2, 0xc2, 0x2e, 0x2c, 0x92, 0xf6, 0x06, 0x6c, 0x07, 0xff, 0x53, 0xd6, 0xff, 0xf5,
-	0xc6, 0x85, 0xcc, 0x9e, 0x04, 0x43, 0xb4, 0xde, 0xba, 0xf5, 0xe5, 0x69, 0xe9, 0x2f, 0x62, 0xcf,
-	0x4a, 0x7f, 0xcf, 0xf9, 0x67, 0xba, 0xca, 0xe4, 0x62, 0x91, 0x88, 0xf7, 0x94, 0xed, 0x22, 0x1c,
-	0x26, 0xc3, 0xa1, 0x0c, 0x8b, 0x24, 0x23, 0x63, 0x4d, 0x34, 0xf6, 0xf5, 0x69, 0xe9, 0x1f, 0x37,
-	0x65, 0x56, 0xfa, 0x9f, 0x75, 0x0c, 0xce, 0x4f, 0xe0, 0xe2, 0x38, 0x51, 0x68, 0x02, 0x46, 0xc1,
-	0x0f, 0x7b, 0x8f, 0x93, 0xbc, 0xe8, 0x85, 0xc9, 0x38, 0x2e, 0x30, 0x0f, 0x5b, 0xaa, 0x09, 0xa8,
-	0x72, 0xa8, 0x09, 0xa8, 0xe2, 0x5c, 0xd4, 0x26, 0x7a, 0x3d, 0x76, 0x7e, 0x64, 0xdd, 0xd9, 0x4b,
-	0x93, 0x61, 0x14, 0x4e, 0xf4, 0x8b, 0x84, 0x2f, 0x4d, 0x4b, 0x7f, 0x9e, 0x39, 0x2b, 0xfd, 0x8e,
-	0x56, 0x5d, 0x67, 0x71, 0x31, 0x3f, 0x9d, 0xff, 0x61, 0x9d, 0xed, 0xdc, 0x8d, 0xf3, 0x22, 0x18,
-	0x0e, 0xbf, 0x09, 0x8e, 0x3c, 0x05, 0x9f, 0x18, 0xdc, 0x64, 0x9b, 0xe9, 0xf8, 0x60, 0x18, 0x85,
-	0xf4, 0x8d, 0x01, 0x96, 0x0a, 0x0b, 0x52, 0xa9, 0xb0, 0x10, 0x17, 0xc4, 0x06, 0x05, 0xd0, 0x75,
-	0xba, 0x1f, 0x19, 0xa0, 0x02, 0x0b, 0x92, 0x02, 0x0b, 0x71, 0x41, 0x6c, 0x78, 0xd6, 0x3c, 0x7f,
-	0xdc, 0x3b, 0x92, 0x13, 0xfd, 0x21, 0x0c, 0x3e, 0xab, 0x86, 0xe8, 0x59, 0x35, 0xc0, 0x85, 0x61,
-	0x61, 0x9f, 0x9b, 0xa7, 0x73, 0x5f, 0xc0, 0x18, 0xcc, 0xe9, 0x73, 0x35, 0x02, 0x7d, 0xae, 0x1e,
-	0x42, 0xad, 0x49, 0x93, 0xac, 0x70, 0xaf, 0x72, 0x40, 0x53, 0xad, 0x01, 0x8a, 0x0b, 0x04, 0xe1,
-	0xb4, 0xae, 0x7c, 0x6f, 0xb3, 0x89, 0x42, 0x78, 0x5a, 0xbb, 0x38, 0x9d, 0xd6, 0x2e, 0xca, 0x45,
-	0x65, 0x12, 0x5e, 0xaa, 0xc3, 0xa3, 0xaf, 0x62, 0x70, 0x19, 0x2d, 0xdb, 0x60, 0xce, 0xa5, 0x5a,
-	0x23, 0x70, 0xa9, 0xd6, 0x43, 0xef, 0x1e, 0x7b, 0x25, 0x48, 0x23, 0xd0, 0x27, 0xb3, 0xde, 0x38,
-	0x1b, 0xea, 0x03, 0xf3, 0xf3, 0xd3, 0xd2, 0xaf, 0x32, 0x66, 0xa5, 0x7f, 0x41, 0x7f, 0x1b, 0xe2,
-	0xc2, 0x5c, 0x54, 0xa7, 0x41, 0xf8, 0x34, 0x15, 0x06, 0xba, 0x3b, 0xc6, 0xf0, 0x59, 0x90, 0xc2,
-	0x67, 0x21, 0x2e, 0x88, 0x8d, 0x9d, 0xe4, 0x30, 0x82, 0xaa, 0x11, 0x06, 0x9d, 0x57, 0x48, 0x81,
-	0x05, 0x9d, 0x4e, 0xd2, 0x40, 0xd0, 0x49, 0x9a, 0x31, 0xbe, 0xb2, 0x51, 0x04, 0xa4, 0xc0, 0x59,
-	0xfa, 0x68, 0x8b, 0x50, 0xe7, 0x95, 0x8d, 0xc5, 0xb8, 0x70, 0x26, 0x40, 0x84, 0x34, 0x55, 0x24,
-	0x47, 0x32, 0xee, 0x6c, 0x53, 0x84, 0x5c, 0x9c, 0x22, 0xe4, 0xa2, 0x5c, 0x54, 0x26, 0x5d, 0xff,
-	0x07, 0x63, 0x2b, 0xdd, 0x7b, 0x1f, 0x78, 0x8f, 0xd8, 0xd9, 0x3b, 0xb2, 0x70, 0xde, 0x8b, 0x7a,
-	0xd5, 0x57, 0xcf, 0x0b, 0xbe, 0x26, 0xda, 0xab, 0xce, 0x58, 0xf0, 0x4e, 0x95, 0x9f, 0xf1, 0x06,
-	0x6c, 0xf7, 0x8e, 0x2c, 0x2a, 0xdf, 0x5f, 0x99, 0x57, 0x73, 0x6f, 0xd4, 0x0c, 0x2c, 0xfc, 0x44,
-	0x6b, 0xef, 0x8d, 0x93, 0xbe, 0x65, 0x71, 0x0c, 0x25, 0xec, 0xe2, 0x02, 0x43, 0xf6, 0x25, 0xc0,
-	0x72, 0xc6, 0xae, 0x7e, 0xda, 0x9f, 0xe4, 0x8e, 0xc1, 0x11, 0xdb, 0xab, 0x1b, 0x74, 0xba, 0xe4,
-	0xe5, 0xec, 0xbd, 0xf5, 0x29, 0x7f, 0x16, 0x3b, 0xe6, 0x22, 0xd6, 0xa9, 0x9b, 0xb3, 0x3d, 0xca,
-	0x72, 0xc6, 0xde, 0x3c, 0xf1, 0xef, 0xb8, 0x93, 0x5d, 0xe9, 0x5e, 0xd7, 0xfe, 0x15, 0x57, 0x9e,
-	0xf0, 0x6f, 0x0c, 0x3f, 0xe3, 0xdd, 0x63, 0xdb, 0x68, 0xd0, 0x49, 0x8e, 0x4b, 0xf5, 0xec, 0xab,
-	0x28, 0xbf, 0xb4, 0xe8, 0xe5, 0xb0, 0xa3, 0xf0, 0x23, 0xb6, 0xe3, 0x28, 0xb4, 0x49, 0x70, 0xb2,
-	0xd2, 0x2b, 0xc7, 0xbd, 0x75, 0x72, 0x14, 0x7f, 0xc8, 0x3c, 0xa3, 0xd8, 0x09, 0xf6, 0xc9, 0x7a,
-	0xfd, 0x63, 0xde, 0xbe, 0x38, 0x6a, 0xef, 0xb3, 0x73, 0x46, 0xad, 0x0d, 0xea, 0xc9, 0x4a, 0x2f,
-	0x2f, 0xec, 0x6f, 0x17, 0xbb, 0xc0, 0x0d, 0xde, 0xb3, 0xb8, 0x60, 0x41, 0xdf, 0xc1, 0xcf, 0x78,
-	0x3f, 0x60, 0x5b, 0x0f, 0x64, 0x01, 0x9e, 0xc5, 0x4b, 0x5a, 0x2d, 0x1d, 0x8e, 0xb9, 0xa7, 0xd6,
-	0x92, 0xef, 0xb8, 0xcb, 0x25, 0x3f, 0x03, 0xd5, 0xed, 0x8e, 0xab, 0xde, 0xab, 0x08, 0xe2, 0x27,
-	0xc9, 0xcb, 0x2b, 0xeb, 0xb2, 0xb3, 0x42, 0xe6, 0xff, 0x31, 0x75, 0xdf, 0x63, 0x5b, 0xee, 0x95,
-	0xa7, 0x56, 0x22, 0x17, 0xdc, 0x86, 0x6a, 0x89, 0x5a, 0xfb, 0x8e, 0x9a, 0x9f, 0x39, 0x58, 0xc3,
-	0x8f, 0xad, 0x6f, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xa1, 0xcc, 0x7b, 0xb0, 0x2d, 0x00,
-	0x00,
+	// 4300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x8c, 0x24, 0xc9,
+	0x55, 0xde, 0xae, 0xdf, 0xee, 0xe8, 0x9e, 0xee, 0x9e, 0xec, 0x19, 0x3a, 0xb7, 0x77, 0xc7, 0x39,
+	0x0e, 0xbc, 0x5e, 0xc3, 0xc2, 0x8e, 0xd8, 0x01, 0x8d, 0xb1, 0x05, 0x2b, 0x76, 0xd6, 0x2c, 0x63,
+	0xbb, 0x3d, 0x6c, 0xcc, 0xee, 0x18, 0x81, 0x50, 0x29, 0xbb, 0x2a, 0xa6, 0x3a, 0xd5, 0x55, 0x99,
+	0xb5, 0x99, 0x59, 0x3d, 0xd4, 0xee, 0x85, 0x03, 0x20, 0x10, 0x88, 0x8b, 0x2f, 0x1c, 0x91, 0x40,
+	0x48, 0x5c, 0x7c, 0xe0, 0xc0, 0xcd, 0x47, 0x0e, 0x88, 0x03, 0x42, 0xdc, 0xf3, 0xc0, 0xb1, 0x24,
+	0x0e, 0xae, 0x1b, 0xe2, 0x62, 0xbd, 0x17, 0x11, 0xf9, 0x22, 0xb2, 0xb2, 0x67, 0xaa, 0x7b, 0x7a,
+	0x3c, 0x1e, 0xef, 0x9e, 0x2a, 0xe3, 0x7b, 0x2f, 0x5e, 0x44, 0xbe, 0x78, 0xf1, 0xe2, 0xc5, 0x8b,
+	0xc8, 0x62, 0x37, 0xfa, 0x47, 0x83, 0x34, 0x1c, 0x26, 0xf1, 0xa3, 0xd1, 0xec, 0x96, 0xf5, 0xfc,
+	0xf6, 0x24, 0x4d, 0xf2, 0xc4, 0xdb, 0xb4, 0xa0, 0x83, 0x6b, 0xc3, 0x64, 0x98, 0x20, 0x7e, 0x0b,
+	0x9e, 0x14, 0x0b, 0xef, 0xb2, 0xf6, 0xb7, 0xc6, 0x93, 0x7c, 0xc6, 0xbf, 0xcd, 0x76, 0x0e, 0x65,
+	0x96, 0x85, 0x43, 0x29, 0x64, 0x36, 0x49, 0xe2, 0x4c, 0x7a, 0x77, 0x58, 0x77, 0xac, 0x20, 0x7f,
+	0xed, 0xe6, 0xda, 0xd7, 0x36, 0xde, 0xbb, 0x31, 0x2f, 0x02, 0x03, 0x2d, 0x8a, 0x60, 0x7b, 0x16,
+	0x8e, 0x47, 0xdf, 0xe0, 0x1a, 0xe0, 0xc2, 0x90, 0xf8, 0xdf, 0xad, 0xb1, 0xd6, 0x47, 0xe1, 0x30,
+	0xf3, 0x7e, 0x85, 0xb5, 0xe3, 0xac, 0x17, 0x0d, 0x74, 0xfd, 0xfd, 0x79, 0x11, 0xb4, 0xe2, 0xec,
+	0xde, 0x60, 0x51, 0x04, 0x9b, 0xaa, 0x32, 0x94, 0xb8, 0x40, 0xd0, 0xfb, 0x75, 0xd6, 0x1d, 0xf7,
+	0x23, 0xe4, 0x6f, 0x20, 0xff, 0x6b, 0xf3, 0x22, 0xe8, 0x00, 0x84, 0x35, 0xae, 0xe8, 0xe6, 0xb0,
+	0xcc, 0x85, 0x26, 0x40, 0x1b, 0xa7, 0x63, 0xa8, 0xd3, 0xa4, 0x36, 0x4e, 0xc7, 0x76, 0x1b, 0x50,
+	0xe2, 0x02, 0x41, 0xfe, 0x3f, 0x4d, 0xb6, 0xf3, 0xf0, 0xf0, 0x30, 0x89, 0x3f, 0x4c, 0x67, 0x42,
+	0x7e, 0x32, 0x95, 0x59, 0xee, 0xdd, 0x72, 0x7b, 0xf9, 0xea, 0xbc, 0x08, 0x14, 0xb0, 0x28, 0x82,
+	0x2d, 0xd3, 0xcd, 0x5e, 0x34, 0xe0, 0x42, 0xc1, 0xde, 0x9d, 0x6a, 0x47, 0x95, 0x62, 0x14, 0x64,
+	0x29, 0x46, 0x01, 0xa0, 0x18, 0xf5, 0xe4, 0xdd, 0x72, 0xfb, 0x8a, 0x2d, 0x9d, 0x8e, 0x9d, 0x96,
+	0xb0, 0xc8, 0x85, 0x82, 0xbd, 0xf7, 0xd9, 0xe6, 0x44, 0xa6, 0x51, 0x32, 0xe8, 0xe5, 0xb3, 0x89,
+	0xf4, 0x5b, 0x58, 0xed, 0x17, 0xe7, 0x45, 0xc0, 0x14, 0xfc, 0xd1, 0x6c, 0x02, 0x23, 0x71, 0x55,
+	0xd5, 0x25, 0x8c, 0x0b, 0x8b, 0xc1, 0x1b, 0xb0, 0xbd, 0x2c, 0x0f, 0xf3, 0x28, 0xcb, 0xa3, 0x7e,
+	0xd6, 0xeb, 0xa7, 0x51, 0x2e, 0xd3, 0x28, 0xf4, 0xdb, 0x28, 0xed, 0xf6, 0xbc, 0x08, 0x3c, 0x22,
+	0xdf, 0xd5, 0xd4, 0x45, 0x11, 0xbc, 0xaa, 0xa4, 0x2e, 0xd3, 0xb8, 0xa8, 0xa9, 0xe0, 0x7d, 0x93,
+	0xad, 0x0f, 0xa6, 0x69, 0x98, 0x47, 0x49, 0xec, 0x77, 0x50, 0x74, 0x30, 0x2f, 0x82, 0x12, 0x5b,
+	0x14, 0xc1, 0x8e, 0x12, 0x68, 0x10, 0x2e, 0x4a, 0xa2, 0xf7, 0x1d, 0xb6, 0x95, 0xc9, 0xf4, 0x34,
+	0xea, 0x4b, 0xf5, 0xa6, 0x5d, 0x14, 0xf0, 0xe6, 0xbc, 0x08, 0x1c, 0x7c, 0x51, 0x04, 0x7b, 0xba,
+	0x57, 0x16, 0xca, 0x85, 0xc3, 0xc4, 0x7f, 0xbc, 0xc6, 0xf6, 0x1f, 0x1e, 0xde, 0x8f, 0xdf, 0x97,
+	0xe3, 0x30, 0x1e, 0xbc, 0x34, 0x83, 0xfd, 0x4d, 0xb6, 0x1e, 0x0e, 0x65, 0x9c, 0xf7, 0xa2, 0x89,
+	0xdf, 0x22, 0x05, 0x1a, 0x8c, 0x14, 0x68, 0x10, 0x2e, 0x4a, 0x22, 0xff, 0xd7, 0x06, 0xdb, 0x7b,
+	0x78, 0x78, 0x78, 0xf7, 0xde, 0x83, 0xcf, 0xc5, 0xfb, 0x7a, 0x1f, 0xb0, 0xcd, 0xb1, 0xcc, 0xd3,
+	0xa8, 0xdf, 0x8b, 0xc3, 0xb1, 0xd4, 0xb6, 0xfc, 0xc6, 0xbc, 0x08, 0x6c, 0x78, 0x51, 0x04, 0x9e,
+	0x71, 0x52, 0x25, 0xc8, 0x85, 0xcd, 0xc2, 0xff, 0xa2, 0xc1, 0xf6, 0xef, 0x4e, 0xa6, 0xc6, 0x5a,
+	0xee, 0xc5, 0x8f, 0x92, 0xd2, 0x03, 0xbe, 0xc5, 0x5a, 0x28, 0xdd, 0x76, 0x5f, 0x4a, 0xac, 0x71,
+	0x5f, 0x28, 0x0f, 0x41, 0xef, 0xb7, 0x59, 0x2b, 0x0f, 0x87, 0x19, 0x6a, 0x6d, 0xf3, 0x9d, 0xab,
+	0x6f, 0xdb, 0xfe, 0x18, 0xbc, 0xa1, 0xaa, 0x0f, 0x2c, 0x54, 0x1f, 0x4a, 0x5c, 0x20, 0x08, 0x8d,
+	0xe5, 0xd1, 0x58, 0xda, 0x7e, 0x0c, 0xca, 0x16, 0x73, 0x84, 0x8d, 0xc1, 0x8f, 0xf7, 0x21, 0xeb,
+	0x9c, 0x86, 0xa3, 0xa9, 0xcc, 0x50, 0x73, 0x9b, 0xef, 0xbc, 0xee, 0x34, 0x57, 0x79, 0x1f, 0xe5,
+	0x48, 0x15, 0x3f, 0x39, 0x52, 0x55, 0xe6, 0x42, 0x13, 0xf8, 0x9f, 0x75, 0xd8, 0x4e, 0xa5, 0xa2,
+	0xf7, 0x7d, 0xb6, 0xd3, 0x9f, 0x4c, 0x7b, 0xd3, 0x3c, 0x1a, 0x45, 0x9f, 0xaa, 0xa9, 0x0d, 0xba,
+	0x58, 0x7b, 0xef, 0x57, 0xe7, 0x45, 0x50, 0x25, 0x2d, 0x8a, 0xe0, 0x17, 0x94, 0xe8, 0x0a, 0x81,
+	0x8b, 0x2a, 0xab, 0x77, 0x97, 0x31, 0x80, 0xb2, 0x59, 0x96, 0xcb, 0x31, 0xaa, 0x6c, 0x4d, 0xf9,
+	0x35, 0x42, 0xc9, 0xaf, 0x11, 0xc6, 0x85, 0xc5, 0x00, 0x06, 0x04, 0xa5, 0x68, 0x30, 0x52, 0x5a,
+	0x5b, 0x53, 0x06, 0x64, 0x30, 0x32, 0x20, 0x83, 0x70, 0x51, 0x12, 0x4d, 0x0f, 0xa2, 0xe4, 0x71,
+	0x18, 0xe5, 0x7e, 0xcb, 0xed, 0x81, 0x42, 0xdd, 0x1e, 0x28, 0x4c, 0xf7, 0x40, 0x15, 0xbc, 0x77,
+	0xd9, 0x06, 0x94, 0x8e, 0xa3, 0x38, 0x4f, 0xd1, 0x06, 0xd7, 0xde, 0xfb, 0xf2, 0xbc, 0x08, 0x08,
+	0x5c, 0x14, 0xc1, 0x2e, 0x89, 0x40, 0x88, 0x0b, 0x22, 0x1b, 0x01, 0x19, 0x0a, 0xe8, 0xb8, 0x02,
+	0xb2, 0x65, 0x01, 0x99, 0x25, 0x00, 0x9f, 0x8d, 0x0e, 0xa6, 0x99, 0x4c, 0xfd, 0xae, 0xab, 0x03,
+	0xc0, 0x5c, 0x1d, 0x00, 0xa2, 0x75, 0x00, 0x8f, 0xa6, 0x72, 0x1c, 0xf5, 0xa5, 0xbf, 0xee, 0x56,
+	0x06, 0xcc, 0xad, 0x0c, 0x88, 0xae, 0x0c, 0x8f, 0x65, 0xd7, 0x73, 0x19, 0x8e, 0xfc, 0x8d, 0x4a,
+	0xd7, 0x01, 0xac, 0x74, 0x1d, 0x20, 0xd3, 0x75, 0x78, 0x36, 0x02, 0x86, 0xe0, 0xa7, 0x7c, 0xe6,
+	0x0a, 0x40, 0xd0, 0x15, 0x80, 0x90, 0x16, 0x80, 0xcf, 0xde, 0x03, 0xb6, 0x5d, 0x16, 0xd4, 0x4b,
+	0x6c, 0xa2, 0x94, 0xb7, 0xe6, 0x45, 0x50, 0xa1, 0x2c, 0x8a, 0xe0, 0x7a, 0x45, 0x94, 0x7e, 0xa1,
+	0x0a, 0x23, 0xff, 0xdb, 0x06, 0x7b, 0xed, 0xee, 0x64, 0xfa, 0xbb, 0xa9, 0xfc, 0xe4, 0x25, 0xf3,
+	0x09, 0x1f, 0x57, 0x7c, 0xc2, 0xcd, 0xaa, 0x4f, 0xa8, 0xbe, 0xd3, 0x6a, 0x7e, 0xe1, 0x21, 0xdb,
+	0xab, 0xa9, 0x5b, 0x0e, 0xff, 0x44, 0xca, 0x81, 0x76, 0x0a, 0x34, 0xfc, 0x00, 0x56, 0x86, 0x1f,
+	0x20, 0x33, 0xfc, 0xf8, 0xfc, 0x37, 0x0d, 0x76, 0x70, 0x28, 0xc7, 0x49, 0x3a, 0x7b, 0xc9, 0xf4,
+	0xfc, 0x51, 0x45, 0xcf, 0x81, 0xd3, 0xdc, 0xf2, 0x2b, 0xad, 0xa6, 0xe6, 0xff, 0x6f, 0x32, 0x6f,
+	0xb9, 0x2e, 0x78, 0xe0, 0xb1, 0x1c, 0x9f, 0xe5, 0x81, 0x2b, 0x24, 0xf2, 0xc0, 0x15, 0x02, 0x17,
+	0x55, 0x56, 0x18, 0x3f, 0x80, 0xf2, 0x24, 0x0f, 0x47, 0x7e, 0x83, 0xc6, 0xaf, 0x04, 0x69, 0xfc,
+	0x4a, 0x88, 0x0b, 0x22, 0x83, 0xf3, 0x40, 0x99, 0x99, 0x1c, 0xd8, 0xde, 0xd7, 0x60, 0xe4, 0x3c,
+	0x0c, 0xc2, 0x45, 0x49, 0x34, 0x95, 0x1f, 0xa5, 0x52, 0xfa, 0x2d, 0xb7, 0x32, 0x60, 0x6e, 0x65,
+	0x40, 0x74, 0x65, 0x78, 0x04, 0xd7, 0x0d, 0xcf, 0xd9, 0x71, 0x98, 0xca, 0x81, 0xdf, 0x26, 0xd7,
+	0x4d, 0x28, 0xb9, 0x6e, 0xc2, 0xb8, 0xb0, 0x18, 0x54, 0x00, 0x31, 0xee, 0x1d, 0x4d, 0x1f, 0x3d,
+	0x92, 0x69, 0xa6, 0x7d, 0xaf, 0x0e, 0x20, 0x4a, 0xd8, 0x0e, 0x20, 0x4a, 0x10, 0x03, 0x88, 0xb2,
+	0x64, 0x7a, 0xd3, 0x0f, 0xfb, 0xc7, 0x72, 0xe0, 0x77, 0xdd, 0xde, 0x28, 0xd4, 0xed, 0x8d, 0xc2,
+	0x74, 0x6f, 0x74, 0xe1, 0x2f, 0x1b, 0xcc, 0x7f, 0x3f, 0xca, 0x4e, 0x5e, 0xb2, 0xa9, 0x20, 0x2a,
+	0x53, 0xe1, 0x86, 0xd3, 0x5c, 0xf5, 0x85, 0x56, 0x9b, 0x08, 0xff, 0xd2, 0x62, 0xbb, 0xd5, 0x9a,
+	0x60, 0xad, 0x83, 0x28, 0x3b, 0x51, 0x06, 0x63, 0x79, 0x9b, 0x12, 0x24, 0x6b, 0x2d, 0x21, 0x2e,
+	0x88, 0x0c, 0xa3, 0x84, 0x05, 0xdb, 0xde, 0x71, 0x94, 0x08, 0xa5, 0x51, 0x22, 0x8c, 0x0b, 0x8b,
+	0xa1, 0xec, 0x85, 0x65, 0xf3, 0xd4, 0x0b, 0x6d, 0xf4, 0x76, 0x2f, 0x94, 0xd5, 0x13, 0xd9, 0xfb,
+	0x23, 0xb6, 0xab, 0x0a, 0xd6, 0x74, 0x56, 0xe6, 0x7f, 0x6b, 0x5e, 0x04, 0x4b, 0xb4, 0x45, 0x11,
+	0xec, 0xdb, 0xe2, 0xec, 0x09, 0xbd, 0xc4, 0x0c, 0x91, 0xfb, 0xc9, 0x51, 0x2f, 0x95, 0xa1, 0x99,
+	0x13, 0x18, 0xb9, 0x6b, 0x88, 0x22, 0x77, 0x0d, 0x70, 0x61, 0x48, 0xa0, 0x9b, 0x93, 0xa3, 0xde,
+	0xe3, 0x34, 0xca, 0x73, 0x19, 0xfb, 0x1d, 0xd2, 0x0d, 0xa1, 0xa4, 0x1b, 0xc2, 0xb8, 0xb0, 0x18,
+	0x60, 0x46, 0x27, 0x93, 0x4c, 0x35, 0x6f, 0x05, 0x22, 0x06, 0xa3, 0x19, 0x6d, 0x10, 0x2e, 0x4a,
+	0x22, 0x28, 0x16, 0x9e, 0x41, 0x96, 0x89, 0x44, 0x50, 0xb1, 0x25, 0x48, 0x8a, 0x2d, 0x21, 0x2e,
+	0x88, 0x8c, 0xab, 0xf6, 0xf7, 0x64, 0xfe, 0x38, 0x49, 0x4f, 0x7e, 0xae, 0x56, 0xed, 0x9a, 0x77,
+	0x5a, 0x6d, 0x16, 0xfd, 0x55, 0x83, 0xed, 0xd5, 0x54, 0x86, 0x61, 0x3a, 0x9a, 0xe5, 0x32, 0xeb,
+	0x45, 0x66, 0x21, 0xc1, 0x61, 0x32, 0x18, 0x0d, 0x93, 0x41, 0xb8, 0x28, 0x89, 0x30, 0x4c, 0xea,
+	0x39, 0x99, 0xe6, 0xf6, 0x9a, 0x51, 0x82, 0x34, 0x4c, 0x25, 0xc4, 0x05, 0x91, 0xc1, 0x44, 0x27,
+	0x27, 0x39, 0x36, 0xde, 0x24, 0x13, 0xd5, 0x10, 0x99, 0xa8, 0x06, 0xb8, 0x30, 0x24, 0xe8, 0x36,
+	0x3e, 0x42, 0xc3, 0xd6, 0x7a, 0x61, 0x30, 0xea, 0xb6, 0x41, 0xb8, 0x28, 0x89, 0xfc, 0x07, 0x0d,
+	0xb6, 0xa7, 0x77, 0xc6, 0x8e, 0x51, 0xdc, 0x66, 0x9d, 0x54, 0x66, 0xd3, 0x51, 0xae, 0xcd, 0x02,
+	0x15, 0xab, 0x10, 0x52, 0xac, 0x2a, 0x73, 0xa1, 0x09, 0x30, 0xb8, 0xd3, 0x38, 0xca, 0xfd, 0x06,
+	0x0d, 0x2e, 0x94, 0x69, 0x70, 0xa1, 0xc4, 0x05, 0x82, 0xc0, 0x3c, 0x90, 0x59, 0xdf, 0xb6, 0x04,
+	0x28, 0x13, 0x33, 0x94, 0xb8, 0x40, 0x10, 0x94, 0x23, 0x47, 0xe1, 0x04, 0x7c, 0x4b, 0x8b, 0x76,
+	0xde, 0x1a, 0x22, 0xe5, 0x68, 0x80, 0x0b, 0x43, 0x82, 0xc4, 0x59, 0x36, 0x91, 0xfd, 0x5e, 0xa4,
+	0x26, 0xbe, 0x7e, 0x11, 0x80, 0x22, 0x2b, 0x71, 0xa6, 0xca, 0x5c, 0x68, 0x02, 0x4c, 0x19, 0x1f,
+	0xb4, 0xf2, 0x40, 0xa5, 0x4e, 0x5e, 0x54, 0xda, 0xe0, 0x3e, 0xbb, 0x12, 0x4e, 0xf3, 0xe3, 0x24,
+	0x35, 0xfe, 0x50, 0xe9, 0xea, 0x97, 0xe6, 0x45, 0xe0, 0x12, 0x16, 0x45, 0x70, 0x4d, 0x09, 0x71,
+	0x60, 0x2e, 0x5c, 0x36, 0xe8, 0x09, 0x4c, 0x2c, 0x63, 0x29, 0x6d, 0xd5, 0x13, 0x0d, 0x51, 0x4f,
+	0x34, 0xc0, 0x85, 0x21, 0xf1, 0x7f, 0x6c, 0xb1, 0x03, 0x57, 0x21, 0x8e, 0xb5, 0xfc, 0xf4, 0x54,
+	0x72, 0x9b, 0x75, 0xb2, 0x64, 0x9a, 0xf6, 0x8d, 0x07, 0x51, 0xc3, 0x89, 0x88, 0x35, 0x9c, 0x58,
+	0x86, 0xe1, 0xc4, 0x07, 0xa8, 0x74, 0x3a, 0xee, 0xf5, 0x63, 0xf3, 0xd6, 0xca, 0x4b, 0x20, 0x62,
+	0x79, 0x09, 0x2c, 0x83, 0x97, 0xc0, 0x07, 0xd8, 0x41, 0x1d, 0xcb, 0x70, 0x94, 0x1f, 0xcf, 0x7a,
+	0xba, 0x72, 0x1b, 0x2b, 0xe3, 0x0e, 0xca, 0xa5, 0xd0, 0x0e, 0xca, 0xc5, 0xb9, 0xa8, 0x30, 0x7a,
+	0x7f, 0xc0, 0xba, 0xd9, 0x74, 0x3c, 0x0e, 0xd3, 0x99, 0xdf, 0xa9, 0x0b, 0x90, 0x49, 0xc5, 0x0f,
+	0x14, 0x9b, 0x52, 0x8c, 0xae, 0x43, 0x8a, 0xd1, 0x00, 0x17, 0x86, 0xe4, 0x3d, 0x64, 0xdd, 0xd3,
+	0x71, 0x6f, 0x14, 0x65, 0xb9, 0xdf, 0xbd, 0xd9, 0x5c, 0x8a, 0x37, 0x1e, 0x1e, 0xba, 0x43, 0xa7,
+	0xe4, 0xea, 0x1a, 0x24, 0x57, 0x03, 0x5c, 0x18, 0x52, 0xe9, 0xb0, 0xd7, 0x57, 0x70, 0xd8, 0xfc,
+	0xff, 0xba, 0xcc, 0x5b, 0x7e, 0x07, 0x58, 0xda, 0x4f, 0x61, 0x73, 0x33, 0x49, 0x93, 0xd3, 0x28,
+	0x8b, 0x92, 0x58, 0x6f, 0x8b, 0xda, 0x6a, 0x69, 0xaf, 0xd2, 0x68, 0x69, 0xaf, 0x52, 0xb8, 0x58,
+	0x62, 0x06, 0xc7, 0x7b, 0xaa, 0x77, 0xed, 0x03, 0xdb, 0xf1, 0x96, 0x20, 0x39, 0xde, 0x12, 0xe2,
+	0x82, 0xc8, 0x75, 0x89, 0x9c, 0xe6, 0xa5, 0x24, 0x72, 0x9c, 0x6d, 0x44, 0xeb, 0x19, 0xb7, 0x11,
+	0xed, 0xf3, 0x6e, 0x23, 0x6a, 0x76, 0x47, 0x9d, 0x4b, 0xd9, 0x1d, 0xb9, 0xe1, 0x62, 0xf7, 0x12,
+	0xc2, 0xc5, 0xf5, 0x4b, 0x0a, 0x17, 0x37, 0x2e, 0x2b, 0x5c, 0xbc, 0xcf, 0xae, 0x20, 0x06, 0x01,
+	0x58, 0xef, 0x68, 0x92, 0xe9, 0x14, 0x0c, 0x3a, 0x5e, 0x87, 0x40, 0x8e, 0xd7, 0x81, 0xb9, 0x70,
+	0xd9, 0xc0, 0x99, 0x20, 0x80, 0x11, 0x19, 0x4a, 0xb4, 0xd2, 0x31, 0x2e, 0x85, 0x9c, 0x89, 0x8b,
+	0x73, 0x51, 0x61, 0x84, 0x81, 0x88, 0x65, 0xde, 0x8b, 0x62, 0x14, 0xb8, 0x45, 0x03, 0x41, 0x28,
+	0x0d, 0x04, 0x61, 0x5c, 0x58, 0x0c, 0xb0, 0xd7, 0x83, 0x52, 0x32, 0xcd, 0x51, 0xca, 0x15, 0xda,
+	0xeb, 0x59, 0x30, 0xed, 0xf5, 0x2c, 0x90, 0x0b, 0x9b, 0x85, 0xff, 0x69, 0x87, 0xed, 0x56, 0xbd,
+	0x0c, 0x25, 0xbe, 0xd7, 0x56, 0x4f, 0x7c, 0xf7, 0xb3, 0x89, 0x3a, 0xe8, 0x68, 0x50, 0xe2, 0xdb,
+	0x60, 0x56, 0xda, 0x4d, 0x23, 0x90, 0x76, 0xd3, 0x8f, 0x60, 0x54, 0x93, 0xe9, 0xd1, 0x28, 0xea,
+	0x43, 0xda, 0x5c, 0xad, 0x0f, 0x68, 0x54, 0x25, 0x48, 0x46, 0x55, 0x42, 0x5c, 0x10, 0x19, 0x34,
+	0xaa, 0xb2, 0xe8, 0xd5, 0x23, 0x25, 0x42, 0x49, 0xa3, 0x84, 0x71, 0x61, 0x31, 0x80, 0x46, 0x55,
+	0x29, 0xcb, 0xc3, 0xdc, 0x49, 0xbf, 0x5b, 0x30, 0x69, 0xd4, 0x02, 0xb9, 0xb0, 0x59, 0xe0, 0xe0,
+	0x47, 0x15, 0xd5, 0x22, 0xe2, 0x77, 0xe8, 0xe0, 0xc7, 0xc6, 0xe9, 0xe0, 0xc7, 0x46, 0xb9, 0x70,
+	0x98, 0x40, 0xb1, 0xa3, 0xe8, 0x54, 0xc6, 0x32, 0xcb, 0xfc, 0x2e, 0x29, 0xd6, 0x60, 0xa4, 0x58,
+	0x83, 0x70, 0x51, 0x12, 0x41, 0xb1, 0xa3, 0x30, 0xcb, 0x7b, 0x99, 0x94, 0x31, 0xce, 0xd6, 0xa6,
+	0x52, 0x6c, 0x09, 0x92, 0x62, 0x4b, 0x88, 0x0b, 0x22, 0xc3, 0x2a, 0x02, 0x0e, 0x17, 0x67, 0x68,
+	0x5b, 0x1f, 0x44, 0xf6, 0x27, 0x53, 0xeb, 0x20, 0xb2, 0x3f, 0x99, 0xc2, 0x41, 0x64, 0x7f, 0x32,
+	0xc5, 0x35, 0x3e, 0x0f, 0xf3, 0xa9, 0x9a, 0x76, 0x66, 0x8d, 0x47, 0xc4, 0x5a, 0xe3, 0xb1, 0x0c,
+	0x6b, 0x3c, 0x3e, 0x40, 0xd6, 0x5f, 0x1d, 0x5d, 0xf8, 0x9b, 0x35, 0x59, 0x7f, 0x32, 0x4c, 0xe4,
+	0x51, 0x22, 0x15, 0x3f, 0x89, 0x54, 0x65, 0x38, 0x3e, 0xc5, 0x07, 0x30, 0x5e, 0x99, 0xa6, 0x49,
+	0xea, 0x6f, 0x91, 0xf1, 0x22, 0x40, 0xc6, 0x8b, 0x45, 0x2e, 0x14, 0xcc, 0xff, 0xbc, 0xcb, 0x76,
+	0x2a, 0x2d, 0x3d, 0xbf, 0x63, 0x82, 0x17, 0x9b, 0xa4, 0xaa, 0x59, 0x5d, 0x5a, 0xcf, 0x61, 0x75,
+	0x69, 0x5f, 0xc2, 0xea, 0xd2, 0xb9, 0xa4, 0xd5, 0xa5, 0xfb, 0xdc, 0x56, 0x97, 0xf5, 0x4b, 0x5f,
+	0x5d, 0x36, 0x2e, 0x7b, 0x75, 0x61, 0x97, 0xb2, 0xba, 0x6c, 0x5e, 0x74, 0x75, 0x29, 0xc3, 0xd0,
+	0xad, 0x55, 0xc2, 0xd0, 0xbf, 0x6e, 0xb0, 0x57, 0x21, 0x0c, 0x15, 0xd1, 0xf0, 0x38, 0xcf, 0xa2,
+	0x4f, 0xa3, 0x78, 0xf8, 0xf3, 0xb1, 0x7f, 0xbb, 0xcd, 0x3a, 0x8f, 0xa3, 0x78, 0x90, 0x3c, 0xd6,
+	0x6b, 0x13, 0xba, 0x31, 0x85, 0x90, 0x1b, 0x53, 0x65, 0x2e, 0x34, 0x81, 0xff, 0x5b, 0x97, 0xed,
+	0x57, 0xb4, 0xf1, 0x62, 0x36, 0x6e, 0xba, 0xeb, 0xcd, 0x95, 0xbb, 0x6e, 0x4e, 0xb1, 0x26, 0x32,
+	0xed, 0xcb, 0x38, 0x8f, 0x46, 0x52, 0x6f, 0xe0, 0xca, 0x53, 0x2c, 0xa2, 0xb8, 0xa7, 0x58, 0x84,
+	0xeb, 0x53, 0x2c, 0x02, 0xac, 0xdd, 0x60, 0x7b, 0xf5, 0xdd, 0xe0, 0x09, 0xbb, 0x96, 0x9c, 0xca,
+	0xd4, 0xde, 0x79, 0xa0, 0x88, 0x0e, 0x8a, 0xb8, 0x33, 0x2f, 0x82, 0x5a, 0xfa, 0xa2, 0x08, 0x5e,
+	0x53, 0x02, 0xeb, 0xa8, 0x5c, 0xd4, 0x56, 0xf2, 0x12, 0x76, 0x7d, 0x1a, 0x0f, 0x6a, 0x5a, 0xeb,
+	0x62, 0x6b, 0xbf, 0x39, 0x2f, 0x82, 0x7a, 0x86, 0x45, 0x11, 0xbc, 0x6e, 0x32, 0x2d, 0x83, 0xba,
+	0xf6, 0xea, 0xab, 0x41, 0xa4, 0x31, 0x4e, 0xe2, 0xfc, 0x78, 0x34, 0xeb, 0xf5, 0x93, 0x2c, 0xd7,
+	0x0e, 0x09, 0x23, 0x0d, 0x1b, 0xa7, 0x48, 0xc3, 0x46, 0xb9, 0x70, 0x98, 0xbc, 0xcf, 0x98, 0x9f,
+	0xca, 0x7e, 0x32, 0x1e, 0xcb, 0x78, 0x20, 0x07, 0x3d, 0x47, 0xb0, 0xf2, 0x4b, 0xef, 0xce, 0x8b,
+	0xe0, 0x4c, 0x9e, 0x45, 0x11, 0x04, 0x26, 0xbd, 0x54, 0xcf, 0xc1, 0xc5, 0x99, 0x95, 0x71, 0x5d,
+	0xd2, 0xe5, 0x2c, 0x3c, 0x8d, 0xe2, 0xa1, 0x71, 0x5d, 0x6a, 0x5d, 0x72, 0x49, 0xd6, 0xba, 0xe4,
+	0x12, 0x60, 0x5d, 0x72, 0x11, 0xef, 0x01, 0xed, 0xaf, 0x37, 0x71, 0x7f, 0x7d, 0x50, 0x09, 0x30,
+	0xac, 0xe9, 0x75, 0xee, 0xcd, 0xf5, 0x4a, 0x5e, 0xed, 0xc7, 0x8c, 0x5d, 0x71, 0x9a, 0xf9, 0x29,
+	0x47, 0xd7, 0x10, 0xc9, 0x24, 0x71, 0x2c, 0xfb, 0xe0, 0x8a, 0xd4, 0xd5, 0x12, 0x35, 0x95, 0x55,
+	0x24, 0xe3, 0x92, 0xac, 0x48, 0xc6, 0x25, 0x40, 0x24, 0xe3, 0x22, 0xe0, 0x53, 0x4c, 0x8e, 0xce,
+	0x4a, 0xee, 0x69, 0x88, 0xd4, 0xa7, 0x01, 0xc8, 0x79, 0xa8, 0xa7, 0x32, 0xaa, 0x6c, 0xaf, 0x12,
+	0x55, 0xe2, 0x95, 0xbd, 0x71, 0x6f, 0x18, 0x1d, 0xe9, 0x88, 0x40, 0x5f, 0xd9, 0x43, 0xc8, 0xbe,
+	0xb2, 0x87, 0x00, 0x5e, 0xd9, 0xc3, 0x27, 0xf0, 0xe2, 0x60, 0x5a, 0xe0, 0x42, 0x7a, 0xc7, 0xc9,
+	0xd4, 0xdc, 0x25, 0x40, 0x2f, 0xee, 0x10, 0xc8, 0x8b, 0x3b, 0x30, 0x17, 0x2e, 0x5b, 0x5d, 0x48,
+	0xb8, 0x7e, 0x29, 0x21, 0x61, 0x4d, 0x50, 0xb6, 0x71, 0x29, 0x41, 0xd9, 0x03, 0xb6, 0x5d, 0xce,
+	0x38, 0x25, 0x57, 0x45, 0xe6, 0xe8, 0x87, 0x5d, 0x0a, 0xf9, 0x61, 0x17, 0xe7, 0xa2, 0xc2, 0xa8,
+	0x52, 0xcc, 0x61, 0x96, 0xc4, 0xfe, 0x26, 0xad, 0x08, 0x0a, 0xb1, 0x53, 0xcc, 0x50, 0xc6, 0x14,
+	0x33, 0x3c, 0x40, 0x40, 0x91, 0x87, 0xe9, 0x50, 0xe6, 0x3d, 0x1c, 0xf9, 0x2d, 0x1c, 0x79, 0x0c,
+	0x28, 0x2c, 0x98, 0x02, 0x0a, 0x0b, 0xe4, 0xc2, 0x66, 0x81, 0x57, 0xd2, 0x45, 0x63, 0x15, 0x57,
+	0x28, 0x66, 0x72, 0x29, 0xf4, 0x4a, 0x2e, 0xce, 0x45, 0x85, 0xd1, 0x12, 0x6a, 0x0c, 0x7a, 0x9b,
+	0xf4, 0xe4, 0x52, 0x96, 0x84, 0x96, 0xe6, 0x5d, 0x61, 0x84, 0xa5, 0x47, 0x23, 0xae, 0x19, 0xee,
+	0x60, 0x7f, 0x71, 0xe9, 0xa9, 0xa3, 0xd3, 0xd2, 0x53, 0x47, 0xe5, 0xa2, 0xb6, 0xd2, 0xd2, 0x4a,
+	0xb0, 0xfb, 0x2c, 0x2b, 0xc1, 0x90, 0xed, 0x19, 0x05, 0xd9, 0x32, 0xaf, 0xa2, 0xcc, 0xdf, 0x98,
+	0x17, 0x41, 0x1d, 0x79, 0x51, 0x04, 0x07, 0xae, 0xb6, 0x9d, 0x16, 0xea, 0xaa, 0xd4, 0x79, 0x7d,
+	0xef, 0x32, 0xbc, 0x3e, 0xff, 0xf7, 0x35, 0xbc, 0xf8, 0xf5, 0xe2, 0xce, 0xcb, 0xee, 0x95, 0x47,
+	0x60, 0x4d, 0x5c, 0x75, 0xae, 0x55, 0x2f, 0xae, 0xac, 0x7e, 0xec, 0xf5, 0xcf, 0x1d, 0xd6, 0xd5,
+	0x15, 0xbe, 0xb8, 0xbc, 0xf6, 0xc5, 0xe5, 0xb5, 0xcf, 0xd9, 0xe5, 0xb5, 0xf3, 0xc5, 0x5a, 0xff,
+	0xb5, 0x56, 0xde, 0xec, 0x7a, 0x71, 0x73, 0xff, 0xb0, 0x32, 0xf7, 0xfd, 0xba, 0x4b, 0x6b, 0xab,
+	0xcf, 0xff, 0xcf, 0xd8, 0xa6, 0x55, 0xe7, 0x99, 0x2f, 0xa9, 0x95, 0x0a, 0x6d, 0xac, 0xa2, 0xd0,
+	0xff, 0x5c, 0x33, 0x57, 0xb8, 0x5e, 0x9c, 0x3e, 0xbf, 0x5b, 0xd1, 0xe7, 0x7e, 0xcd, 0xe5, 0xb4,
+	0xd5, 0xd5, 0xf9, 0xf7, 0x2d, 0xc6, 0xa8, 0xce, 0x17, 0x97, 0xd1, 0x3e, 0x17, 0x97, 0xd1, 0xce,
+	0x77, 0x1c, 0xfa, 0x1f, 0x6b, 0xea, 0xba, 0xd6, 0x8b, 0x33, 0xf9, 0x6f, 0x57, 0x4c, 0xfe, 0xfa,
+	0xd2, 0x25, 0xb4, 0x73, 0x5c, 0x9b, 0x69, 0xb3, 0x75, 0x53, 0x03, 0xde, 0xc2, 0xba, 0x6f, 0x86,
+	0xbd, 0xd0, 0xf6, 0xa0, 0x7b, 0xa1, 0x6c, 0xa1, 0x65, 0xec, 0x40, 0xe5, 0x2a, 0x67, 0xb9, 0xcc,
+	0xec, 0xa0, 0x80, 0x50, 0xd2, 0x3c, 0x61, 0x5c, 0x58, 0x0c, 0x30, 0x0f, 0xb0, 0x54, 0x5e, 0x1f,
+	0xd2, 0xf3, 0xa0, 0x04, 0x69, 0x1e, 0x94, 0x10, 0x17, 0x44, 0x86, 0xdd, 0x32, 0x14, 0x32, 0x6d,
+	0xc7, 0xb8, 0x5b, 0x46, 0x80, 0x76, 0xcb, 0x58, 0xe4, 0x42, 0xc1, 0x50, 0xc1, 0xce, 0x42, 0x63,
+	0x05, 0x33, 0xe3, 0x74, 0x05, 0x3d, 0xdb, 0x14, 0x8c, 0xf7, 0x5f, 0x28, 0xe3, 0xac, 0xee, 0xbf,
+	0xa8, 0x19, 0x66, 0xee, 0xbf, 0xe0, 0xec, 0x42, 0x10, 0x22, 0x6d, 0xf8, 0x35, 0x99, 0x29, 0xbf,
+	0x4b, 0x91, 0xb6, 0x8d, 0x53, 0xa4, 0x6d, 0xa3, 0x5c, 0x38, 0x4c, 0x30, 0x49, 0x74, 0xe6, 0x16,
+	0x55, 0xbc, 0x4e, 0x93, 0xc4, 0x82, 0x69, 0x92, 0x58, 0x20, 0x17, 0x36, 0x0b, 0x0c, 0x95, 0x2a,
+	0xa2, 0x9a, 0x37, 0x68, 0xa8, 0x08, 0xa5, 0xa1, 0x22, 0x8c, 0x0b, 0x8b, 0x01, 0x73, 0x7d, 0x50,
+	0x32, 0xb9, 0x17, 0x95, 0xeb, 0x43, 0xc4, 0xca, 0xf5, 0x61, 0x19, 0x72, 0x7d, 0xf8, 0x50, 0xce,
+	0xac, 0xcd, 0x55, 0xd7, 0x67, 0x7d, 0x85, 0xeb, 0x67, 0x75, 0x7d, 0xb6, 0xba, 0xb7, 0xda, 0xfc,
+	0xfa, 0x61, 0x83, 0x6d, 0x5a, 0x95, 0x3e, 0x8f, 0xd7, 0xd1, 0x4a, 0x23, 0x68, 0xaf, 0x62, 0x04,
+	0x09, 0xdb, 0x3f, 0x4c, 0xe2, 0x28, 0x4f, 0xd2, 0x28, 0x1e, 0xde, 0x4d, 0xe2, 0x47, 0xd1, 0xd0,
+	0xe4, 0xf8, 0x3f, 0x62, 0xad, 0x08, 0x36, 0x20, 0x6b, 0x38, 0xb4, 0x5f, 0x76, 0x17, 0xfa, 0x4a,
+	0x1d, 0x1a, 0x21, 0x48, 0x4f, 0xd8, 0xc9, 0x07, 0x55, 0xe6, 0x42, 0x13, 0xf8, 0x84, 0xf9, 0xcb,
+	0x0d, 0x6a, 0xcb, 0x7b, 0x3e, 0x2d, 0xfe, 0xb0, 0xc9, 0xae, 0xd5, 0xd5, 0x86, 0xad, 0x35, 0x66,
+	0xd6, 0xf5, 0x47, 0x5f, 0x71, 0x2e, 0xd3, 0xd3, 0x70, 0xa4, 0x6f, 0xd5, 0xe0, 0xd6, 0xba, 0x86,
+	0x4c, 0x5b, 0xeb, 0x1a, 0x22, 0x17, 0x75, 0x55, 0xbc, 0xc7, 0x6c, 0x1f, 0xe1, 0x7e, 0x32, 0x1a,
+	0xc9, 0x7e, 0x9e, 0xa4, 0xd4, 0x58, 0x03, 0x1b, 0xfb, 0xad, 0x79, 0x11, 0x9c, 0xc5, 0xb2, 0x28,
+	0x82, 0x2f, 0x59, 0x0d, 0x2e, 0x33, 0x70, 0x71, 0x56, 0x55, 0xd8, 0x04, 0x8c, 0xc3, 0x3f, 0xe9,
+	0x1d, 0x43, 0x7a, 0xa2, 0x9f, 0x4c, 0xe3, 0xdc, 0x6f, 0x52, 0xee, 0xdf, 0xa5, 0xd0, 0x26, 0xc0,
+	0xc5, 0xb9, 0xa8, 0x30, 0x7a, 0x3d, 0x76, 0x75, 0x5c, 0xaa, 0xb3, 0x37, 0x49, 0x46, 0x51, 0x7f,
+	0xa6, 0x93, 0x8e, 0xbf, 0x36, 0x2f, 0x82, 0x65, 0xe2, 0xa2, 0x08, 0xfc, 0x32, 0x59, 0xe0, 0x92,
+	0xb8, 0x58, 0x66, 0xe7, 0x3f, 0xd8, 0x60, 0x7b, 0xf7, 0xe2, 0x2c, 0x0f, 0x47, 0xa3, 0xdf, 0x01,
+	0x45, 0xbe, 0x04, 0xdf, 0x1a, 0x3a, 0xb7, 0x26, 0x5a, 0x17, 0xb8, 0x35, 0xf1, 0x2e, 0xdb, 0x80,
+	0x5d, 0xa7, 0xfd, 0xb5, 0x21, 0x0a, 0x28, 0x41, 0x12, 0x50, 0x42, 0x5c, 0x10, 0x19, 0xde, 0x35,
+	0xcb, 0x8e, 0x7b, 0x27, 0x72, 0xe6, 0x77, 0xe8, 0x5d, 0x35, 0x44, 0xef, 0xaa, 0x01, 0x48, 0x00,
+	0xab, 0x27, 0x27, 0x9f, 0xdd, 0x3d, 0x6f, 0x3e, 0xfb, 0x2d, 0xd6, 0x9a, 0x24, 0x69, 0x6e, 0x87,
+	0x72, 0x50, 0x26, 0x5f, 0x03, 0x25, 0x2e, 0x10, 0x5c, 0xfa, 0x08, 0x77, 0xe3, 0x19, 0x3e, 0xc2,
+	0xc5, 0xa0, 0xba, 0x7f, 0xf2, 0x75, 0x1c, 0x5c, 0x46, 0xdd, 0x36, 0x18, 0x75, 0xdb, 0x20, 0x10,
+	0x54, 0xeb, 0x47, 0x3c, 0x54, 0x9c, 0x44, 0x20, 0x4f, 0xa6, 0xbd, 0x69, 0x3a, 0xd2, 0x0b, 0xa6,
+	0x3a, 0x54, 0xb4, 0x09, 0xd6, 0xa1, 0xa2, 0x0d, 0xc3, 0xa1, 0xa2, 0x5d, 0x86, 0xe1, 0xd3, 0xa5,
+	0x7e, 0xe8, 0x6f, 0xd1, 0xf0, 0x95, 0x20, 0x0d, 0x5f, 0x09, 0x71, 0x41, 0x64, 0x10, 0xd0, 0x1f,
+	0x45, 0xe0, 0x35, 0xfa, 0xa1, 0x7f, 0x85, 0x04, 0x94, 0x20, 0x09, 0x28, 0x21, 0xd8, 0x49, 0x9a,
+	0x67, 0x4c, 0xd9, 0xa8, 0x02, 0x98, 0xc0, 0x36, 0x5d, 0xbb, 0x21, 0xd4, 0x4a, 0xd9, 0x94, 0x18,
+	0xa4, 0x6c, 0xca, 0x02, 0x8c, 0x90, 0x2e, 0xe5, 0xc9, 0x89, 0x8c, 0xfd, 0x1d, 0x1a, 0x21, 0x1b,
+	0xa7, 0x11, 0xb2, 0x51, 0x2e, 0x1c, 0x26, 0x5c, 0xfd, 0xd2, 0xe4, 0x11, 0x9c, 0x38, 0xee, 0x92,
+	0x45, 0x6a, 0xc8, 0x5a, 0xfd, 0x14, 0x00, 0xab, 0x9f, 0x7a, 0xaa, 0xdc, 0x20, 0xba, 0x7a, 0xb1,
+	0x1b, 0x44, 0x70, 0xe2, 0x90, 0xca, 0x81, 0x8c, 0xf3, 0x28, 0x1c, 0x81, 0x91, 0x78, 0x34, 0xc4,
+	0x0e, 0x81, 0x86, 0xd8, 0x81, 0xe1, 0xc4, 0xc1, 0x29, 0xff, 0x43, 0x87, 0xf9, 0xe8, 0x8e, 0x0e,
+	0x65, 0x1e, 0x0e, 0xc2, 0x3c, 0xfc, 0x6e, 0x94, 0xbd, 0x00, 0xd7, 0x64, 0x4f, 0xd7, 0xe6, 0x79,
+	0xa7, 0x6b, 0x75, 0x06, 0xb6, 0x9e, 0x65, 0x06, 0xfe, 0x6c, 0xde, 0xd1, 0x72, 0x8d, 0xa7, 0x7b,
+	0x31, 0xe3, 0xb1, 0x2f, 0x7a, 0xad, 0x9f, 0xf7, 0xa2, 0x17, 0x7c, 0x27, 0x23, 0x67, 0x8f, 0x93,
+	0x74, 0xe0, 0x6f, 0xd0, 0xd0, 0x6a, 0xc8, 0xfa, 0x4e, 0x46, 0x01, 0xf0, 0x9d, 0x8c, 0x7a, 0x82,
+	0x8a, 0x59, 0x92, 0xe6, 0xbd, 0xa3, 0x99, 0xcf, 0xa8, 0xa2, 0x86, 0xa8, 0xa2, 0x06, 0xc0, 0x85,
+	0xab, 0x27, 0xb0, 0xbe, 0x24, 0x1d, 0xc8, 0xd4, 0xdf, 0x24, 0xeb, 0x43, 0x80, 0xac, 0x0f, 0x8b,
+	0x5c, 0x28, 0x18, 0xdd, 0x36, 0xfc, 0xef, 0xc6, 0x16, 0x1d, 0xfa, 0x4d, 0xd4, 0x9f, 0x6e, 0x18,
+	0xb7, 0x8d, 0xff, 0xb8, 0x81, 0x20, 0x30, 0x67, 0xd1, 0xa7, 0xd2, 0xbf, 0x42, 0xcc, 0x50, 0x26,
+	0x66, 0x28, 0x71, 0x81, 0x20, 0xff, 0xef, 0x06, 0x7b, 0xb5, 0x66, 0x96, 0xd0, 0x55, 0x09, 0xb5,
+	0x1b, 0x54, 0x31, 0xd6, 0x4a, 0xbb, 0x41, 0xec, 0x68, 0xe3, 0x3c, 0x1d, 0x6d, 0xae, 0xd0, 0x51,
+	0xef, 0x3e, 0x6b, 0x43, 0xac, 0x09, 0x3b, 0x59, 0xd8, 0x77, 0x7c, 0xc9, 0x09, 0x36, 0x9d, 0x37,
+	0xc0, 0x48, 0x13, 0xbb, 0x8a, 0x15, 0xa8, 0xab, 0x58, 0xe4, 0x42, 0xc1, 0xde, 0x1f, 0xd2, 0xb5,
+	0xf4, 0x76, 0x4d, 0xfc, 0xea, 0x88, 0x3c, 0xe7, 0xc5, 0x74, 0xfe, 0xa3, 0x0d, 0x76, 0x75, 0xa9,
+	0x4f, 0x4b, 0xb3, 0x79, 0xed, 0x59, 0x66, 0x73, 0xe9, 0xc1, 0x1a, 0xe7, 0xf7, 0x60, 0xcd, 0x8b,
+	0x05, 0x57, 0xad, 0x15, 0x83, 0xab, 0x3b, 0xac, 0xdb, 0xcf, 0x26, 0xf0, 0x57, 0x23, 0x7e, 0x9b,
+	0x5a, 0xd2, 0x10, 0xb5, 0xa4, 0x01, 0x2e, 0x0c, 0xa9, 0xe2, 0x0b, 0x3a, 0x97, 0x72, 0x15, 0xb5,
+	0x7b, 0x69, 0x6e, 0x6e, 0xfd, 0x59, 0xdc, 0xdc, 0x67, 0xcc, 0x57, 0xe5, 0x69, 0x6c, 0x3e, 0x8f,
+	0x48, 0x65, 0x36, 0xc1, 0x1b, 0x2e, 0xea, 0x82, 0x28, 0x5e, 0x10, 0x39, 0x8b, 0x87, 0x2e, 0x88,
+	0x9c, 0xc5, 0xc1, 0xc5, 0x99, 0x95, 0xdd, 0x68, 0x97, 0x5d, 0x20, 0xda, 0xb5, 0x83, 0xb7, 0xcd,
+	0xf3, 0x06, 0x6f, 0xce, 0x45, 0xda, 0xad, 0x0b, 0x5c, 0xa4, 0xb5, 0xbd, 0xfb, 0x95, 0x0b, 0x78,
+	0x77, 0x13, 0xd5, 0x6c, 0x9f, 0x2b, 0xaa, 0xc1, 0xa3, 0xfa, 0x61, 0x94, 0x98, 0xa8, 0x4a, 0x1f,
+	0xd5, 0x0f, 0x23, 0xf7, 0xa8, 0x7e, 0x18, 0xe9, 0xa3, 0xfa, 0xa1, 0x4e, 0x5c, 0xe7, 0x69, 0x18,
+	0x67, 0x18, 0x64, 0xef, 0x92, 0xa6, 0x4b, 0x90, 0xde, 0xb5, 0x84, 0xb8, 0x20, 0x32, 0xbc, 0x6b,
+	0x92, 0x4e, 0x8e, 0x43, 0xf8, 0x5c, 0x04, 0x22, 0xa9, 0x75, 0xf5, 0xae, 0x06, 0xa3, 0x77, 0x35,
+	0x08, 0x7c, 0x36, 0xa9, 0x1f, 0xc1, 0xf4, 0xcd, 0x73, 0x2f, 0xcc, 0x31, 0x82, 0x6a, 0x2a, 0xd3,
+	0xb7, 0x60, 0x32, 0x7d, 0x0b, 0xe4, 0xc2, 0x66, 0xe1, 0xff, 0xdb, 0x60, 0xd7, 0xea, 0x1c, 0xe0,
+	0xf9, 0x17, 0x84, 0x3b, 0xac, 0xab, 0xcd, 0x51, 0xaf, 0x09, 0xa8, 0x7e, 0x0d, 0x91, 0xfa, 0x35,
+	0xc0, 0x85, 0x21, 0xe1, 0x06, 0xcb, 0x58, 0xb2, 0x5e, 0x21, 0xd4, 0x06, 0x2b, 0xa6, 0xca, 0x66,
+	0x83, 0x15, 0x97, 0xd5, 0x89, 0xec, 0x3d, 0x60, 0xcd, 0x7e, 0x36, 0xd1, 0xcb, 0xc5, 0x2f, 0x3f,
+	0xd5, 0xb7, 0xbf, 0x7d, 0x37, 0x9b, 0x7c, 0x2b, 0xce, 0xd3, 0xd9, 0x7b, 0xd7, 0xe7, 0x45, 0x00,
+	0x55, 0x17, 0x45, 0xc0, 0x4a, 0x4f, 0xc5, 0x05, 0x40, 0x07, 0x1f, 0xb3, 0x75, 0xc3, 0xe7, 0xed,
+	0xb2, 0x26, 0x84, 0xee, 0xe8, 0xc5, 0x05, 0x3c, 0x7a, 0xb7, 0x59, 0x1b, 0xf3, 0x5a, 0x3a, 0xbb,
+	0x76, 0x63, 0xb9, 0xd1, 0xdf, 0xc3, 0xce, 0xdd, 0x85, 0x7d, 0xb9, 0x50, 0xbc, 0xdf, 0x68, 0x7c,
+	0x7d, 0x8d, 0xff, 0x68, 0x8d, 0xed, 0x56, 0xe9, 0x2f, 0x91, 0xae, 0xdf, 0xf9, 0xa7, 0x6d, 0xd6,
+	0x3c, 0xbc, 0xff, 0x3d, 0xef, 0x21, 0xdb, 0xfe, 0x40, 0xe6, 0xd6, 0xb7, 0x95, 0xde, 0xcd, 0xca,
+	0x8d, 0xb1, 0xa5, 0x7f, 0x24, 0x3a, 0xb8, 0xb9, 0xf4, 0x35, 0x58, 0xe5, 0x4b, 0x3b, 0xfe, 0x8a,
+	0x17, 0xb1, 0xeb, 0x5a, 0x6e, 0xe5, 0x5b, 0x8b, 0x37, 0xce, 0xfa, 0x94, 0xcc, 0x6d, 0xe3, 0xcd,
+	0x27, 0xb0, 0x55, 0x9a, 0xfa, 0x84, 0xdd, 0x70, 0x9b, 0x12, 0xf0, 0x8e, 0xd1, 0xf8, 0x39, 0x36,
+	0x39, 0x60, 0x9e, 0x6e, 0xd2, 0xbe, 0xe8, 0xf6, 0xd5, 0x25, 0x01, 0xb5, 0x57, 0x7b, 0x0f, 0xbe,
+	0xf2, 0x24, 0x3e, 0xab, 0x95, 0x21, 0xdb, 0xff, 0x40, 0xe6, 0xce, 0xff, 0x60, 0x99, 0x9b, 0x11,
+	0x5f, 0xa9, 0x0c, 0x52, 0xed, 0x5f, 0x65, 0x55, 0x1a, 0x3a, 0xe3, 0x3f, 0x92, 0xf8, 0x2b, 0x5e,
+	0xc2, 0x5e, 0xab, 0x69, 0xa8, 0x3c, 0x83, 0x5d, 0xad, 0xb1, 0xaf, 0x3d, 0xed, 0xcf, 0x4a, 0xac,
+	0x06, 0xc7, 0xec, 0xa0, 0xda, 0xa0, 0x75, 0x48, 0xb9, 0x5a, 0x7b, 0x6f, 0x3e, 0xe5, 0x4f, 0x3b,
+	0x1c, 0x63, 0xf4, 0xab, 0xcd, 0x95, 0x47, 0x44, 0xab, 0x35, 0xf6, 0xc6, 0x13, 0xff, 0x16, 0xe1,
+	0xc9, 0xaa, 0xb4, 0xb3, 0xe5, 0x17, 0x51, 0xe5, 0x13, 0xbe, 0x8a, 0xe7, 0xaf, 0x78, 0xf7, 0xd9,
+	0x0e, 0x36, 0x68, 0x19, 0x47, 0xf5, 0xa3, 0x12, 0x57, 0xf8, 0xeb, 0x75, 0x77, 0x73, 0x2c, 0x81,
+	0xdf, 0x67, 0x7b, 0x96, 0xc0, 0xd2, 0x08, 0x9e, 0x2c, 0xf4, 0xe6, 0x59, 0x87, 0xfe, 0x96, 0xe0,
+	0x8f, 0x71, 0xd2, 0x60, 0x4d, 0x6b, 0xb0, 0x9f, 0x2c, 0x37, 0x38, 0xe3, 0xf0, 0xdb, 0x12, 0xfb,
+	0x21, 0xdb, 0x35, 0x62, 0xcb, 0x41, 0x7d, 0xb2, 0xd0, 0x1b, 0xb5, 0xc7, 0x8b, 0xf5, 0x2a, 0xb0,
+	0x07, 0xef, 0x3c, 0x2a, 0xa8, 0x39, 0xf6, 0xe1, 0xaf, 0x78, 0x7f, 0xcc, 0xb6, 0x1e, 0xc8, 0x1c,
+	0x34, 0x8b, 0x39, 0xf2, 0x8a, 0x39, 0x9c, 0x71, 0x4c, 0x70, 0xf0, 0xc6, 0x53, 0xb8, 0x4a, 0xf1,
+	0xdf, 0x61, 0x5b, 0x1f, 0xd8, 0xe2, 0x3d, 0xa7, 0x22, 0xfe, 0x4d, 0xe4, 0xea, 0xc2, 0x0e, 0xd9,
+	0xb6, 0x90, 0xd9, 0xa5, 0x89, 0xfb, 0x7d, 0xb6, 0x65, 0x67, 0x9c, 0x2b, 0xcb, 0x4c, 0x4d, 0x32,
+	0xba, 0x62, 0xa8, 0x95, 0xff, 0xb6, 0x44, 0x27, 0x7c, 0x15, 0xb6, 0xbe, 0x4e, 0x68, 0x50, 0xf1,
+	0xf5, 0x67, 0x65, 0x93, 0x0e, 0xbe, 0xfa, 0x34, 0x36, 0xd3, 0xca, 0x51, 0x07, 0xff, 0x66, 0xf3,
+	0xf6, 0x4f, 0x06, 0x00, 0x5b, 0xfb, 0xc4, 0x23, 0xaa, 0x53, 0x00, 0x00,
 }
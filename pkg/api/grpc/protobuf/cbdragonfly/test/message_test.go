@@ -0,0 +1,89 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/grpc/protobuf/cbdragonfly"
+)
+
+// TestDescriptorFields 메시지 구조체 필드와 등록된 파일 디스크립터 필드 일치 여부 확인 (디스크립터에 없는 필드는 직렬화되지 않음)
+func TestDescriptorFields(t *testing.T) {
+	fd, err := protoregistry.GlobalFiles.FindFileByPath("cbdragonfly/cbdragonfly.proto")
+	if err != nil {
+		t.Fatalf("failed to find file descriptor, error=%s", err)
+	}
+	messages := fd.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		msgType := proto.MessageType(string(md.FullName()))
+		if msgType == nil {
+			t.Errorf("message type %s is not registered", md.FullName())
+			continue
+		}
+		structType := msgType.Elem()
+		var fieldCnt int
+		for j := 0; j < structType.NumField(); j++ {
+			if _, ok := structType.Field(j).Tag.Lookup("protobuf"); ok {
+				fieldCnt++
+			}
+		}
+		if fieldCnt != md.Fields().Len() {
+			t.Errorf("expected %d fields of %s in descriptor, got %d", fieldCnt, md.FullName(), md.Fields().Len())
+		}
+	}
+}
+
+func TestAgentMetadataInfoRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name string
+		msg  proto.Message
+		dst  proto.Message
+	}{
+		{
+			name: "agent metadata",
+			msg: &cbdragonfly.AgentMetadataInfo{
+				ServiceType:           "mcis",
+				NsId:                  "ns01",
+				McisId:                "mcis01",
+				VmId:                  "vm01",
+				CspType:               "aws",
+				AgentType:             "push",
+				AgentState:            "enable",
+				AgentHealth:           "healthy",
+				AgentUnhealthyRespCnt: 1,
+				PublicIp:              "10.0.0.1",
+				LastSeen:              1700000000,
+				Liveness:              "healthy",
+				Profile:               "web",
+				Region:                "ap-northeast-2",
+				Transport:             "nats",
+				Orphaned:              true,
+				OrphanedAt:            1700000100,
+			},
+			dst: &cbdragonfly.AgentMetadataInfo{},
+		},
+		{
+			name: "vm monitoring request",
+			msg:  &cbdragonfly.VMMonQryRequest{NsId: "ns01", McisId: "mcis01", VmId: "vm01", Duration: "5m", ServiceType: "mcis"},
+			dst:  &cbdragonfly.VMMonQryRequest{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := proto.Marshal(tc.msg)
+			if err != nil {
+				t.Fatalf("failed to marshal message, error=%s", err)
+			}
+			if err := proto.Unmarshal(data, tc.dst); err != nil {
+				t.Fatalf("failed to unmarshal message, error=%s", err)
+			}
+			if !reflect.DeepEqual(tc.msg, tc.dst) {
+				t.Errorf("expected %v, got %v", tc.msg, tc.dst)
+			}
+		})
+	}
+}
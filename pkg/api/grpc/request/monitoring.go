@@ -142,6 +142,45 @@ func (monReq *MonitoringRequest) GetMCISMonInfo(mcisMonQueryRequest pb.VMMCISMon
 	return monReq.convertResponseToString(resp)
 }
 
+// GetMCISServiceMonInfo
+func (monReq *MonitoringRequest) GetMCISServiceMonInfo(mcisServiceMonQueryRequest pb.MCISServiceMonQryRequest) (string, error) {
+	// set timeout context
+	ctx, cancel := context.WithTimeout(context.Background(), monReq.Timeout)
+	defer cancel()
+
+	resp, err := monReq.Client.GetMCISServiceMonInfo(ctx, &mcisServiceMonQueryRequest)
+	if err != nil {
+		return "", err
+	}
+	return monReq.convertResponseToString(resp)
+}
+
+// GetMCISServiceRealtimeMonInfo
+func (monReq *MonitoringRequest) GetMCISServiceRealtimeMonInfo(mcisServiceMonQueryRequest pb.MCISServiceMonQryRequest) (string, error) {
+	// set timeout context
+	ctx, cancel := context.WithTimeout(context.Background(), monReq.Timeout)
+	defer cancel()
+
+	resp, err := monReq.Client.GetMCISServiceRealtimeMonInfo(ctx, &mcisServiceMonQueryRequest)
+	if err != nil {
+		return "", err
+	}
+	return monReq.convertResponseToString(resp)
+}
+
+// GetMCISRightsizing
+func (monReq *MonitoringRequest) GetMCISRightsizing(mcisRightsizingQueryRequest pb.MCISRightsizingQryRequest) (string, error) {
+	// set timeout context
+	ctx, cancel := context.WithTimeout(context.Background(), monReq.Timeout)
+	defer cancel()
+
+	resp, err := monReq.Client.GetMCISRightsizing(ctx, &mcisRightsizingQueryRequest)
+	if err != nil {
+		return "", err
+	}
+	return monReq.convertResponseToString(resp)
+}
+
 // InstallAgent
 func (monReq *MonitoringRequest) InstallAgent(installAgentRequest pb.InstallAgentRequest) (string, error) {
 	// set timeout context
@@ -158,6 +197,19 @@ func (monReq *MonitoringRequest) InstallAgent(installAgentRequest pb.InstallAgen
 	return monReq.convertResponseToString(resp)
 }
 
+// ListAgentMetadata
+func (monReq *MonitoringRequest) ListAgentMetadata(agentMetadataListRequest pb.AgentMetadataListRequest) (string, error) {
+	// set timeout context
+	ctx, cancel := context.WithTimeout(context.Background(), monReq.Timeout)
+	defer cancel()
+
+	resp, err := monReq.Client.ListAgentMetadata(ctx, &agentMetadataListRequest)
+	if err != nil {
+		return "", err
+	}
+	return monReq.convertResponseToString(resp)
+}
+
 // convertResponseToString - convert response object to string
 func (monReq *MonitoringRequest) convertResponseToString(response interface{}) (string, error) {
 	result, err := common.ConvertToOutput(monReq.OutType, response)
@@ -110,6 +110,22 @@ func (monApi *MonitoringAPI) GetMCISMonInfo(mcisMonQueryRequest pb.VMMCISMonQryR
 	return monApi.monRequest.GetMCISMonInfo(mcisMonQueryRequest)
 }
 
+func (monApi *MonitoringAPI) GetMCISServiceMonInfo(mcisServiceMonQueryRequest pb.MCISServiceMonQryRequest) (string, error) {
+	return monApi.monRequest.GetMCISServiceMonInfo(mcisServiceMonQueryRequest)
+}
+
+func (monApi *MonitoringAPI) GetMCISServiceRealtimeMonInfo(mcisServiceMonQueryRequest pb.MCISServiceMonQryRequest) (string, error) {
+	return monApi.monRequest.GetMCISServiceRealtimeMonInfo(mcisServiceMonQueryRequest)
+}
+
+func (monApi *MonitoringAPI) GetMCISRightsizing(mcisRightsizingQueryRequest pb.MCISRightsizingQryRequest) (string, error) {
+	return monApi.monRequest.GetMCISRightsizing(mcisRightsizingQueryRequest)
+}
+
 func (monApi *MonitoringAPI) InstallAgent(installAgentRequest pb.InstallAgentRequest) (string, error) {
 	return monApi.monRequest.InstallAgent(installAgentRequest)
 }
+
+func (monApi *MonitoringAPI) ListAgentMetadata(agentMetadataListRequest pb.AgentMetadataListRequest) (string, error) {
+	return monApi.monRequest.ListAgentMetadata(agentMetadataListRequest)
+}
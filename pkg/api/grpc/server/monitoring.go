@@ -33,6 +33,48 @@ func (c MonitoringService) GetMCISMonInfo(ctx context.Context, request *pb.VMMCI
 	return &resp, nil
 }
 
+func (c MonitoringService) GetMCISServiceMonInfo(ctx context.Context, request *pb.MCISServiceMonQryRequest) (*pb.MCISServiceMonInfoResponse, error) {
+	monInfo, statusCode, err := mcis.GetMCISMonInfo(request.NsId, request.McisId, request.Authorization)
+	if statusCode != http.StatusOK {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.GetMCISServiceMonInfo()")
+	}
+
+	var resp pb.MCISServiceMonInfoResponse
+	err = common.CopySrcToDest(monInfo, &resp)
+	if err != nil {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.GetMCISServiceMonInfo()")
+	}
+	return &resp, nil
+}
+
+func (c MonitoringService) GetMCISServiceRealtimeMonInfo(ctx context.Context, request *pb.MCISServiceMonQryRequest) (*pb.MCISServiceMonInfoResponse, error) {
+	monInfo, statusCode, err := mcis.GetMCISRealtimeMonInfo(request.NsId, request.McisId, request.Authorization, int(request.Timeout))
+	if statusCode != http.StatusOK {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.GetMCISServiceRealtimeMonInfo()")
+	}
+
+	var resp pb.MCISServiceMonInfoResponse
+	err = common.CopySrcToDest(monInfo, &resp)
+	if err != nil {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.GetMCISServiceRealtimeMonInfo()")
+	}
+	return &resp, nil
+}
+
+func (c MonitoringService) GetMCISRightsizing(ctx context.Context, request *pb.MCISRightsizingQryRequest) (*pb.MCISRightsizingResponse, error) {
+	rightsizing, statusCode, err := mcis.GetMCISRightsizing(request.NsId, request.McisId, request.Authorization, request.Window)
+	if statusCode != http.StatusOK {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.GetMCISRightsizing()")
+	}
+
+	var resp pb.MCISRightsizingResponse
+	err = common.CopySrcToDest(rightsizing, &resp)
+	if err != nil {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.GetMCISRightsizing()")
+	}
+	return &resp, nil
+}
+
 func (c MonitoringService) GetVMOnDemandMonCpuInfo(ctx context.Context, request *pb.VMOnDemandMonQryRequest) (*pb.CpuOnDemandInfoResponse, error) {
 	cpuMetric, statusCode, err := mcis.GetVMOnDemandMonInfo(types.Cpu.ToString(), request.AgentIp)
 	if statusCode != http.StatusOK {
@@ -185,7 +227,7 @@ func (c MonitoringService) GetVMMonCpuInfo(ctx context.Context, request *pb.VMMo
 		ServiceID:           request.McisId,
 		VMID:                request.VmId,
 		MetricName:          types.Cpu.ToString(),
-		MonitoringMechanism: config.GetInstance().Monitoring.DefaultPolicy == types.PushPolicy,
+		MonitoringMechanism: agentcommon.IsPushAgent(request.NsId, request.McisId, request.VmId),
 		Period:              request.PeriodType,
 		AggegateType:        request.StatisticsCriteria,
 		Duration:            request.Duration,
@@ -224,7 +266,7 @@ func (c MonitoringService) GetVMMonCpuFreqInfo(ctx context.Context, request *pb.
 		ServiceID:           request.McisId,
 		VMID:                request.VmId,
 		MetricName:          types.CpuFrequency.ToString(),
-		MonitoringMechanism: config.GetInstance().Monitoring.DefaultPolicy == types.PushPolicy,
+		MonitoringMechanism: agentcommon.IsPushAgent(request.NsId, request.McisId, request.VmId),
 		Period:              request.PeriodType,
 		AggegateType:        request.StatisticsCriteria,
 		Duration:            request.Duration,
@@ -262,7 +304,7 @@ func (c MonitoringService) GetVMMonMemoryInfo(ctx context.Context, request *pb.V
 		ServiceID:           request.McisId,
 		VMID:                request.VmId,
 		MetricName:          types.Memory.ToString(),
-		MonitoringMechanism: config.GetInstance().Monitoring.DefaultPolicy == types.PushPolicy,
+		MonitoringMechanism: agentcommon.IsPushAgent(request.NsId, request.McisId, request.VmId),
 		Period:              request.PeriodType,
 		AggegateType:        request.StatisticsCriteria,
 		Duration:            request.Duration,
@@ -300,7 +342,7 @@ func (c MonitoringService) GetVMMonDiskInfo(ctx context.Context, request *pb.VMM
 		ServiceID:           request.McisId,
 		VMID:                request.VmId,
 		MetricName:          types.Disk.ToString(),
-		MonitoringMechanism: config.GetInstance().Monitoring.DefaultPolicy == types.PushPolicy,
+		MonitoringMechanism: agentcommon.IsPushAgent(request.NsId, request.McisId, request.VmId),
 		Period:              request.PeriodType,
 		AggegateType:        request.StatisticsCriteria,
 		Duration:            request.Duration,
@@ -338,7 +380,7 @@ func (c MonitoringService) GetVMMonNetworkInfo(ctx context.Context, request *pb.
 		ServiceID:           request.McisId,
 		VMID:                request.VmId,
 		MetricName:          types.Network.ToString(),
-		MonitoringMechanism: config.GetInstance().Monitoring.DefaultPolicy == types.PushPolicy,
+		MonitoringMechanism: agentcommon.IsPushAgent(request.NsId, request.McisId, request.VmId),
 		Period:              request.PeriodType,
 		AggegateType:        request.StatisticsCriteria,
 		Duration:            request.Duration,
@@ -435,6 +477,9 @@ func (c MonitoringService) InstallAgent(ctx context.Context, request *pb.Install
 		ClientCA:     request.ClientCa,
 		ClientKey:    request.ClientKey,
 		ClientToken:  request.ClientToken,
+		Profile:      request.Profile,
+		AgentType:    request.AgentType,
+		CredentialId: request.CredentialId,
 	}
 	statusCode, err := coreagent.InstallAgent(*requestInfo)
 	if statusCode != http.StatusOK {
@@ -442,3 +487,32 @@ func (c MonitoringService) InstallAgent(ctx context.Context, request *pb.Install
 	}
 	return &pb.MessageResponse{Message: "agent installation is finished"}, nil
 }
+
+func (c MonitoringService) ListAgentMetadata(ctx context.Context, request *pb.AgentMetadataListRequest) (*pb.AgentMetadataListResponse, error) {
+	filter := agentcommon.AgentListFilter{
+		NsId:        request.NsId,
+		McisId:      request.McisId,
+		CspType:     request.CspType,
+		ServiceType: request.ServiceType,
+		AgentState:  request.AgentState,
+		AgentHealth: request.AgentHealth,
+		AgentType:   request.AgentType,
+		Liveness:    request.Liveness,
+		Keyword:     request.Keyword,
+		SortBy:      request.SortBy,
+		Order:       request.Order,
+		Page:        int(request.Page),
+		Size:        int(request.Size),
+	}
+	agentMetadataList, err := agentcommon.SearchAgent(filter)
+	if err != nil {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.ListAgentMetadata()")
+	}
+
+	var resp pb.AgentMetadataListResponse
+	err = common.CopySrcToDest(agentMetadataList, &resp)
+	if err != nil {
+		return nil, common.ConvGrpcStatusErr(err, "", "MonitoringService.ListAgentMetadata()")
+	}
+	return &resp, nil
+}
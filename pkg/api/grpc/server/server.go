@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	"google.golang.org/grpc"
@@ -11,18 +13,49 @@ import (
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 )
 
-func StartGRPCServer() {
+var grpcServerMutex sync.Mutex
+var grpcServer *grpc.Server
+
+func StartGRPCServer(wg *sync.WaitGroup) {
+	defer wg.Done()
 	grpcConfig := config.GetGrpcInstance()
 	tcpConn, err := net.Listen("tcp", fmt.Sprintf("%s:%d", grpcConfig.GrpcServer.Ip, grpcConfig.GrpcServer.Port))
 	if err != nil {
 		util.GetLogger().Error("failed to listen server address: ", err)
 		return
 	}
-	grpcServer := grpc.NewServer()
-	pb.RegisterMONServer(grpcServer, MonitoringService{})
-	err = grpcServer.Serve(tcpConn)
+	server := grpc.NewServer()
+	pb.RegisterMONServer(server, MonitoringService{})
+
+	grpcServerMutex.Lock()
+	grpcServer = server
+	grpcServerMutex.Unlock()
+
+	err = server.Serve(tcpConn)
 	if err != nil {
 		util.GetLogger().Error("failed to run grpc server: ", err)
 		return
 	}
 }
+
+// StopGRPCServer 신규 요청 차단 후 처리 중인 요청 완료 시 gRPC 서버 종료 (ctx 만료 시 강제 종료)
+func StopGRPCServer(ctx context.Context) error {
+	grpcServerMutex.Lock()
+	server := grpcServer
+	grpcServerMutex.Unlock()
+	if server == nil {
+		return nil
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package agent
 import (
 	"fmt"
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	"github.com/labstack/echo/v4"
@@ -15,6 +16,7 @@ import (
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/mcis"
 
 	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 )
@@ -39,6 +41,9 @@ func InstallTelegraf(c echo.Context) error {
 	if !checkEmptyFormParam(params.ServiceType) {
 		return c.JSON(http.StatusBadRequest, rest.SetMessage("empty agent type parameter"))
 	}
+	if err := applyCredential(params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
 
 	if util.CheckMCK8SType(params.ServiceType) {
 		// 토큰 값이 비어있을 경우
@@ -68,6 +73,9 @@ func InstallTelegraf(c echo.Context) error {
 		if params.Port == "" {
 			params.Port = "22"
 		}
+		if params.Transport != "" && !agentcommon.IsValidTransport(params.Transport) {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid transport %s, transport must be kafka, http or nats", params.Transport)))
+		}
 	} else {
 		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("unsupported agentType: %s", params.ServiceType)))
 	}
@@ -90,6 +98,10 @@ func InstallTelegraf(c echo.Context) error {
 		ClientToken:   params.ClientToken,
 		PrivateDomain: params.PrivateDomain,
 		IP:            params.IP,
+		Profile:       params.Profile,
+		AgentType:     params.AgentType,
+		Transport:     params.Transport,
+		Region:        params.Region,
 	}
 
 	errCode, err := agent.InstallAgent(*requestInfo)
@@ -137,8 +149,43 @@ func GetTelegrafConfFile(c echo.Context) error {
 	strConf = strings.ReplaceAll(strConf, "{{csp_type}}", cspType)
 	strConf = strings.ReplaceAll(strConf, "{{service_type}}", serviceType)
 
-	strConf = strings.ReplaceAll(strConf, "{{mechanism}}", config.GetInstance().Monitoring.DefaultPolicy)
-	strConf = strings.ReplaceAll(strConf, "{{agent_collect_interval}}", fmt.Sprintf("%ds", config.GetInstance().Monitoring.MCISAgentInterval))
+	agentInfo := agentcommon.AgentInstallInfo{
+		NsId:        nsId,
+		McisId:      mcisId,
+		VmId:        vmId,
+		CspType:     cspType,
+		ServiceType: serviceType,
+		Profile:     c.QueryParam("profile"),
+		AgentType:   c.QueryParam("agent_type"),
+		Transport:   c.QueryParam("transport"),
+	}
+
+	// 수집 방식, 전송 방식 기반 출력 플러그인 설정
+	//   - 설정 파일 다운로드 방식은 인증서 파일을 전달할 수 없으므로 kafka TLS 설정을 지원하지 않습니다.
+	mechanism := agentcommon.ResolveAgentType(agentInfo)
+	transport := agentcommon.ResolveTransport(agentInfo)
+	agentUUID := agentcommon.MakeAgentUUID(agentInfo)
+	if strings.EqualFold(mechanism, types.PushPolicy) && transport == types.KafkaTransport && kafkasec.IsTLSEnabled(config.GetInstance().Kafka) {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage("kafka tls is not supported for downloaded telegraf config, install agent with ssh instead"))
+	}
+	outputPlugins, err := mcis.RenderOutputPlugins(mechanism, transport, agentUUID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to render output plugins, error=%s", err)))
+	}
+	strConf = strings.ReplaceAll(strConf, "{{output_plugins}}", outputPlugins)
+	strConf = strings.ReplaceAll(strConf, "{{mechanism}}", mechanism)
+
+	// 수집 프로파일 기반 수집주기, 입력 플러그인 설정
+	profile, err := agentcommon.ResolveProfile(agentInfo)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("failed to get collection profile, error=%s", err)))
+	}
+	inputPlugins, err := mcis.RenderInputPlugins(*profile)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to render input plugins, error=%s", err)))
+	}
+	strConf = strings.ReplaceAll(strConf, "{{agent_collect_interval}}", fmt.Sprintf("%ds", mcis.GetProfileInterval(*profile)))
+	strConf = strings.ReplaceAll(strConf, "{{input_plugins}}", inputPlugins)
 
 	if strings.EqualFold(config.GetInstance().Monitoring.DeployType, "helm") {
 		strConf = strings.ReplaceAll(strConf, "{{server_port}}", fmt.Sprintf("%d", config.GetInstance().Dragonfly.HelmPort))
@@ -153,7 +200,8 @@ func GetTelegrafConfFile(c echo.Context) error {
 	}
 	kafkaAddr := fmt.Sprintf("%s:%d", config.GetInstance().Kafka.EndpointUrl, kafkaPort)
 	strConf = strings.ReplaceAll(strConf, "{{broker_server}}", kafkaAddr)
-	strConf = strings.ReplaceAll(strConf, "{{topic}}", fmt.Sprintf("%s_%s_%s_%s_%s", nsId, serviceType, mcisId, vmId, cspType))
+	strConf = strings.ReplaceAll(strConf, "{{topic}}", agentcommon.GetAgentTopic(agentUUID))
+	strConf = strings.ReplaceAll(strConf, "{{agent_uuid}}", agentUUID)
 
 	return c.Blob(http.StatusOK, "text/plain", []byte(strConf))
 }
@@ -215,6 +263,9 @@ func UninstallAgent(c echo.Context) error {
 	if !checkEmptyFormParam(params.ServiceType) {
 		return c.JSON(http.StatusBadRequest, rest.SetMessage("empty agent type parameter"))
 	}
+	if err := applyCredential(params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
 
 	if util.CheckMCK8SType(params.ServiceType) {
 		// 토큰 값이 비어있을 경우
@@ -307,6 +358,14 @@ func RegisterSnapshotAgent(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, rest.SetMessage("Empty new parameter for registration of snapshot agents"))
 	}
 
+	// 자격증명 참조 값 적용
+	if err := applyCredential(&params.Base); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+	if err := applyCredential(&params.New); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+
 	// base 메타데이터 있는지 검사
 	snapshotAgentInfo := agentcommon.SnapshotAgentInstallInfo{
 		BaseAgent: agentcommon.AgentInstallInfo{
@@ -330,6 +389,9 @@ func RegisterSnapshotAgent(c echo.Context) error {
 			PublicIp:    params.New.PublicIp,
 			Port:        params.New.Port,
 			CspType:     params.New.CspType,
+			Profile:     params.New.Profile,
+			AgentType:   params.New.AgentType,
+			Transport:   params.New.Transport,
 		},
 	}
 
@@ -340,16 +402,27 @@ func RegisterSnapshotAgent(c echo.Context) error {
 	if baseMetadata == nil {
 		return c.JSON(http.StatusBadRequest, rest.SetMessage("Not found base agent info"))
 	}
+	// 수집 프로파일 미지정 시 base 에이전트 프로파일 적용
+	if snapshotAgentInfo.NewAgent.Profile == "" {
+		snapshotAgentInfo.NewAgent.Profile = baseMetadata.Profile
+	}
+	// 수집 방식 미지정 시 base 에이전트 수집 방식 적용
+	if snapshotAgentInfo.NewAgent.AgentType == "" {
+		snapshotAgentInfo.NewAgent.AgentType = baseMetadata.AgentType
+	}
 
 	errCode, err := agent.RegisterSnapshotAgent(snapshotAgentInfo)
 	if errCode != http.StatusOK {
 		return c.JSON(errCode, rest.SetMessage(err.Error()))
 	}
 
-	agentUUID := agentcommon.MakeAgentUUID(snapshotAgentInfo.NewAgent)
-	errQue := util.RingQueuePut(types.TopicAdd, agentUUID)
-	if errQue != nil {
-		return c.JSON(http.StatusBadRequest, errQue)
+	// PUSH 수집 방식일 경우 콜렉터 토픽 등록
+	if agentcommon.ResolveAgentType(snapshotAgentInfo.NewAgent) == types.PushPolicy {
+		agentUUID := agentcommon.MakeAgentUUID(snapshotAgentInfo.NewAgent)
+		errQue := util.RingQueuePut(types.TopicAdd, agentUUID)
+		if errQue != nil {
+			return c.JSON(http.StatusBadRequest, errQue)
+		}
 	}
 	return c.JSON(http.StatusOK, rest.SetMessage("Snapshot agent registration is finished"))
 }
@@ -362,3 +435,30 @@ func checkEmptyFormParam(datas ...string) bool {
 	}
 	return true
 }
+
+// applyCredential 자격증명 참조 값이 있을 경우 요청 파라미터에 자격증명 시크릿 값 설정
+func applyCredential(params *rest.AgentType) error {
+	if params.CredentialId == "" {
+		return nil
+	}
+	info := agentcommon.AgentInstallInfo{
+		ServiceType:  params.ServiceType,
+		UserName:     params.UserName,
+		SshKey:       params.SshKey,
+		ServerCA:     params.ServerCA,
+		ClientCA:     params.ClientCA,
+		ClientKey:    params.ClientKey,
+		ClientToken:  params.ClientToken,
+		CredentialId: params.CredentialId,
+	}
+	if err := agentcommon.ApplyCredential(&info); err != nil {
+		return err
+	}
+	params.UserName = info.UserName
+	params.SshKey = info.SshKey
+	params.ServerCA = info.ServerCA
+	params.ClientCA = info.ClientCA
+	params.ClientKey = info.ClientKey
+	params.ClientToken = info.ClientToken
+	return nil
+}
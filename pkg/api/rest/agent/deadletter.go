@@ -0,0 +1,80 @@
+package agent
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+const defaultDeadLetterLimit = 100
+
+// ListDeadLetter 최근 거부 메세지 목록 조회
+// @Summary List dead letter
+// @Description 파싱 실패, 미지원 메트릭, 식별 태그 오류 등으로 집계에서 제외된 최근 에이전트 메세지 목록 조회 (거부 시각 내림차순)
+// @Tags [Agent] Dead Letter
+// @Accept  json
+// @Produce  json
+// @Param agent_uuid query string false "에이전트 UUID (미입력 시 전체 에이전트)"
+// @Param reason query string false "거부 사유 (invalid_format, unknown_measurement, invalid_tag, unknown_agent)"
+// @Param limit query int false "최대 조회 건수 (기본값 100)"
+// @Success 200 {object} []common.DeadLetter
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /deadletters [get]
+func ListDeadLetter(c echo.Context) error {
+	reason := common.RejectReason(c.QueryParam("reason"))
+	if reason != "" && !common.IsValidRejectReason(reason) {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid reason %s", reason)))
+	}
+	limit := defaultDeadLetterLimit
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitStr); err != nil || limit <= 0 {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid limit %s", limitStr)))
+		}
+	}
+	deadLetterList, err := common.ListDeadLetter(c.QueryParam("agent_uuid"), reason, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get dead letter list, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, deadLetterList)
+}
+
+// ListDeadLetterStat 에이전트 별 거부 메세지 통계 조회
+// @Summary List dead letter statistics
+// @Description 에이전트 별 거부 메세지 건수, 사유 별 건수, 마지막 거부 시각 조회 (거부 건수 내림차순)
+// @Tags [Agent] Dead Letter
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} []common.DeadLetterStat
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /deadletters/stats [get]
+func ListDeadLetterStat(c echo.Context) error {
+	statList, err := common.ListDeadLetterStat()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get dead letter statistics, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, statList)
+}
+
+// DeleteDeadLetter 거부 메세지 통계, 이력 삭제
+// @Summary Delete dead letter
+// @Description 에이전트 거부 메세지 통계, 이력 삭제 (에이전트 UUID 미입력 시 전체 삭제)
+// @Tags [Agent] Dead Letter
+// @Accept  json
+// @Produce  json
+// @Param agent_uuid query string false "에이전트 UUID"
+// @Success 204
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /deadletters [delete]
+func DeleteDeadLetter(c echo.Context) error {
+	if err := common.DeleteDeadLetter(c.QueryParam("agent_uuid")); err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to delete dead letter, error=%s", err)))
+	}
+	return c.JSON(http.StatusNoContent, nil)
+}
@@ -2,12 +2,14 @@ package agent
 
 import (
 	"fmt"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	"github.com/labstack/echo/v4"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -17,19 +19,66 @@ type MetaDataListType struct {
 
 // ListAgentMetadata 에이전트 메타데이터 조회
 // @Summary List Agent Metadata
-// @Description 에이전트 메타데이터 목록 조회
+// @Description 에이전트 메타데이터 목록 조회 (page, size 파라미터가 없을 경우 필터 적용 메타데이터 맵 반환, 있을 경우 필터, 정렬, 페이지 적용 결과 반환)
 // @Tags [Agent] Monitoring Agent
 // @Accept  json
 // @Produce  json
-// @Success 200 {object}  rest.JSONResult{[DEFAULT]=[]MetaDataListType,[ID]=common.AgentInfo} "Different return structures by the given param"
-// @Failure 404 {object} rest.SimpleMsg
+// @Param ns_id query string false "네임스페이스 아이디" Enums(test_ns)
+// @Param mcis_id query string false "MCIS 아이디" Enums(test_mcis)
+// @Param csp_type query string false "CSP 타입" Enums(aws)
+// @Param service_type query string false "서비스 타입" Enums(mcis, mck8s)
+// @Param agent_state query string false "에이전트 설치 상태" Enums(enable, disable)
+// @Param agent_health query string false "에이전트 헬스 상태" Enums(healthy, unhealthy)
+// @Param agent_type query string false "에이전트 수집 방식" Enums(push, pull)
+// @Param liveness query string false "에이전트 하트비트 상태" Enums(healthy, degraded, unreachable)
+// @Param keyword query string false "검색어 (UUID, 아이디, IP 부분 일치)"
+// @Param sort_by query string false "정렬 기준" Enums(uuid, ns_id, mcis_id, vm_id, csp_type, service_type, agent_state, agent_health, agent_type, liveness)
+// @Param order query string false "정렬 순서" Enums(asc, desc)
+// @Param page query int false "페이지 번호 (1부터 시작)"
+// @Param size query int false "페이지 크기 (기본 50, 최대 1000)"
+// @Success 200 {object} rest.JSONResult{[DEFAULT]=[]MetaDataListType,[PAGE]=common.AgentListResult} "Different return structures by the given param"
+// @Failure 400 {object} rest.SimpleMsg
 // @Failure 500 {object} rest.SimpleMsg
 // @Router /agents/metadata [get]
 func ListAgentMetadata(c echo.Context) error {
-	// 에이전트 UUID 파라미터 값 추출
+	// 조회 조건 파라미터 값 추출
+	filter := common.AgentListFilter{
+		NsId:        c.QueryParam("ns_id"),
+		McisId:      c.QueryParam("mcis_id"),
+		CspType:     c.QueryParam("csp_type"),
+		ServiceType: c.QueryParam("service_type"),
+		AgentState:  c.QueryParam("agent_state"),
+		AgentHealth: c.QueryParam("agent_health"),
+		AgentType:   c.QueryParam("agent_type"),
+		Liveness:    c.QueryParam("liveness"),
+		Keyword:     c.QueryParam("keyword"),
+		SortBy:      c.QueryParam("sort_by"),
+		Order:       c.QueryParam("order"),
+	}
+
+	// 페이지 조회 조건이 없을 경우 기존 메타데이터 맵 형식으로 반환
+	page, size := c.QueryParam("page"), c.QueryParam("size")
+	if page == "" && size == "" {
+		agentMetadataList, err := common.FilterAgent(filter)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get metadata list, error=%s", err)))
+		}
+		return c.JSON(http.StatusOK, agentMetadataList)
+	}
+
+	var err error
+	if page != "" {
+		if filter.Page, err = strconv.Atoi(page); err != nil {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid page parameter, error=%s", err)))
+		}
+	}
+	if size != "" {
+		if filter.Size, err = strconv.Atoi(size); err != nil {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid size parameter, error=%s", err)))
+		}
+	}
 
-	// 파라미터 값 체크
-	agentMetadataList, err := common.ListAgent()
+	agentMetadataList, err := common.SearchAgent(filter)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get metadata list, error=%s", err)))
 	}
@@ -92,7 +141,7 @@ func GetAgentMetadata(c echo.Context) error {
 
 // PutAgentMetadata 에이전트 메타데이터 수정
 // @Summary Put Agent Metadata
-// @Description 에이전트 메타데이터 수정
+// @Description 에이전트 메타데이터 수정 (agent_type 변경 시 에이전트 수집 방식 재설정, user_name, ssh_key 또는 credential_id 필수)
 // @Tags [Agent] Monitoring Agent
 // @Accept  json
 // @Produce  json
@@ -146,6 +195,33 @@ func PutAgentMetadata(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get metadata before update metadata, error=%s", err)))
 	}
 
+	// 에이전트 수집 방식 (push, pull) 변경 요청일 경우
+	if params.AgentType != "" && !strings.EqualFold(params.AgentType, existAgentMetadata.AgentType) {
+		if err := applyCredential(params); err != nil {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+		}
+		if !checkEmptyFormParam(params.UserName, params.SshKey) {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage("bad request parameter to change agent type, user_name and ssh_key are required"))
+		}
+		if params.Port == "" {
+			params.Port = "22"
+		}
+		requestInfo.UserName = params.UserName
+		requestInfo.SshKey = params.SshKey
+		requestInfo.Port = params.Port
+		requestInfo.AgentType = params.AgentType
+
+		errCode, err := agent.ChangeAgentType(requestInfo)
+		if errCode != http.StatusOK {
+			return c.JSON(errCode, rest.SetMessage(fmt.Sprintf("failed to change agent type, error=%s", err)))
+		}
+		agentMetadata, err := common.GetAgent(requestInfo)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get metadata after change agent type, error=%s", err)))
+		}
+		return c.JSON(http.StatusOK, agentMetadata)
+	}
+
 	// 에이전트 상태 업데이트 데이터가 있을 경우
 	agentUnHealthyRespCnt := existAgentMetadata.AgentUnhealthyRespCnt
 	if !strings.EqualFold(params.AgentState, existAgentMetadata.AgentState) || !strings.EqualFold(params.AgentHealth, existAgentMetadata.AgentHealth) {
@@ -158,7 +234,11 @@ func PutAgentMetadata(c echo.Context) error {
 		common.AgentState(existAgentMetadata.AgentState),
 		common.AgentHealth(existAgentMetadata.AgentHealth))
 
-	errQue := util.RingQueuePut(types.TopicAdd, agentUUID)
+	// PUSH 수집 방식일 경우 콜렉터 토픽 등록
+	var errQue error
+	if err == nil && agentMetadata.AgentType == types.PushPolicy {
+		errQue = util.RingQueuePut(types.TopicAdd, agentUUID)
+	}
 	if err != nil || errQue != nil {
 		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to update metadata, error=%s", err)))
 	}
@@ -0,0 +1,165 @@
+package agent
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// ListProfile 수집 프로파일 목록 조회
+// @Summary List collection profile
+// @Description 에이전트 수집 프로파일 목록 조회
+// @Tags [Agent] Collection Profile
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} []common.CollectionProfile
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /profiles [get]
+func ListProfile(c echo.Context) error {
+	profileList, err := common.ListProfile()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get profile list, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, profileList)
+}
+
+// GetProfile 수집 프로파일 조회
+// @Summary Get collection profile
+// @Description 에이전트 수집 프로파일 단일 조회
+// @Tags [Agent] Collection Profile
+// @Accept  json
+// @Produce  json
+// @Param profile_name path string true "수집 프로파일 이름"
+// @Success 200 {object} common.CollectionProfile
+// @Failure 404 {object} rest.SimpleMsg
+// @Router /profile/{profile_name} [get]
+func GetProfile(c echo.Context) error {
+	profile, err := common.GetProfile(c.Param("profile_name"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, rest.SetMessage(fmt.Sprintf("failed to get profile, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+// PutProfile 수집 프로파일 생성 및 수정
+// @Summary Put collection profile
+// @Description 에이전트 수집 프로파일 생성 및 수정
+// @Tags [Agent] Collection Profile
+// @Accept  json
+// @Produce  json
+// @Param profile_name path string true "수집 프로파일 이름"
+// @Param profileInfo body common.CollectionProfile true "Details for an collection profile object"
+// @Success 200 {object} common.CollectionProfile
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /profile/{profile_name} [put]
+func PutProfile(c echo.Context) error {
+	params := common.CollectionProfile{}
+	if err := c.Bind(&params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+	params.Name = c.Param("profile_name")
+
+	profile, err := common.PutProfile(params)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("failed to put profile, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+// DeleteProfile 수집 프로파일 삭제
+// @Summary Delete collection profile
+// @Description 에이전트 수집 프로파일 삭제
+// @Tags [Agent] Collection Profile
+// @Accept  json
+// @Produce  json
+// @Param profile_name path string true "수집 프로파일 이름"
+// @Success 204
+// @Failure 400 {object} rest.SimpleMsg
+// @Router /profile/{profile_name} [delete]
+func DeleteProfile(c echo.Context) error {
+	if err := common.DeleteProfile(c.Param("profile_name")); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("failed to delete profile, error=%s", err)))
+	}
+	return c.JSON(http.StatusNoContent, nil)
+}
+
+// PutMCISProfile MCIS 수집 프로파일 지정
+// @Summary Put MCIS collection profile
+// @Description MCIS 단위 수집 프로파일 지정 (이후 프로파일 미지정으로 설치되는 에이전트에 적용)
+// @Tags [Agent] Collection Profile
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param profileInfo body common.MCISProfileInfo true "Details for an MCIS collection profile object"
+// @Success 200 {object} common.MCISProfileInfo
+// @Failure 400 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/profile [put]
+func PutMCISProfile(c echo.Context) error {
+	params := common.MCISProfileInfo{}
+	if err := c.Bind(&params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+	params.NsId = c.Param("ns_id")
+	params.McisId = c.Param("mcis_id")
+	if !checkEmptyFormParam(params.NsId, params.McisId, params.Profile) {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage("bad request parameter to put mcis profile"))
+	}
+
+	if err := common.PutMCISProfile(params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("failed to put mcis profile, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, params)
+}
+
+// ApplyAgentProfile 에이전트 수집 프로파일 변경
+// @Summary Apply collection profile to agent
+// @Description 설치된 MCIS 에이전트 수집 프로파일 변경 (telegraf.conf 재생성 후 에이전트 재시작)
+// @Tags [Agent] Collection Profile
+// @Accept  json
+// @Produce  json
+// @Param agentInfo body rest.AgentType true "Details for an Agent object (ssh 접속 정보 또는 credential_id, profile 필수)"
+// @Success 200 {object} rest.SimpleMsg
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /agent/profile [put]
+func ApplyAgentProfile(c echo.Context) error {
+	params := &rest.AgentType{}
+	if err := c.Bind(params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+	if err := applyCredential(params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+	if !checkEmptyFormParam(params.ServiceType, params.NsId, params.McisId, params.VmId, params.PublicIp, params.UserName, params.SshKey, params.CspType, params.Profile) {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage("bad request parameter to apply agent profile"))
+	}
+	if params.Port == "" {
+		params.Port = "22"
+	}
+
+	requestInfo := common.AgentInstallInfo{
+		NsId:        params.NsId,
+		McisId:      params.McisId,
+		VmId:        params.VmId,
+		PublicIp:    params.PublicIp,
+		UserName:    params.UserName,
+		SshKey:      params.SshKey,
+		CspType:     params.CspType,
+		Port:        params.Port,
+		ServiceType: params.ServiceType,
+		Profile:     params.Profile,
+	}
+
+	errCode, err := agent.ApplyAgentProfile(requestInfo)
+	if errCode != http.StatusOK {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, rest.SetMessage(fmt.Sprintf("profile %s is applied", params.Profile)))
+}
@@ -0,0 +1,78 @@
+package agent
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// ListRawMode raw 모드 설정 목록 조회
+// @Summary List raw mode
+// @Description 원본 샘플(raw) 저장 설정 목록 조회
+// @Tags [Agent] Raw Mode
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} []common.RawModeInfo
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /rawmodes [get]
+func ListRawMode(c echo.Context) error {
+	rawModeList, err := common.ListRawMode()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get raw mode list, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, rawModeList)
+}
+
+// PutRawMode 네임스페이스, MCIS raw 모드 설정
+// @Summary Put raw mode
+// @Description 네임스페이스 또는 MCIS 단위 원본 샘플(raw) 저장 설정 (집계 메트릭과 함께 에이전트 원본 샘플을 raw 데이터베이스에 저장)
+// @Tags [Agent] Raw Mode
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string false "MCIS 아이디 (미입력 시 네임스페이스 전체 적용)"
+// @Success 200 {object} common.RawModeInfo
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/rawmode [put]
+// @Router /ns/{ns_id}/mcis/{mcis_id}/rawmode [put]
+func PutRawMode(c echo.Context) error {
+	params := common.RawModeInfo{
+		NsId:   c.Param("ns_id"),
+		McisId: c.Param("mcis_id"),
+	}
+	if !checkEmptyFormParam(params.NsId) {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage("bad request parameter to put raw mode"))
+	}
+	if err := common.PutRawMode(params); err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, params)
+}
+
+// DeleteRawMode 네임스페이스, MCIS raw 모드 해제
+// @Summary Delete raw mode
+// @Description 네임스페이스 또는 MCIS 단위 원본 샘플(raw) 저장 해제
+// @Tags [Agent] Raw Mode
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string false "MCIS 아이디 (미입력 시 네임스페이스 설정 해제)"
+// @Success 204
+// @Failure 400 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/rawmode [delete]
+// @Router /ns/{ns_id}/mcis/{mcis_id}/rawmode [delete]
+func DeleteRawMode(c echo.Context) error {
+	params := common.RawModeInfo{
+		NsId:   c.Param("ns_id"),
+		McisId: c.Param("mcis_id"),
+	}
+	if err := common.DeleteRawMode(params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("failed to delete raw mode, error=%s", err)))
+	}
+	return c.JSON(http.StatusNoContent, nil)
+}
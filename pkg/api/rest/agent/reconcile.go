@@ -0,0 +1,57 @@
+package agent
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// ReconcileAgent Tumblebug 인벤토리 기반 에이전트 정합성 점검 실행
+// @Summary Reconcile agent with Tumblebug inventory
+// @Description Tumblebug MCIS, VM, MCK8S 인벤토리와 에이전트 메타데이터를 비교하여 삭제된 VM 에이전트 처리, 공인 IP 갱신, 신규 VM 에이전트 자동 설치 수행
+// @Tags [Agent] Reconcile
+// @Accept  json
+// @Produce  json
+// @Param dry_run query bool false "조치 내역만 조회 (메타데이터 미반영, 기본값 false)"
+// @Success 200 {object} agent.ReconcileResult
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Failure 502 {object} rest.SimpleMsg
+// @Router /agent/reconcile [post]
+func ReconcileAgent(c echo.Context) error {
+	dryRun := false
+	if dryRunStr := c.QueryParam("dry_run"); dryRunStr != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(dryRunStr); err != nil {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid dry_run %s", dryRunStr)))
+		}
+	}
+	result, errCode, err := agent.ReconcileAgent(dryRun)
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetReconcileResult 최근 에이전트 정합성 점검 결과 조회
+// @Summary Get agent reconcile result
+// @Description 최근 실행된 에이전트 인벤토리 정합성 점검 결과 조회 (dry run 결과 제외)
+// @Tags [Agent] Reconcile
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} agent.ReconcileResult
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /agent/reconcile [get]
+func GetReconcileResult(c echo.Context) error {
+	result, errCode, err := agent.GetReconcileResult()
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -61,6 +61,7 @@ func GetAlertTask(c echo.Context) error {
 // @Produce  json
 // @Param eventHandlerInfo body types.AlertTask true "Details for an Event object"
 // @Success 200 {object} types.AlertTask
+// @Failure 400 {object} rest.SimpleMsg
 // @Failure 404 {object} rest.SimpleMsg
 // @Failure 500 {object} rest.SimpleMsg
 // @Router /alert/task [post]
@@ -69,6 +70,9 @@ func CreateAlertTask(c echo.Context) error {
 	if err := c.Bind(params); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, rest.SetMessage(err.Error()))
 	}
+	if err := task.ValidateTagFilter(params.TagFilter); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
 	alertTask, err := task.CreateTask(*params)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, rest.SetMessage(err.Error()))
@@ -85,6 +89,7 @@ func CreateAlertTask(c echo.Context) error {
 // @Param task_id path string true "태스크 아이디"
 // @Param eventHandlerInfo body types.AlertTask true "Details for an Event object"
 // @Success 200 {object} types.AlertTask
+// @Failure 400 {object} rest.SimpleMsg
 // @Failure 404 {object} rest.SimpleMsg
 // @Failure 500 {object} rest.SimpleMsg
 // @Router /alert/task/{task_id} [put]
@@ -93,6 +98,9 @@ func UpdateAlertTask(c echo.Context) error {
 	if err := c.Bind(params); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, rest.SetMessage(err.Error()))
 	}
+	if err := task.ValidateTagFilter(params.TagFilter); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
 	alertTask, err := task.UpdateTask(params.Name, *params)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, rest.SetMessage(err.Error()))
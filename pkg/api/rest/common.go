@@ -38,6 +38,13 @@ type AgentType struct {
 	Port        string `json:"port"`
 	AgentState  string `json:"agent_state"`
 	AgentHealth string `json:"agent_health"`
+	Profile     string `json:"profile"`
+	AgentType   string `json:"agent_type"`
+	Transport   string `json:"transport"` // PUSH 메트릭 전송 방식 (kafka, http, nats)
+	Region      string `json:"region"`
+
+	// 자격증명 참조 (ssh_key, client_key, client_token 등 시크릿 값 대체)
+	CredentialId string `json:"credential_id"`
 }
 
 type SnapShotAgentType struct {
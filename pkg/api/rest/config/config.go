@@ -20,6 +20,7 @@ import (
 // @Produce  json
 // @Param monitorInfo body pkgconfig.Monitoring true "Details for an Monitor object"
 // @Success 200 {object} pkgconfig.Monitoring
+// @Failure 400 {object} rest.SimpleMsg
 // @Failure 404 {object} rest.SimpleMsg
 // @Failure 500 {object} rest.SimpleMsg
 // @Router /config [put]
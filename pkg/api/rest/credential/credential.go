@@ -0,0 +1,86 @@
+package credential
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/credential"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// ListCredential 자격증명 목록 조회
+// @Summary List agent credential
+// @Description 에이전트 자격증명 목록 조회 (시크릿 값 제외)
+// @Tags [Credential] Agent Credential management
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} []credential.Credential
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /credentials [get]
+func ListCredential(c echo.Context) error {
+	credentialList, err := credential.ListCredential()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get credential list, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, credentialList)
+}
+
+// GetCredential 자격증명 조회
+// @Summary Get agent credential
+// @Description 에이전트 자격증명 조회 (시크릿 값 제외)
+// @Tags [Credential] Agent Credential management
+// @Accept  json
+// @Produce  json
+// @Param credential_id path string true "자격증명 아이디"
+// @Success 200 {object} credential.Credential
+// @Failure 404 {object} rest.SimpleMsg
+// @Router /credential/{credential_id} [get]
+func GetCredential(c echo.Context) error {
+	credentialInfo, err := credential.GetCredential(c.Param("credential_id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, rest.SetMessage(fmt.Sprintf("failed to get credential, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, credentialInfo)
+}
+
+// RegisterCredential 자격증명 등록
+// @Summary Register agent credential
+// @Description 에이전트 자격증명 등록 (cbstore: 마스터 키 기반 암호화 저장, vault, kubernetes: 외부 저장소 시크릿 참조)
+// @Tags [Credential] Agent Credential management
+// @Accept  json
+// @Produce  json
+// @Param credentialInfo body credential.Credential true "Details for an Credential object"
+// @Success 200 {object} credential.Credential
+// @Failure 400 {object} rest.SimpleMsg
+// @Router /credential [post]
+func RegisterCredential(c echo.Context) error {
+	params := credential.Credential{}
+	if err := c.Bind(&params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+
+	credentialInfo, err := credential.RegisterCredential(params)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("failed to register credential, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, credentialInfo)
+}
+
+// DeleteCredential 자격증명 삭제
+// @Summary Delete agent credential
+// @Description 에이전트 자격증명 삭제
+// @Tags [Credential] Agent Credential management
+// @Accept  json
+// @Produce  json
+// @Param credential_id path string true "자격증명 아이디"
+// @Success 204
+// @Failure 404 {object} rest.SimpleMsg
+// @Router /credential/{credential_id} [delete]
+func DeleteCredential(c echo.Context) error {
+	if err := credential.DeleteCredential(c.Param("credential_id")); err != nil {
+		return c.JSON(http.StatusNotFound, rest.SetMessage(fmt.Sprintf("failed to delete credential, error=%s", err)))
+	}
+	return c.JSON(http.StatusNoContent, nil)
+}
@@ -3,6 +3,8 @@ package healthcheck
 import (
 	"net/http"
 
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/leader"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
 	"github.com/labstack/echo/v4"
 )
 
@@ -16,3 +18,32 @@ import (
 func Ping(c echo.Context) error {
 	return c.JSON(http.StatusNoContent, nil)
 }
+
+// GetLeaderStatus 인스턴스 리더 선출 상태 조회
+// @Summary Get Leader Election Status
+// @Description 인스턴스 리더 선출(HA) 상태 조회
+// @Tags [Health] Health Check
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} leader.Status
+// @Router /healthcheck/leader [get]
+func GetLeaderStatus(c echo.Context) error {
+	return c.JSON(http.StatusOK, leader.GetStatus())
+}
+
+// GetStorageStatus 메트릭 저장소(InfluxDB) 비동기 쓰기 파이프라인 상태 조회
+// @Summary Get Metric Storage Write Status
+// @Description 메트릭 저장소(InfluxDB) 비동기 쓰기 파이프라인 상태(버퍼, 재시도, 디스크 저장 현황) 조회
+// @Tags [Health] Health Check
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} v1.WriteStatus
+// @Failure 503 {object} v1.WriteStatus
+// @Router /healthcheck/storage [get]
+func GetStorageStatus(c echo.Context) error {
+	status := v1.GetWritePipeline().GetStatus()
+	if !status.StoreAvailable {
+		return c.JSON(http.StatusServiceUnavailable, status)
+	}
+	return c.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,109 @@
+package ingest
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/ingest"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/telegraf"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+const defaultMaxBodySize = 4096
+
+var errBodyTooLarge = errors.New("request body is too large")
+
+// IngestMetric 에이전트 메트릭 수신
+// @Summary Ingest agent metric
+// @Description HTTP 전송 방식 에이전트 메트릭 수신 (influx line protocol, telegraf json)
+// @Description 에이전트 UUID, 수신 토큰 기반 basic 인증, 메트릭의 에이전트 식별 태그는 에이전트 메타데이터 값으로 설정
+// @Description kafka 미설정 시 리더 인스턴스에서 직접 집계 (리더가 아닌 인스턴스는 NATS 사용 시 NATS 로 전달, 미사용 시 503 반환)
+// @Tags [Agent] Monitoring Agent
+// @Accept  plain
+// @Accept  json
+// @Produce  json
+// @Param precision query string false "line protocol timestamp precision (n, u, ms, s)"
+// @Success 204
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 401 {object} rest.SimpleMsg
+// @Failure 403 {object} rest.SimpleMsg
+// @Failure 413 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Failure 503 {object} rest.SimpleMsg
+// @Router /ingest [post]
+func IngestMetric(c echo.Context) error {
+	agentUUID, token, ok := c.Request().BasicAuth()
+	if !ok || !agentcommon.ValidateIngestToken(agentUUID, token) {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Basic realm=\"cb-dragonfly\"")
+		return c.JSON(http.StatusUnauthorized, rest.SetMessage("invalid agent ingest credential"))
+	}
+	agentInfo, err := ingest.GetPushAgent(agentUUID)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, rest.SetMessage(err.Error()))
+	}
+
+	body, err := readBody(c)
+	if err != nil {
+		if err == errBodyTooLarge {
+			return c.JSON(http.StatusRequestEntityTooLarge, rest.SetMessage(err.Error()))
+		}
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+
+	var metrics []telegraf.Metric
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		metrics, err = telegraf.ParseJSON(body)
+	} else {
+		metrics, err = telegraf.ParseLineProtocol(body, c.QueryParam("precision"))
+	}
+	if err != nil {
+		agentcommon.RecordDeadLetter(agentUUID, types.HTTPTransport, agentcommon.InvalidFormat, err.Error(), body)
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+
+	// kafka 설정 시 에이전트 토픽 담당 콜렉터, 미설정 시 리더 인스턴스 집계 모듈로 전달
+	if err = ingest.Relay(*agentInfo, types.HTTPTransport, metrics); err != nil {
+		if err == ingest.ErrForwardQueueFull || err == ingest.ErrIngestBufferFull || err == ingest.ErrIngestUnavailable {
+			return c.JSON(http.StatusServiceUnavailable, rest.SetMessage(err.Error()))
+		}
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(err.Error()))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// readBody 요청 본문 조회 (gzip 압축 해제, 최대 크기 max_body_size(KB) 제한)
+func readBody(c echo.Context) ([]byte, error) {
+	maxBodySize := int64(config.GetInstance().Ingestion.MaxBodySize)
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxBodySize
+	}
+	maxBodySize *= 1024
+
+	var reader io.Reader = c.Request().Body
+	if strings.EqualFold(c.Request().Header.Get(echo.HeaderContentEncoding), "gzip") {
+		gzipReader, err := gzip.NewReader(c.Request().Body)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to read gzip body, error=%s", err))
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(reader, maxBodySize+1))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to read request body, error=%s", err))
+	}
+	if int64(len(body)) > maxBodySize {
+		return nil, errBodyTooLarge
+	}
+	return body, nil
+}
@@ -0,0 +1,182 @@
+package mcis
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/lifecycle"
+)
+
+// ListBenchmarkCampaign 벤치마크 캠페인 목록 조회
+// @Summary List benchmark campaign
+// @Description 등록된 MCIS 벤치마크 캠페인 목록 및 최근 실행 결과 조회
+// @Tags [Monitoring] Benchmark
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} []mcis.BenchmarkCampaign
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /benchmark/campaigns [get]
+func ListBenchmarkCampaign(c echo.Context) error {
+	campaignList, err := mcis.ListBenchmarkCampaign()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, rest.SetMessage(fmt.Sprintf("failed to get benchmark campaign list, error=%s", err)))
+	}
+	return c.JSON(http.StatusOK, campaignList)
+}
+
+// GetBenchmarkCampaign 벤치마크 캠페인 조회
+// @Summary Get benchmark campaign
+// @Description MCIS 벤치마크 캠페인 설정 및 최근 실행 결과 조회
+// @Tags [Monitoring] Benchmark
+// @Accept  json
+// @Produce  json
+// @Param campaign_id path string true "벤치마크 캠페인 아이디"
+// @Success 200 {object} mcis.BenchmarkCampaign
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /benchmark/campaign/{campaign_id} [get]
+func GetBenchmarkCampaign(c echo.Context) error {
+	campaign, errCode, err := mcis.GetBenchmarkCampaign(c.Param("campaign_id"))
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, campaign)
+}
+
+// PutBenchmarkCampaign 벤치마크 캠페인 생성, 수정
+// @Summary Put benchmark campaign
+// @Description MCIS 벤치마크 캠페인 생성, 수정 (대상 VM, 벤치마크 종류, 실행 주기)
+// @Description 지원 벤치마크: cpus, cpum, memR, memW, fioR, fioW, dbR, dbW, rtt (rtt 는 rtt_host 필수)
+// @Description interval 은 분 단위 실행 주기이며 0 일 경우 실행 요청 시에만 실행
+// @Tags [Monitoring] Benchmark
+// @Accept  json
+// @Produce  json
+// @Param campaign_id path string true "벤치마크 캠페인 아이디"
+// @Param campaignInfo body mcis.BenchmarkCampaign true "Details for a benchmark campaign object"
+// @Success 200 {object} mcis.BenchmarkCampaign
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /benchmark/campaign/{campaign_id} [put]
+func PutBenchmarkCampaign(c echo.Context) error {
+	params := mcis.BenchmarkCampaign{}
+	if err := c.Bind(&params); err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("failed to bind request body, error=%s", err)))
+	}
+	params.Id = c.Param("campaign_id")
+	campaign, errCode, err := mcis.PutBenchmarkCampaign(params)
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, campaign)
+}
+
+// DeleteBenchmarkCampaign 벤치마크 캠페인 삭제
+// @Summary Delete benchmark campaign
+// @Description MCIS 벤치마크 캠페인 삭제 (저장된 벤치마크 이력은 보존 기간 동안 유지)
+// @Tags [Monitoring] Benchmark
+// @Accept  json
+// @Produce  json
+// @Param campaign_id path string true "벤치마크 캠페인 아이디"
+// @Success 204
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /benchmark/campaign/{campaign_id} [delete]
+func DeleteBenchmarkCampaign(c echo.Context) error {
+	errCode, err := mcis.DeleteBenchmarkCampaign(c.Param("campaign_id"))
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusNoContent, nil)
+}
+
+// RunBenchmarkCampaign 벤치마크 캠페인 실행
+// @Summary Run benchmark campaign
+// @Description MCIS 벤치마크 캠페인 즉시 실행 (비동기 실행, 결과는 캠페인 last_run 및 벤치마크 이력으로 조회)
+// @Description Authorization 헤더는 Tumblebug VM 목록 조회 시 사용
+// @Tags [Monitoring] Benchmark
+// @Accept  json
+// @Produce  json
+// @Param campaign_id path string true "벤치마크 캠페인 아이디"
+// @Success 202 {object} rest.SimpleMsg
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 409 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Failure 503 {object} rest.SimpleMsg
+// @Router /benchmark/campaign/{campaign_id}/run [post]
+func RunBenchmarkCampaign(c echo.Context) error {
+	campaignId := c.Param("campaign_id")
+	lm := lifecycle.GetInstance()
+	errCode, err := mcis.StartBenchmarkCampaign(lm.Context(), lm.WaitGroup(), campaignId, c.Request().Header.Get("Authorization"))
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusAccepted, rest.SetMessage(fmt.Sprintf("benchmark campaign %s started", campaignId)))
+}
+
+// GetBenchmarkHistory 벤치마크 측정 이력 조회
+// @Summary Get benchmark history
+// @Description VM, 벤치마크 별 측정 이력 조회 (스펙, CSP 태그 포함)
+// @Tags [Monitoring] Benchmark
+// @Accept  json
+// @Produce  json
+// @Param ns_id query string false "네임스페이스 아이디"
+// @Param mcis_id query string false "MCIS 아이디"
+// @Param vm_id query string false "VM 아이디"
+// @Param campaign_id query string false "벤치마크 캠페인 아이디"
+// @Param benchmark query string false "벤치마크 종류"
+// @Param spec_id query string false "스펙 아이디"
+// @Param csp_type query string false "CSP 종류"
+// @Param period query string false "조회 기간 (예: 7d, 24h, 기본값 30d)"
+// @Success 200 {object} []mcis.BenchmarkHistory
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /benchmark/history [get]
+func GetBenchmarkHistory(c echo.Context) error {
+	historyList, errCode, err := mcis.GetBenchmarkHistory(getBenchmarkFilter(c))
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, historyList)
+}
+
+// GetBenchmarkComparison CSP, 스펙 별 벤치마크 통계 조회
+// @Summary Get benchmark comparison
+// @Description 조회 기간 내 벤치마크, CSP, 스펙 별 평균, 최소, 최대, 표준편차 조회 (벤치마크 별 평균 내림차순)
+// @Tags [Monitoring] Benchmark
+// @Accept  json
+// @Produce  json
+// @Param ns_id query string false "네임스페이스 아이디"
+// @Param mcis_id query string false "MCIS 아이디"
+// @Param campaign_id query string false "벤치마크 캠페인 아이디"
+// @Param benchmark query string false "벤치마크 종류"
+// @Param spec_id query string false "스펙 아이디"
+// @Param csp_type query string false "CSP 종류"
+// @Param period query string false "조회 기간 (예: 7d, 24h, 기본값 30d)"
+// @Success 200 {object} []mcis.BenchmarkStat
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /benchmark/comparison [get]
+func GetBenchmarkComparison(c echo.Context) error {
+	statList, errCode, err := mcis.GetBenchmarkComparison(getBenchmarkFilter(c))
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, statList)
+}
+
+func getBenchmarkFilter(c echo.Context) mcis.BenchmarkHistoryFilter {
+	return mcis.BenchmarkHistoryFilter{
+		NsId:       c.QueryParam("ns_id"),
+		McisId:     c.QueryParam("mcis_id"),
+		VmId:       c.QueryParam("vm_id"),
+		CampaignId: c.QueryParam("campaign_id"),
+		Benchmark:  c.QueryParam("benchmark"),
+		SpecId:     c.QueryParam("spec_id"),
+		CspType:    c.QueryParam("csp_type"),
+		Period:     c.QueryParam("period"),
+	}
+}
@@ -0,0 +1,74 @@
+package mcis
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// GetMCISLatencyMatrix 멀티 클라우드 인프라 서비스 VM 간 지연 시간 매트릭스 측정
+// @Summary Get MCIS latency matrix
+// @Description MCIS 전체 VM 에이전트에 나머지 VM 의 공인, 사설 IP 대상 RTT 측정을 요청하여 N×N 지연 시간 매트릭스 조회 (응답이 없는 VM 쌍은 loss 100, error 로 표시)
+// @Description store 설정 시 측정 결과를 latency measurement 로 저장 (알람 태스크 대상, metric: rtt, loss, reachable)
+// @Description 리전 간 구간은 tag_filter interRegion: "true", 응답이 없는 구간은 tag_filter reachable: "false" 로 지정
+// @Description Authorization 헤더는 Tumblebug VM 목록 조회 시 사용
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param store query bool false "측정 결과 저장 여부 (기본값 false)"
+// @Success 200 {object} mcis.LatencyMatrix
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/latency-matrix [get]
+func GetMCISLatencyMatrix(c echo.Context) error {
+	store := false
+	if storeStr := c.QueryParam("store"); storeStr != "" {
+		var err error
+		if store, err = strconv.ParseBool(storeStr); err != nil {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid store %s", storeStr)))
+		}
+	}
+	result, errCode, err := mcis.GetMCISLatencyMatrix(c.Param("ns_id"), c.Param("mcis_id"), c.Request().Header.Get("Authorization"), store)
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetMCISLatencyHistory 멀티 클라우드 인프라 서비스 VM 간 지연 시간 이력 조회
+// @Summary Get MCIS latency history
+// @Description 저장된 VM 쌍 별 지연 시간, 손실률 이력 조회 (group_time 구간 평균, 응답이 없는 구간의 rtt 는 null)
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param period query string false "조회 기간 (예: 7d, 24h, 기본값 24h)"
+// @Param group_time query string false "집계 구간 (예: 10m, 1h, 기본값 10m)"
+// @Param inter_region query bool false "리전 간 구간만 조회 (기본값 false)"
+// @Success 200 {object} []mcis.LatencyHistory
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/latency-matrix/history [get]
+func GetMCISLatencyHistory(c echo.Context) error {
+	interRegionOnly := false
+	if interRegionStr := c.QueryParam("inter_region"); interRegionStr != "" {
+		var err error
+		if interRegionOnly, err = strconv.ParseBool(interRegionStr); err != nil {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid inter_region %s", interRegionStr)))
+		}
+	}
+	result, errCode, err := mcis.GetMCISLatencyHistory(c.Param("ns_id"), c.Param("mcis_id"), c.QueryParam("period"), c.QueryParam("group_time"), interRegionOnly)
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
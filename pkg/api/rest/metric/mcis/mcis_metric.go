@@ -1,9 +1,11 @@
 package mcis
 
 import (
+	"fmt"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 
@@ -80,3 +82,54 @@ func GetMCISMetric(c echo.Context) error {
 		return c.JSON(http.StatusOK, result)
 	}
 }
+
+// GetMCISMonInfo 멀티 클라우드 인프라 서비스 모니터링 정보 조회
+// @Summary Get MCIS monitoring info
+// @Description 멀티 클라우드 인프라 서비스 VM 별 에이전트 상태, 최신 메트릭, 상태 및 MCIS 전체 자원 사용량 합계 조회 (저장된 메트릭 기준)
+// @Description Authorization 헤더 입력 시 Tumblebug VM 스펙 기준 vCPU 할당량 포함
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Success 200 {object} mcis.MCISServiceMonInfo
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/info [get]
+func GetMCISMonInfo(c echo.Context) error {
+	result, errCode, err := mcis.GetMCISMonInfo(c.Param("ns_id"), c.Param("mcis_id"), c.Request().Header.Get("Authorization"))
+	if errCode != http.StatusOK {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetMCISRealtimeMonInfo 멀티 클라우드 인프라 서비스 실시간 모니터링 정보 조회
+// @Summary Get MCIS realtime monitoring info
+// @Description 멀티 클라우드 인프라 서비스 VM 에이전트에 병렬로 온디멘드 메트릭을 조회해 VM 별 상태 및 MCIS 전체 자원 사용량 합계 조회
+// @Description 제한 시간 내에 응답하지 않은 VM 은 error 필드에 실패 사유 표시
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param timeout query int false "에이전트 조회 제한 시간 (s, 기본값 10)"
+// @Success 200 {object} mcis.MCISServiceMonInfo
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/rt-info [get]
+func GetMCISRealtimeMonInfo(c echo.Context) error {
+	timeout := mcis.DefaultRealtimeTimeout
+	if timeoutStr := c.QueryParam("timeout"); timeoutStr != "" {
+		var err error
+		if timeout, err = strconv.Atoi(timeoutStr); err != nil || timeout <= 0 {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid timeout %s", timeoutStr)))
+		}
+	}
+	result, errCode, err := mcis.GetMCISRealtimeMonInfo(c.Param("ns_id"), c.Param("mcis_id"), c.Request().Header.Get("Authorization"), timeout)
+	if errCode != http.StatusOK {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
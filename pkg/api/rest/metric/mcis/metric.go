@@ -1,12 +1,12 @@
 package mcis
 
 import (
+	"fmt"
+	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric"
-	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/labstack/echo/v4"
 
@@ -26,7 +26,9 @@ import (
 // @Param periodType query string false "모니터링 단위" Enums(m, h, d)
 // @Param statisticsCriteria query string false "모니터링 통계 기준" Enums(min, max, avg, last)
 // @Param duration query string false "모니터링 조회 범위" Enums(5m, 5h, 5d)
+// @Param resolution query string false "모니터링 조회 해상도 (raw: raw 모드 설정 대상의 에이전트 원본 샘플 조회, periodType, statisticsCriteria 미적용)" Enums(raw)
 // @Success 200 {object} rest.VMMonInfoType
+// @Failure 400 {object} rest.SimpleMsg
 // @Failure 404 {object} rest.SimpleMsg
 // @Failure 500 {object} rest.SimpleMsg
 // @Router /ns/{ns_id}/mcis/{mcis_id}/vm/{vm_id}/metric/{metric_name}/info [get]
@@ -40,6 +42,7 @@ func GetVMMonInfo(c echo.Context) error {
 	period := c.QueryParam("periodType")
 	aggregateType := c.QueryParam("statisticsCriteria")
 	duration := c.QueryParam("duration")
+	resolution := c.QueryParam("resolution")
 	if string(duration[len(duration)-1]) == "m" {
 		durationInt, _ := strconv.Atoi(duration[:len(duration)-1])
 		if durationInt < 2 {
@@ -47,16 +50,27 @@ func GetVMMonInfo(c echo.Context) error {
 		}
 	}
 
+	pushAgent := agentcommon.IsPushAgent(nsId, mcisId, vmId)
+	if resolution != "" {
+		if resolution != types.RawResolution {
+			return echo.NewHTTPError(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("not supported resolution %s", resolution)))
+		}
+		if !pushAgent {
+			return echo.NewHTTPError(http.StatusBadRequest, rest.SetMessage("raw resolution is supported only for push agent"))
+		}
+	}
+
 	dbInfo := types.DBMetricRequestInfo{
 		NsID:                nsId,
 		ServiceType:         types.MCIS,
 		ServiceID:           mcisId,
 		VMID:                vmId,
 		MetricName:          metricName,
-		MonitoringMechanism: strings.EqualFold(config.GetInstance().Monitoring.DefaultPolicy, types.PushPolicy),
+		MonitoringMechanism: pushAgent,
 		Period:              period,
 		AggegateType:        aggregateType,
 		Duration:            duration,
+		Resolution:          resolution,
 	}
 
 	result, errCode, err := metric.GetMonInfo(dbInfo)
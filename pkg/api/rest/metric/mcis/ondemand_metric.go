@@ -89,9 +89,6 @@ func GetMCISSpec(c echo.Context) error {
 	if nsId == "" || mcisId == "" {
 		return c.JSON(http.StatusInternalServerError, errors.New("parameter is missing"))
 	}
-	if auth == "" {
-		return c.JSON(http.StatusInternalServerError, errors.New("basic auth is missing"))
-	}
 
 	result, errCode, err2 := mcis.GetMCISSpecInfo(nsId, mcisId, auth)
 	if errCode != http.StatusOK {
@@ -0,0 +1,69 @@
+package mcis
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// CaptureMCISPacketFlow 멀티 클라우드 인프라 서비스 VM 간 패킷 흐름 캡처
+// @Summary Capture MCIS packet flow
+// @Description MCIS 전체 에이전트가 나머지 VM 으로 향하는 패킷을 watch_time 동안 캡처하여 VM 별 송신 흐름 테이블 조회 (동시 캡처 VM 쌍 수는 packet_flow.max_concurrency 로 제한되며, 전체 캡처 완료까지 응답 대기)
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param watch_time query int false "캡처 시간 (s, 1 ~ 60, 기본값 10)"
+// @Param store query bool false "캡처 결과 저장 여부 (기본값 false)"
+// @Success 200 {object} mcis.MCISPacketFlow
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/packet-flow [get]
+func CaptureMCISPacketFlow(c echo.Context) error {
+	watchTime := 0
+	if watchTimeStr := c.QueryParam("watch_time"); watchTimeStr != "" {
+		var err error
+		if watchTime, err = strconv.Atoi(watchTimeStr); err != nil || watchTime <= 0 {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid watch_time %s", watchTimeStr)))
+		}
+	}
+	store := false
+	if storeStr := c.QueryParam("store"); storeStr != "" {
+		var err error
+		if store, err = strconv.ParseBool(storeStr); err != nil {
+			return c.JSON(http.StatusBadRequest, rest.SetMessage(fmt.Sprintf("invalid store %s", storeStr)))
+		}
+	}
+	result, errCode, err := mcis.CaptureMCISPacketFlow(c.Param("ns_id"), c.Param("mcis_id"), watchTime, store)
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetMCISFlowMap 멀티 클라우드 인프라 서비스 VM 간 패킷 흐름 지도 조회
+// @Summary Get MCIS packet flow map
+// @Description 조회 기간 내 저장된 패킷 캡처 결과를 VM 쌍 별 송신량, CSP 간 송신량으로 집계 (주기 캡처 구간 샘플 기준)
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param period query string false "조회 기간 (예: 1h, 7d, 기본값 24h)"
+// @Success 200 {object} mcis.MCISFlowMap
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/packet-flow/map [get]
+func GetMCISFlowMap(c echo.Context) error {
+	result, errCode, err := mcis.GetMCISFlowMap(c.Param("ns_id"), c.Param("mcis_id"), c.QueryParam("period"))
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,90 @@
+package mcis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// GetVMProcessHistory 멀티 클라우드 인프라 VM 프로세스 사용량 이력 조회
+// @Summary Get VM process history
+// @Description 조회 기간 내 수집 시각 별 CPU, 메모리 사용량 상위 프로세스 목록 조회 (설정 파일 process_collection 수집 사용 시)
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param vm_id path string true "VM 아이디"
+// @Param start query string false "조회 시작 시각 (RFC3339, 기본값 종료 시각 1시간 전)"
+// @Param end query string false "조회 종료 시각 (RFC3339, 기본값 현재 시각)"
+// @Param sort query string false "정렬 기준 (cpu, mem, 기본값 cpu)"
+// @Param limit query int false "수집 시각 별 최대 프로세스 수 (기본값 10)"
+// @Success 200 {object} []mcis.ProcessSnapshot
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/vm/{vm_id}/process-history [get]
+func GetVMProcessHistory(c echo.Context) error {
+	query, err := getProcessHistoryQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+	result, errCode, err := mcis.GetVMProcessHistory(query)
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetVMTopProcess 멀티 클라우드 인프라 VM 상위 프로세스 조회
+// @Summary Get VM top process
+// @Description 조회 기간 내 실행 파일 별 평균, 최대 CPU, 메모리 사용량 상위 프로세스 조회
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param vm_id path string true "VM 아이디"
+// @Param start query string false "조회 시작 시각 (RFC3339, 기본값 종료 시각 1시간 전)"
+// @Param end query string false "조회 종료 시각 (RFC3339, 기본값 현재 시각)"
+// @Param sort query string false "정렬 기준 (cpu, mem, 기본값 cpu)"
+// @Param limit query int false "최대 프로세스 수 (기본값 10)"
+// @Success 200 {object} []mcis.ProcessStat
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/vm/{vm_id}/process-top [get]
+func GetVMTopProcess(c echo.Context) error {
+	query, err := getProcessHistoryQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, rest.SetMessage(err.Error()))
+	}
+	result, errCode, err := mcis.GetVMTopProcess(query)
+	if err != nil {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func getProcessHistoryQuery(c echo.Context) (mcis.ProcessHistoryQuery, error) {
+	query := mcis.ProcessHistoryQuery{
+		NsId:   c.Param("ns_id"),
+		McisId: c.Param("mcis_id"),
+		VmId:   c.Param("vm_id"),
+		Start:  c.QueryParam("start"),
+		End:    c.QueryParam("end"),
+		Sort:   c.QueryParam("sort"),
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return query, errors.New(fmt.Sprintf("invalid limit %s", limitStr))
+		}
+		query.Limit = limit
+	}
+	return query, nil
+}
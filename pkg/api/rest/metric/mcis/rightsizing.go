@@ -0,0 +1,33 @@
+package mcis
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/rest"
+)
+
+// GetMCISRightsizing 멀티 클라우드 인프라 서비스 스펙 추천 조회
+// @Summary Get MCIS rightsizing recommendation
+// @Description 분석 기간 내 VM 별 CPU 백분위 사용률, 최대 메모리 사용률과 Tumblebug VM 스펙을 결합해 과다/부족 할당 VM, 추천 스펙, 월 예상 절감액 조회
+// @Description Authorization 헤더는 Tumblebug 스펙 조회 시 사용
+// @Tags [Monitoring] Monitoring management
+// @Accept  json
+// @Produce  json
+// @Param ns_id path string true "네임스페이스 아이디"
+// @Param mcis_id path string true "MCIS 아이디"
+// @Param window query string false "사용률 분석 기간 (예: 7d, 24h, 기본값 설정 파일 rightsizing.window)"
+// @Success 200 {object} mcis.MCISRightsizing
+// @Failure 400 {object} rest.SimpleMsg
+// @Failure 404 {object} rest.SimpleMsg
+// @Failure 500 {object} rest.SimpleMsg
+// @Router /ns/{ns_id}/mcis/{mcis_id}/rightsizing [get]
+func GetMCISRightsizing(c echo.Context) error {
+	result, errCode, err := mcis.GetMCISRightsizing(c.Param("ns_id"), c.Param("mcis_id"), c.Request().Header.Get("Authorization"), c.QueryParam("window"))
+	if errCode != http.StatusOK {
+		return c.JSON(errCode, rest.SetMessage(err.Error()))
+	}
+	return c.JSON(http.StatusOK, result)
+}
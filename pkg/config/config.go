@@ -16,15 +16,34 @@ type Config struct {
 	Agent
 	Dragonfly
 	Monitoring
+	Credential
+	Ingestion
+	Rightsizing
+	Tumblebug
+	Reconcile
+	LatencyMatrix     `mapstructure:"latency_matrix"`
+	ProcessCollection `mapstructure:"process_collection"`
+	PacketFlow        `mapstructure:"packet_flow"`
 }
 
 type InfluxDB struct {
-	EndpointUrl             string `json:"endpoint_url" mapstructure:"endpoint_url"`
-	HelmPort                int    `json:"helm_port" mapstructure:"helm_port"`
-	Database                string
-	UserName                string `json:"user_name" mapstructure:"user_name"`
-	Password                string
-	RetentionPolicyDuration string `json:"rpDuration" mapstructure:"rpDuration"`
+	EndpointUrl                      string `json:"endpoint_url" mapstructure:"endpoint_url"`
+	HelmPort                         int    `json:"helm_port" mapstructure:"helm_port"`
+	Database                         string
+	UserName                         string `json:"user_name" mapstructure:"user_name"`
+	Password                         string
+	RetentionPolicyDuration          string `json:"rpDuration" mapstructure:"rpDuration"`
+	RawRetentionPolicyDuration       string `json:"raw_rpDuration" mapstructure:"raw_rpDuration"`             // raw 모드 원본 샘플 보관 기간
+	BenchmarkRetentionPolicyDuration string `json:"benchmark_rpDuration" mapstructure:"benchmark_rpDuration"` // 벤치마크 결과 보관 기간
+	FlowRetentionPolicyDuration      string `json:"flow_rpDuration" mapstructure:"flow_rpDuration"`           // 패킷 흐름 요약 보관 기간
+	ProcessRetentionPolicyDuration   string `json:"process_rpDuration" mapstructure:"process_rpDuration"`     // 상위 프로세스 사용량 보관 기간
+
+	WriteBufferSize    int    `json:"write_buffer_size" mapstructure:"write_buffer_size"`       // 비동기 쓰기 버퍼 크기 (point 수)
+	WriteBatchSize     int    `json:"write_batch_size" mapstructure:"write_batch_size"`         // 배치 쓰기 크기 (point 수)
+	WriteFlushInterval int    `json:"write_flush_interval" mapstructure:"write_flush_interval"` // 배치 쓰기 주기 (ms)
+	WriteMaxRetry      int    `json:"write_max_retry" mapstructure:"write_max_retry"`           // 쓰기 실패 시 재시도 횟수
+	SpillPath          string `json:"spill_path" mapstructure:"spill_path"`                     // 쓰기 실패 데이터 디스크 저장 경로 (기본: $CBMON_ROOT/spill)
+	SpillMaxSize       int    `json:"spill_max_size" mapstructure:"spill_max_size"`             // 디스크 저장 최대 크기 (MB)
 }
 
 type Kapacitor struct {
@@ -35,6 +54,20 @@ type Kapacitor struct {
 type Kafka struct {
 	EndpointUrl string `json:"endpoint_url" mapstructure:"endpoint_url"`
 	HelmPort    int    `json:"helm_port" mapstructure:"helm_port"`
+
+	SecurityProtocol       string `json:"security_protocol" mapstructure:"security_protocol"`               // kafka 접속 보안 프로토콜 (PLAINTEXT, SASL_PLAINTEXT, SASL_SSL, SSL)
+	SaslMechanism          string `json:"sasl_mechanism" mapstructure:"sasl_mechanism"`                     // SASL 인증 방식 (SCRAM-SHA-256, SCRAM-SHA-512)
+	SaslUsername           string `json:"sasl_username" mapstructure:"sasl_username"`                       // 콜렉터 kafka 계정 (super user)
+	SaslPassword           string `json:"sasl_password" mapstructure:"sasl_password"`                       // 콜렉터 kafka 계정 비밀번호
+	SslCaLocation          string `json:"ssl_ca_location" mapstructure:"ssl_ca_location"`                   // kafka 브로커 CA 인증서 경로 (에이전트에 함께 배포)
+	SslCertificateLocation string `json:"ssl_certificate_location" mapstructure:"ssl_certificate_location"` // 콜렉터 클라이언트 인증서 경로 (SSL)
+	SslKeyLocation         string `json:"ssl_key_location" mapstructure:"ssl_key_location"`                 // 콜렉터 클라이언트 키 경로 (SSL)
+	SslKeyPassword         string `json:"ssl_key_password" mapstructure:"ssl_key_password"`                 // 콜렉터 클라이언트 키 비밀번호 (SSL)
+	AgentCaCertLocation    string `json:"agent_ca_cert_location" mapstructure:"agent_ca_cert_location"`     // 에이전트 클라이언트 인증서 발급 CA 인증서 경로 (SSL)
+	AgentCaKeyLocation     string `json:"agent_ca_key_location" mapstructure:"agent_ca_key_location"`       // 에이전트 클라이언트 인증서 발급 CA 키 경로 (SSL)
+	AclEnabled             bool   `json:"acl_enabled" mapstructure:"acl_enabled"`                           // 에이전트 토픽 ACL 등록 여부
+	ZookeeperConnect       string `json:"zookeeper_connect" mapstructure:"zookeeper_connect"`               // SCRAM 계정, ACL 등록 시 사용하는 zookeeper 주소
+	AdminBinPath           string `json:"admin_bin_path" mapstructure:"admin_bin_path"`                     // kafka-configs.sh, kafka-acls.sh 경로
 }
 
 type Dragonfly struct {
@@ -42,6 +75,10 @@ type Dragonfly struct {
 	Port          int    `json:"port" mapstructure:"port"`
 	HelmPort      int    `json:"helm_port" mapstructure:"helm_port"`
 	HelmNamespace string `json:"helm_namespace" mapstructure:"helm_namespace"`
+
+	LeaderElection      bool `json:"leader_election" mapstructure:"leader_election"`             // 리더 선출(HA) 활성화 여부
+	LeaderLeaseDuration int  `json:"leader_lease_duration" mapstructure:"leader_lease_duration"` // 리더 lease 유지 시간 (s)
+	ShutdownTimeout     int  `json:"shutdown_timeout" mapstructure:"shutdown_timeout"`           // 종료 시 처리 중인 작업 완료 대기 시간 (s)
 }
 
 type Agent struct {
@@ -51,18 +88,112 @@ type Agent struct {
 	Timeout        time.Duration `json:"timeout" mapstructure:"timeout"`
 }
 
+type Credential struct {
+	MasterKey    string `json:"master_key" mapstructure:"master_key"`       // cb-store 자격증명 암호화 마스터 키 (CBMON_CREDENTIAL_MASTER_KEY 환경변수 우선 적용)
+	VaultAddr    string `json:"vault_addr" mapstructure:"vault_addr"`       // Vault 서버 주소
+	VaultToken   string `json:"vault_token" mapstructure:"vault_token"`     // Vault 접근 토큰
+	VaultMount   string `json:"vault_mount" mapstructure:"vault_mount"`     // Vault KV v2 시크릿 엔진 경로
+	K8sNamespace string `json:"k8s_namespace" mapstructure:"k8s_namespace"` // Kubernetes Secret 기본 네임스페이스
+}
+
+type Ingestion struct {
+	Transport               string `json:"transport" mapstructure:"transport"`           // 에이전트 메트릭 전송 방식 기본 값 (kafka, http, nats)
+	BufferSize              int    `json:"buffer_size" mapstructure:"buffer_size"`       // NATS 수신 샘플 버퍼, HTTP 수신 kafka 전달 큐 크기
+	MaxBodySize             int    `json:"max_body_size" mapstructure:"max_body_size"`   // HTTP 수신 요청 최대 크기 (KB)
+	HTTPUrl                 string `json:"http_url" mapstructure:"http_url"`             // 에이전트 HTTP 수신 주소 (미설정 시 http://cb-dragonfly:{port}/dragonfly/ingest)
+	NatsEnabled             bool   `json:"nats_enabled" mapstructure:"nats_enabled"`     // NATS JetStream 수신 모듈 사용 여부
+	NatsUrl                 string `json:"nats_url" mapstructure:"nats_url"`             // NATS 서버 주소 (콜렉터 접속)
+	NatsAgentUrl            string `json:"nats_agent_url" mapstructure:"nats_agent_url"` // NATS 서버 주소 (에이전트 접속)
+	NatsUsername            string `json:"nats_username" mapstructure:"nats_username"`   // NATS 계정 (콜렉터)
+	NatsPassword            string `json:"nats_password" mapstructure:"nats_password"`
+	NatsCredentialsLocation string `json:"nats_credentials_location" mapstructure:"nats_credentials_location"` // NATS 사용자 자격증명(.creds) 파일 경로 (콜렉터, JWT 인증 사용 시)
+	NatsTlsCaLocation       string `json:"nats_tls_ca_location" mapstructure:"nats_tls_ca_location"`           // NATS 서버 CA 인증서 경로 (TLS 사용 시, 에이전트에도 복사)
+	NatsTlsCertLocation     string `json:"nats_tls_cert_location" mapstructure:"nats_tls_cert_location"`       // NATS 클라이언트 인증서 경로 (콜렉터, mTLS 사용 시)
+	NatsTlsKeyLocation      string `json:"nats_tls_key_location" mapstructure:"nats_tls_key_location"`
+	NatsAccountSeedLocation string `json:"nats_account_seed_location" mapstructure:"nats_account_seed_location"` // 에이전트 자격증명 서명용 NATS 계정 nkey seed 경로 (에이전트 별 subject publish 권한 발급)
+	NatsStream              string `json:"nats_stream" mapstructure:"nats_stream"`                               // JetStream 스트림 이름
+	NatsSubject             string `json:"nats_subject" mapstructure:"nats_subject"`                             // 에이전트 메트릭 subject prefix ({prefix}.{에이전트 UUID})
+	NatsConsumer            string `json:"nats_consumer" mapstructure:"nats_consumer"`                           // JetStream durable 컨슈머 이름
+}
+
+type Tumblebug struct {
+	EndpointUrl string `json:"endpoint_url" mapstructure:"endpoint_url"` // Tumblebug REST API 주소 (예: http://localhost:1323/tumblebug)
+	Username    string `json:"username" mapstructure:"username"`         // Tumblebug API basic 인증 계정
+	Password    string `json:"password" mapstructure:"password"`
+	Timeout     int    `json:"timeout" mapstructure:"timeout"`     // 요청 제한 시간 (s)
+	MaxRetry    int    `json:"max_retry" mapstructure:"max_retry"` // 접속 실패, 5xx 응답 시 재시도 횟수
+	CacheTTL    int    `json:"cache_ttl" mapstructure:"cache_ttl"` // 조회 결과 캐시 유지 시간 (s), 0일 경우 캐시 미사용
+}
+
+type Reconcile struct {
+	Enabled           bool          `json:"enabled" mapstructure:"enabled"`                         // Tumblebug 인벤토리 기반 에이전트 정합성 점검 사용 여부
+	Interval          int           `json:"interval" mapstructure:"interval"`                       // 점검 주기 (s)
+	OrphanPolicy      string        `json:"orphan_policy" mapstructure:"orphan_policy"`             // 삭제된 VM, 클러스터의 에이전트 처리 방식 (mark, delete)
+	OrphanGracePeriod int           `json:"orphan_grace_period" mapstructure:"orphan_grace_period"` // delete 정책 사용 시 orphaned 표시 후 메타데이터 삭제까지 유예 시간 (s)
+	MaxOrphanRatio    float64       `json:"max_orphan_ratio" mapstructure:"max_orphan_ratio"`       // 한 번의 점검에서 새로 삭제 확인된 에이전트 비율 상한 (초과 시 삭제 에이전트 처리 생략)
+	AutoInstall       []AutoInstall `json:"auto_install" mapstructure:"auto_install"`               // 신규 VM 에이전트 자동 설치 대상 네임스페이스
+}
+
+type AutoInstall struct {
+	NsId         string `json:"ns_id" mapstructure:"ns_id"`
+	CredentialId string `json:"credential_id" mapstructure:"credential_id"` // VM 접속 SSH 자격증명 아이디
+	AgentType    string `json:"agent_type" mapstructure:"agent_type"`       // 수집 방식 (미설정 시 모니터링 기본 정책)
+	Profile      string `json:"profile" mapstructure:"profile"`             // 수집 프로파일 (미설정 시 기본 프로파일)
+}
+
+type LatencyMatrix struct {
+	Enabled    bool     `json:"enabled" mapstructure:"enabled"`       // MCIS VM 간 지연 시간 매트릭스 주기 측정 사용 여부
+	Interval   int      `json:"interval" mapstructure:"interval"`     // 측정 주기 (s)
+	Namespaces []string `json:"namespaces" mapstructure:"namespaces"` // 측정 대상 네임스페이스 (미설정 시 전체 네임스페이스)
+}
+
+type ProcessCollection struct {
+	Enabled        bool `json:"enabled" mapstructure:"enabled"`                 // VM 상위 프로세스 사용량 주기 수집 사용 여부
+	Interval       int  `json:"interval" mapstructure:"interval"`               // 수집 주기 (s)
+	TopN           int  `json:"top_n" mapstructure:"top_n"`                     // VM 별 CPU, 메모리 사용량 상위 프로세스 수집 개수
+	MaxConcurrency int  `json:"max_concurrency" mapstructure:"max_concurrency"` // 동시 수집 요청 에이전트 수
+}
+
+type PacketFlow struct {
+	Enabled        bool `json:"enabled" mapstructure:"enabled"`                 // MCIS VM 간 패킷 흐름 주기 캡처 사용 여부
+	Interval       int  `json:"interval" mapstructure:"interval"`               // 캡처 주기 (s)
+	WatchTime      int  `json:"watch_time" mapstructure:"watch_time"`           // 1회 캡처 시간 (s)
+	MaxConcurrency int  `json:"max_concurrency" mapstructure:"max_concurrency"` // 동시 캡처 요청 VM 쌍 수
+}
+
+type Rightsizing struct {
+	Window           string  `json:"window" mapstructure:"window"`                         // 사용률 분석 기간 (InfluxQL duration, 예: 7d)
+	CpuPercentile    int     `json:"cpu_percentile" mapstructure:"cpu_percentile"`         // CPU 사용률 분석 백분위 (p95)
+	CpuHighThreshold float64 `json:"cpu_high_threshold" mapstructure:"cpu_high_threshold"` // 이상일 경우 CPU 부족 (under-provisioned) 판단 (%)
+	CpuLowThreshold  float64 `json:"cpu_low_threshold" mapstructure:"cpu_low_threshold"`   // 미만일 경우 CPU 과다 (over-provisioned) 판단 (%)
+	MemHighThreshold float64 `json:"mem_high_threshold" mapstructure:"mem_high_threshold"` // 최대 메모리 사용률 기준 부족 판단 (%)
+	MemLowThreshold  float64 `json:"mem_low_threshold" mapstructure:"mem_low_threshold"`   // 최대 메모리 사용률 기준 과다 판단 (%)
+	TargetCpu        float64 `json:"target_cpu" mapstructure:"target_cpu"`                 // 추천 스펙 산정 시 목표 CPU 사용률 (%)
+	TargetMem        float64 `json:"target_mem" mapstructure:"target_mem"`                 // 추천 스펙 산정 시 목표 메모리 사용률 (%)
+	HoursPerMonth    float64 `json:"hours_per_month" mapstructure:"hours_per_month"`       // 월 비용 환산 시간
+}
+
 type Monitoring struct {
-	MCISAgentInterval       int    `json:"mcis_agent_interval" mapstructure:"mcis_agent_interval"`           // 모니터링 에이전트 수집주기
-	MCK8SAgentInterval      int    `json:"mck8s_agent_interval" mapstructure:"mck8s_agent_interval"`         // 모니터링 에이전트 수집주기
-	MCISCollectorInterval   int    `json:"mcis_collector_interval" mapstructure:"mcis_collector_interval"`   // MCIS 모니터링 콜렉터 Aggregate 주기
-	MCK8SCollectorInterval  int    `json:"mck8s_collector_interval" mapstructure:"mck8s_collector_interval"` // MCK8S 모니터링 콜렉터 Aggregate 주기
-	MonitoringPolicy        string `json:"monitoring_policy" mapstructure:"monitoring_policy"`               // 모니터링 콜렉터 정책
-	MaxHostCount            int    `json:"max_host_count" mapstructure:"max_host_count"`                     // 모니터링 콜렉터 수
-	DefaultPolicy           string `json:"default_policy" mapstructure:"default_policy"`                     // 모니터링 기본 정책
-	PullerInterval          int    `json:"puller_interval" mapstructure:"puller_interval"`                   // 모니터링 puller 실행 주기
-	PullerAggregateInterval int    `json:"puller_aggregate_interval" mapstructure:"puller_aggregate_interval"`
-	AggregateType           string `json:"aggregate_type" mapstructure:"aggregate_type"`
-	DeployType              string `json:"deploy_type" mapstructure:"deploy_type"`
+	MCISAgentInterval             int    `json:"mcis_agent_interval" mapstructure:"mcis_agent_interval"`           // 모니터링 에이전트 수집주기
+	MCK8SAgentInterval            int    `json:"mck8s_agent_interval" mapstructure:"mck8s_agent_interval"`         // 모니터링 에이전트 수집주기
+	MCISCollectorInterval         int    `json:"mcis_collector_interval" mapstructure:"mcis_collector_interval"`   // MCIS 모니터링 콜렉터 Aggregate 주기
+	MCK8SCollectorInterval        int    `json:"mck8s_collector_interval" mapstructure:"mck8s_collector_interval"` // MCK8S 모니터링 콜렉터 Aggregate 주기
+	MonitoringPolicy              string `json:"monitoring_policy" mapstructure:"monitoring_policy"`               // 모니터링 콜렉터 정책
+	MaxHostCount                  int    `json:"max_host_count" mapstructure:"max_host_count"`                     // 모니터링 콜렉터 수
+	DefaultPolicy                 string `json:"default_policy" mapstructure:"default_policy"`                     // 모니터링 기본 정책
+	MixedPolicy                   bool   `json:"mixed_policy" mapstructure:"mixed_policy"`                         // 에이전트 별 수집 방식 혼용 여부 (PUSH, PULL 모듈 모두 구동)
+	PullerInterval                int    `json:"puller_interval" mapstructure:"puller_interval"`                   // 모니터링 puller 실행 주기
+	PullerAggregateInterval       int    `json:"puller_aggregate_interval" mapstructure:"puller_aggregate_interval"`
+	PullerWorkerCount             int    `json:"puller_worker_count" mapstructure:"puller_worker_count"` // 동시 PULL 요청 에이전트 수
+	AggregateType                 string `json:"aggregate_type" mapstructure:"aggregate_type"`
+	DeployType                    string `json:"deploy_type" mapstructure:"deploy_type"`
+	HeartbeatCheckInterval        int    `json:"heartbeat_check_interval" mapstructure:"heartbeat_check_interval"`               // 에이전트 하트비트 상태 점검 주기 (s)
+	HeartbeatDegradedThreshold    int    `json:"heartbeat_degraded_threshold" mapstructure:"heartbeat_degraded_threshold"`       // 마지막 수신 이후 degraded 판단 기준 시간 (s)
+	HeartbeatUnreachableThreshold int    `json:"heartbeat_unreachable_threshold" mapstructure:"heartbeat_unreachable_threshold"` // 마지막 수신 이후 unreachable 판단 기준 시간 (s)
+	CollectorMode                 string `json:"collector_mode" mapstructure:"collector_mode"`                                   // MCIS 콜렉터 동작 방식 (scheduler, consumer_group)
+	CollectorCount                int    `json:"collector_count" mapstructure:"collector_count"`                                 // 컨슈머 그룹 방식 콜렉터 수 (helm 배포 시 replicas)
+	CollectorTopicMode            string `json:"collector_topic_mode" mapstructure:"collector_topic_mode"`                       // 컨슈머 그룹 방식 토픽 구성 (agent, shared)
+	AllowedLateness               int    `json:"allowed_lateness" mapstructure:"allowed_lateness"`                               // MCIS 집계 윈도우 지연 데이터 허용 시간 (s)
 }
 
 var once sync.Once
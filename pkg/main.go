@@ -1,9 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"runtime"
-	"sync"
 	"time"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/api"
@@ -14,7 +15,10 @@ import (
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/alert/template"
 	grpc "github.com/cloud-barista/cb-dragonfly/pkg/api/grpc/server"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/lifecycle"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/leader"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/ingest"
 	//_ "github.com/swaggo/gin-swagger/example/basic/docs" // docs is generated by Swag CLI, you have to import it.
 )
 
@@ -49,9 +53,12 @@ func main() {
 		panic(err)
 	}
 
+	// 프로세스 생명주기 관리자 (종료 시그널 수신 시 모듈 context 취소 및 드레인)
+	lm := lifecycle.GetInstance()
+	wg := lm.WaitGroup()
+
 	// Push, Pull 메커니즘 기반 모니터링 모듈 실행
-	var wg sync.WaitGroup
-	if err := monitoring.NewMechanism(&wg); err != nil {
+	if err := monitoring.NewMechanism(lm.Context(), wg); err != nil {
 		panic(err)
 	}
 
@@ -62,12 +69,28 @@ func main() {
 		util.GetLogger().Error(fmt.Sprintf("failed to initialize api server, error=%s", err))
 		panic(err)
 	}
-	go apiServer.StartAPIServer(&wg)
+	go apiServer.StartAPIServer(wg)
+	lm.OnShutdown("api server", apiServer.Shutdown)
 
 	// 모니터링 gRPC 서버 실행
 	wg.Add(1)
-	go grpc.StartGRPCServer()
-
-	// 모든 고루틴이 종료될 때까지 대기
-	wg.Wait()
+	go grpc.StartGRPCServer(wg)
+	lm.OnShutdown("grpc server", grpc.StopGRPCServer)
+
+	// 모듈 드레인 이후 리더 lease 반납, 메트릭 쓰기 버퍼 flush, InfluxDB 클라이언트 종료
+	lm.OnDrained("leader election", leader.Release)
+	lm.OnDrained("ingest forwarder", ingest.FlushForwarder)
+	lm.OnDrained("ingest nats publisher", ingest.ClosePublisher)
+	lm.OnDrained("influxdb write pipeline", v1.GetWritePipeline().Flush)
+	lm.OnDrained("influxdb client", func(ctx context.Context) error {
+		return v1.GetInstance().Client.Close()
+	})
+
+	// 종료 시그널 수신 시 모든 모듈이 처리 중인 작업을 마칠 때까지 대기 후 종료
+	lm.WaitForSignal()
+
+	// 리더 자격 상실 등 비정상 종료 시 재시작되도록 종료 코드 1 반환
+	if lm.AbortErr() != nil {
+		os.Exit(1)
+	}
 }
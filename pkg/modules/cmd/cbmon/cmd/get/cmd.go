@@ -14,5 +14,6 @@ func NewCmd() *cobra.Command {
 	cmd.AddCommand(newGetMetricCmd())
 	cmd.AddCommand(newGetOnDemandMetricCmd())
 	cmd.AddCommand(newGetMCISMetricCmd())
+	cmd.AddCommand(newGetMCISServiceCmd())
 	return cmd
 }
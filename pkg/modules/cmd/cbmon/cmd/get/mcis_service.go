@@ -0,0 +1,54 @@
+package get
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/cloud-barista/cb-dragonfly/pkg/api/grpc/protobuf/cbdragonfly"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/grpc/request"
+)
+
+func newGetMCISServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcis-service",
+		Short: "Get MCIS service monitoring information",
+		Long:  `Get agent status, latest metric and health of every VM in MCIS with MCIS-wide totals`,
+		RunE:  getMCISServiceRun,
+	}
+	cmd.Flags().StringP("ns-id", "", "", "")
+	cmd.Flags().StringP("mcis-id", "", "", "")
+	cmd.Flags().StringP("auth", "", "", "tumblebug authorization header for vm spec")
+	cmd.Flags().BoolP("realtime", "", false, "get metric from agents instead of metric store")
+	cmd.Flags().Int32P("timeout", "", 0, "realtime agent request timeout (s)")
+	return cmd
+}
+
+func getMCISServiceRun(cmd *cobra.Command, args []string) error {
+	nsId, _ := cmd.Flags().GetString("ns-id")
+	mcisId, _ := cmd.Flags().GetString("mcis-id")
+	auth, _ := cmd.Flags().GetString("auth")
+	realtime, _ := cmd.Flags().GetBool("realtime")
+	timeout, _ := cmd.Flags().GetInt32("timeout")
+
+	reqParams := pb.MCISServiceMonQryRequest{
+		NsId:          nsId,
+		McisId:        mcisId,
+		Authorization: auth,
+		Timeout:       timeout,
+	}
+
+	monApi := request.GetMonitoringAPI()
+	var result string
+	var err error
+	if realtime {
+		result, err = monApi.GetMCISServiceRealtimeMonInfo(reqParams)
+	} else {
+		result, err = monApi.GetMCISServiceMonInfo(reqParams)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	return nil
+}
@@ -0,0 +1,160 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const defaultShutdownTimeout = 30
+
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Manager 프로세스 생명주기 관리
+//   - 모듈 고루틴(API 서버, gRPC 서버, 스케줄러, 콜렉터, puller)에 공통 context 를 전달하고, WaitGroup 으로 종료를 추적합니다.
+//   - 종료 시그널(SIGINT, SIGTERM) 수신 시 아래 순서로 종료합니다.
+//     1. context 취소 => 스케줄러, 콜렉터, puller 는 처리 중인 작업을 마친 후 종료
+//     2. shutdown 훅 실행 => API, gRPC 서버 신규 요청 차단 및 처리 중인 요청 완료
+//     3. 모듈 고루틴 종료 대기 (shutdown_timeout)
+//     4. drained 훅 실행 => 토픽 맵 저장, 메트릭 쓰기 flush, 리더 lease 반납 등
+type Manager struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	waitGroup    *sync.WaitGroup
+	mutex        sync.Mutex
+	shutdownOnce sync.Once
+	abortErr     error
+	stopHooks    []hook
+	drainHooks   []hook
+}
+
+var once sync.Once
+var manager *Manager
+
+func GetInstance() *Manager {
+	once.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		manager = &Manager{
+			ctx:       ctx,
+			cancel:    cancel,
+			waitGroup: &sync.WaitGroup{},
+		}
+	})
+	return manager
+}
+
+// Context 모듈 공통 context (종료 시 취소)
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// WaitGroup 모듈 고루틴 종료 추적용 WaitGroup
+func (m *Manager) WaitGroup() *sync.WaitGroup {
+	return m.waitGroup
+}
+
+// Abort 비정상 종료 요청 (context 취소 후 WaitForSignal 에서 종료 처리, 리더 자격 상실 등)
+func (m *Manager) Abort(err error) {
+	m.mutex.Lock()
+	if m.abortErr == nil {
+		m.abortErr = err
+	}
+	m.mutex.Unlock()
+	m.cancel()
+}
+
+// AbortErr 비정상 종료 사유 (정상 종료 시 nil)
+func (m *Manager) AbortErr() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.abortErr
+}
+
+// OnShutdown 종료 시작 시 실행할 훅 등록 (API, gRPC 서버 종료)
+func (m *Manager) OnShutdown(name string, fn func(ctx context.Context) error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.stopHooks = append(m.stopHooks, hook{name: name, fn: fn})
+}
+
+// OnDrained 모듈 고루틴 종료 이후 실행할 훅 등록 (토픽 맵 저장, 메트릭 쓰기 flush 등)
+func (m *Manager) OnDrained(name string, fn func(ctx context.Context) error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.drainHooks = append(m.drainHooks, hook{name: name, fn: fn})
+}
+
+// WaitForSignal 종료 시그널 수신 대기 후 종료 처리
+func (m *Manager) WaitForSignal() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	select {
+	case sig := <-sigCh:
+		fmt.Printf("[%s] <Lifecycle> received signal %s, shutting down\n", time.Now().Format(time.RFC3339), sig)
+	case <-m.ctx.Done():
+		if err := m.AbortErr(); err != nil {
+			fmt.Printf("[%s] <Lifecycle> aborted, shutting down, reason=%s\n", time.Now().Format(time.RFC3339), err)
+		}
+	}
+	m.Shutdown()
+}
+
+// Shutdown 모듈 종료 처리 (shutdown_timeout 이내 처리 중인 작업 완료 대기)
+func (m *Manager) Shutdown() {
+	m.shutdownOnce.Do(func() {
+		shutdownTimeout := config.GetInstance().Dragonfly.ShutdownTimeout
+		if shutdownTimeout <= 0 {
+			shutdownTimeout = defaultShutdownTimeout
+		}
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Duration(shutdownTimeout)*time.Second)
+		defer cancel()
+
+		m.cancel()
+
+		m.mutex.Lock()
+		stopHooks := append([]hook{}, m.stopHooks...)
+		drainHooks := append([]hook{}, m.drainHooks...)
+		m.mutex.Unlock()
+
+		runHooks(timeoutCtx, stopHooks)
+
+		drained := make(chan struct{})
+		go func() {
+			m.waitGroup.Wait()
+			close(drained)
+		}()
+		select {
+		case <-drained:
+			fmt.Printf("[%s] <Lifecycle> all modules drained\n", time.Now().Format(time.RFC3339))
+		case <-timeoutCtx.Done():
+			util.GetLogger().Error(fmt.Sprintf("failed to drain modules within %ds, shutting down anyway", shutdownTimeout))
+		}
+
+		// 모듈 드레인 시간이 초과되었더라도 정리 작업은 수행
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), time.Duration(shutdownTimeout)*time.Second)
+		defer drainCancel()
+		runHooks(drainCtx, drainHooks)
+	})
+}
+
+func runHooks(ctx context.Context, hooks []hook) {
+	for _, h := range hooks {
+		if err := h.fn(ctx); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to run shutdown hook %s, error=%s", h.name, err))
+			continue
+		}
+		fmt.Printf("[%s] <Lifecycle> %s stopped\n", time.Now().Format(time.RFC3339), h.name)
+	}
+}
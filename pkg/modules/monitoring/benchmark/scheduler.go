@@ -0,0 +1,54 @@
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	checkInterval = 60
+)
+
+// Scheduler 실행 주기가 도래한 MCIS 벤치마크 캠페인 실행
+type Scheduler struct {
+	WaitGroup *sync.WaitGroup
+}
+
+func NewScheduler(wg *sync.WaitGroup) (*Scheduler, error) {
+	return &Scheduler{WaitGroup: wg}, nil
+}
+
+// StartScheduler 1분 간격으로 캠페인 실행 주기 점검 (ctx 취소 시 종료)
+//   - 구동 시, 이후 점검 주기마다 실행 인스턴스가 종료되어 running 상태로 남은 캠페인을 실패 처리합니다.
+//   - 실행한 캠페인 고루틴은 WaitGroup 으로 종료를 추적합니다.
+func (s *Scheduler) StartScheduler(ctx context.Context) {
+	defer s.WaitGroup.Done()
+	for {
+		failedList, err := mcis.FailStaleBenchmarkCampaign(time.Now())
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to check stale benchmark campaign, error=%s", err))
+		}
+		for _, campaignId := range failedList {
+			fmt.Printf("[%s] <BENCHMARK> stale campaign %s marked as failed\n", time.Now().Format(time.RFC3339), campaignId)
+		}
+
+		startedList, err := mcis.StartDueBenchmarkCampaign(ctx, s.WaitGroup, time.Now())
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to start benchmark campaign, error=%s", err))
+		}
+		for _, campaignId := range startedList {
+			fmt.Printf("[%s] <BENCHMARK> campaign %s started\n", time.Now().Format(time.RFC3339), campaignId)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(checkInterval * time.Second):
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	defaultCaptureInterval = 600
+)
+
+// Scheduler MCIS VM 간 패킷 흐름 주기 캡처
+type Scheduler struct {
+	WaitGroup *sync.WaitGroup
+}
+
+func NewScheduler(wg *sync.WaitGroup) (*Scheduler, error) {
+	return &Scheduler{WaitGroup: wg}, nil
+}
+
+// StartScheduler 캡처 주기마다 에이전트가 2개 이상 설치된 MCIS 패킷 흐름 캡처 후 저장 (ctx 취소 시 종료)
+func (s *Scheduler) StartScheduler(ctx context.Context) {
+	defer s.WaitGroup.Done()
+	for {
+		flowConfig := config.GetInstance().PacketFlow
+		interval := flowConfig.Interval
+		if interval <= 0 {
+			interval = defaultCaptureInterval
+		}
+
+		captureAll(flowConfig.WatchTime)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+	}
+}
+
+// captureAll 에이전트 부하를 줄이기 위해 MCIS 단위로 순차 캡처
+func captureAll(watchTime int) {
+	targetMap, err := mcis.ListFlowTarget()
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to get packet flow target, error=%s", err))
+		return
+	}
+	for nsId, mcisIdList := range targetMap {
+		for _, mcisId := range mcisIdList {
+			if _, _, err := mcis.CaptureMCISPacketFlow(nsId, mcisId, watchTime, true); err != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to capture packet flow, nsId=%s, mcisId=%s, error=%s", nsId, mcisId, err))
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package heartbeat
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	agentmetadata "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	defaultCheckInterval = 30
+)
+
+// LivenessChecker 에이전트 하트비트(마지막 수신 시각) 기반 생존 상태 점검
+type LivenessChecker struct {
+	WaitGroup *sync.WaitGroup
+}
+
+func NewLivenessChecker(wg *sync.WaitGroup) (*LivenessChecker, error) {
+	return &LivenessChecker{WaitGroup: wg}, nil
+}
+
+// StartLivenessCheck 점검 주기마다 에이전트 상태를 평가하고 상태 전이 이벤트 발행 (ctx 취소 시 종료)
+func (lc *LivenessChecker) StartLivenessCheck(ctx context.Context) {
+	defer lc.WaitGroup.Done()
+	for {
+		checkInterval := config.GetInstance().Monitoring.HeartbeatCheckInterval
+		if checkInterval <= 0 {
+			checkInterval = defaultCheckInterval
+		}
+
+		eventList, err := agentmetadata.CheckAgentLiveness(time.Now())
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to check agent liveness, error=%s", err))
+		}
+		if len(eventList) > 0 {
+			util.GetLogger().Info(fmt.Sprintf("agent liveness check finished, changed agents=%d", len(eventList)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(checkInterval) * time.Second):
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package latency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	defaultMeasureInterval = 600
+)
+
+// Scheduler MCIS VM 간 지연 시간 매트릭스 주기 측정
+type Scheduler struct {
+	WaitGroup *sync.WaitGroup
+}
+
+func NewScheduler(wg *sync.WaitGroup) (*Scheduler, error) {
+	return &Scheduler{WaitGroup: wg}, nil
+}
+
+// StartScheduler 측정 주기마다 대상 네임스페이스의 전체 MCIS 지연 시간 매트릭스 측정 후 저장 (ctx 취소 시 종료)
+func (s *Scheduler) StartScheduler(ctx context.Context) {
+	defer s.WaitGroup.Done()
+	for {
+		interval := config.GetInstance().LatencyMatrix.Interval
+		if interval <= 0 {
+			interval = defaultMeasureInterval
+		}
+
+		measureAll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+	}
+}
+
+// measureAll 에이전트 부하를 줄이기 위해 MCIS 단위로 순차 측정
+func measureAll() {
+	nsIdList := config.GetInstance().LatencyMatrix.Namespaces
+	if len(nsIdList) == 0 {
+		nsList, err := tumblebug.GetInstance().ListNamespace()
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to get namespace list, error=%s", err))
+			return
+		}
+		for _, ns := range nsList {
+			nsIdList = append(nsIdList, ns.Id)
+		}
+	}
+
+	for _, nsId := range nsIdList {
+		mcisList, err := tumblebug.GetInstance().ListMCIS(nsId)
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to get mcis list, nsId=%s, error=%s", nsId, err))
+			continue
+		}
+		for _, mcisInfo := range mcisList {
+			if _, _, err := mcis.GetMCISLatencyMatrix(nsId, mcisInfo.Id, "", true); err != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to measure latency matrix, nsId=%s, mcisId=%s, error=%s", nsId, mcisInfo.Id, err))
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+	"github.com/google/uuid"
+)
+
+const defaultLeaseDuration = 15
+
+var isLeader int32
+var released int32
+
+var electionCancel context.CancelFunc
+var electionDone chan struct{}
+
+var identityOnce sync.Once
+var identity string
+
+// GetIdentity 리더 선출 인스턴스 식별자 (hostname_uuid)
+func GetIdentity() string {
+	identityOnce.Do(func() {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "cb-dragonfly"
+		}
+		identity = fmt.Sprintf("%s_%s", hostname, uuid.New().String()[:8])
+	})
+	return identity
+}
+
+// IsLeader 현재 인스턴스 리더 여부 (리더 선출 비활성화 시 항상 리더)
+func IsLeader() bool {
+	return atomic.LoadInt32(&isLeader) == 1
+}
+
+func getLeaseDuration() time.Duration {
+	leaseDuration := config.GetInstance().Dragonfly.LeaderLeaseDuration
+	if leaseDuration <= 0 {
+		leaseDuration = defaultLeaseDuration
+	}
+	return time.Duration(leaseDuration) * time.Second
+}
+
+// Run 리더 선출 후 리더로 선출된 인스턴스에서만 onStartedLeading (스케줄러, 콜렉터, PULL 모듈 등) 실행
+//   - 리더 선출 비활성화 시 단일 인스턴스로 간주하여 즉시 실행합니다.
+//   - helm 배포일 경우 k8s Lease, dev/compose 배포일 경우 etcd lease 로 리더를 선출합니다.
+//   - 팔로워 인스턴스의 토픽 추가/삭제 요청은 공유 큐(cb-store)를 통해 리더 인스턴스로 전달됩니다.
+//   - 리더 전용 모듈은 구동 후 중지할 수 없으므로, 리더 자격을 잃으면 abort 로 생명주기 context 를 취소합니다.
+//     모듈 드레인, 메트릭 쓰기 flush 등 종료 처리 후 프로세스가 재시작되어 팔로워로 참여합니다.
+//   - 프로세스 종료 시 모듈 드레인 완료 후 Release 로 lease 를 반납하여 다른 인스턴스가 즉시 리더로 선출될 수 있도록 합니다.
+func Run(ctx context.Context, abort func(err error), onStartedLeading func() error) error {
+	if !config.GetInstance().Dragonfly.LeaderElection {
+		atomic.StoreInt32(&isLeader, 1)
+		return onStartedLeading()
+	}
+
+	util.SetSharedQueue(putSharedQueue)
+
+	startedLeading := func() {
+		atomic.StoreInt32(&isLeader, 1)
+		fmt.Printf("[%s] <Leader> %s started leading\n", time.Now().Format(time.RFC3339), GetIdentity())
+		go drainSharedQueue(ctx)
+		// 리더 전환 시 스케줄러는 cb-store 또는 configmap 에 저장된 토픽 맵을 기준으로 재개합니다.
+		if err := onStartedLeading(); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to start leader modules, error=%s", err))
+			abort(err)
+		}
+	}
+	stoppedLeading := func() {
+		atomic.StoreInt32(&isLeader, 0)
+		// 종료 처리 중 lease 반납에 의한 리더 자격 상실은 정상 종료
+		if atomic.LoadInt32(&released) == 1 {
+			return
+		}
+		util.GetLogger().Error(fmt.Sprintf("leader election lost, identity=%s", GetIdentity()))
+		abort(errors.New(fmt.Sprintf("leader election lost, identity=%s", GetIdentity())))
+	}
+
+	var electionCtx context.Context
+	electionCtx, electionCancel = context.WithCancel(context.Background())
+	electionDone = make(chan struct{})
+
+	if config.GetInstance().Monitoring.DeployType == types.Helm {
+		elector, err := newLeaseElector(startedLeading, stoppedLeading)
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer close(electionDone)
+			elector.run(electionCtx)
+		}()
+		return nil
+	}
+	elector, err := newStoreElector(startedLeading, stoppedLeading)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer close(electionDone)
+		elector.run(electionCtx)
+	}()
+	return nil
+}
+
+// Release 리더 선출 중지 및 lease 반납 (프로세스 종료 시 모듈 드레인 완료 후 호출)
+func Release(ctx context.Context) error {
+	if electionCancel == nil {
+		return nil
+	}
+	atomic.StoreInt32(&released, 1)
+	electionCancel()
+	select {
+	case <-electionDone:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Status 인스턴스 리더 선출 상태
+type Status struct {
+	LeaderElection bool   `json:"leader_election"`
+	Identity       string `json:"identity"`
+	Leader         bool   `json:"leader"`
+}
+
+// GetStatus 인스턴스 리더 선출 상태 조회
+func GetStatus() Status {
+	return Status{
+		LeaderElection: config.GetInstance().Dragonfly.LeaderElection,
+		Identity:       GetIdentity(),
+		Leader:         IsLeader(),
+	}
+}
@@ -0,0 +1,68 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// leaseElector k8s Lease 오브젝트 기반 리더 선출 (helm 배포)
+type leaseElector struct {
+	electionConfig leaderelection.LeaderElectionConfig
+}
+
+func newLeaseElector(onStartedLeading func(), onStoppedLeading func()) (*leaseElector, error) {
+	inClusterConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to get in-cluster config, error=%s", err))
+	}
+	clientSet, err := kubernetes.NewForConfig(inClusterConfig)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to create k8s client, error=%s", err))
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      types.LeaderLeaseName,
+			Namespace: config.GetInstance().Dragonfly.HelmNamespace,
+		},
+		Client: clientSet.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: GetIdentity(),
+		},
+	}
+
+	leaseDuration := getLeaseDuration()
+	return &leaseElector{
+		electionConfig: leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			ReleaseOnCancel: true,
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   leaseDuration * 2 / 3,
+			RetryPeriod:     leaseDuration / 5,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					onStartedLeading()
+				},
+				OnStoppedLeading: onStoppedLeading,
+				OnNewLeader: func(leaderIdentity string) {
+					fmt.Printf("[%s] <Leader> current leader is %s\n", time.Now().Format(time.RFC3339), leaderIdentity)
+				},
+			},
+		},
+	}, nil
+}
+
+// run 리더 선출 수행 (ctx 취소 시 lease 반납 - ReleaseOnCancel)
+func (le *leaseElector) run(ctx context.Context) {
+	leaderelection.RunOrDie(ctx, le.electionConfig)
+}
@@ -0,0 +1,53 @@
+package leader
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Workiva/go-datastructures/queue"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const sharedQueueDrainInterval = 2 * time.Second
+
+// putSharedQueue 토픽 추가/삭제 요청을 공유 큐(cb-store)에 등록
+// key: {queueKey}/{등록 시각(ns)}_{인스턴스 식별자}
+func putSharedQueue(queueKey string, topicBytes []byte) error {
+	return cbstore.GetInstance().StorePut(fmt.Sprintf("%s/%d_%s", queueKey, time.Now().UnixNano(), GetIdentity()), string(topicBytes))
+}
+
+// drainSharedQueue 리더 인스턴스가 공유 큐의 토픽 추가/삭제 요청을 스케줄러 in-memory 큐로 이동
+func drainSharedQueue(ctx context.Context) {
+	for {
+		moveSharedQueue(types.MCISTopicQueue, util.GetRingQueue())
+		moveSharedQueue(types.MCK8STopicQueue, util.GetMCK8SRingQueue())
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sharedQueueDrainInterval):
+		}
+	}
+}
+
+func moveSharedQueue(queueKey string, ringQueue *queue.Queue) {
+	keyValList, err := cbstore.GetInstance().Store.GetList(queueKey, true)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to get shared topic queue, error=%s", err))
+		return
+	}
+	for _, keyVal := range keyValList {
+		if keyVal == nil || len(keyVal.Key) == 0 {
+			continue
+		}
+		if err = ringQueue.Put([]byte(keyVal.Value)); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to put topic to ring queue, error=%s", err))
+			return
+		}
+		if err = cbstore.GetInstance().StoreDelete(keyVal.Key); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to delete shared topic queue, error=%s", err))
+		}
+	}
+}
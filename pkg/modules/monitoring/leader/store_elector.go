@@ -0,0 +1,171 @@
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+	cbstoreconfig "github.com/cloud-barista/cb-store/config"
+	"github.com/etcd-io/etcd/clientv3"
+)
+
+// etcd 요청 제한 시간
+const etcdRequestTimeout = 5 * time.Second
+
+// LeaseRecord 리더 lease 레코드
+type LeaseRecord struct {
+	HolderIdentity string    `json:"holder_identity"`
+	RenewTime      time.Time `json:"renew_time"`
+	LeaseDuration  int       `json:"lease_duration"`
+}
+
+// storeElector etcd lease 기반 리더 선출 (dev, compose 배포)
+//   - 리더 키는 etcd lease 에 연결되어, 리더 인스턴스가 갱신하지 못하면 lease 만료 시 삭제됩니다.
+//   - 획득은 리더 키가 없을 경우(create revision = 0)에만 기록하는 트랜잭션으로 수행합니다.
+//   - 갱신, 반납은 마지막으로 기록한 mod revision 과 일치할 경우에만 수행하여 다른 인스턴스의 레코드를 덮어쓰지 않습니다.
+//   - 여러 인스턴스가 동일한 저장소를 공유해야 하므로 cb-store ETCD 스토어 타입에서 사용합니다.
+type storeElector struct {
+	client           *clientv3.Client
+	leaseDuration    time.Duration
+	renewDeadline    time.Duration
+	retryPeriod      time.Duration
+	onStartedLeading func()
+	onStoppedLeading func()
+
+	leaseId     clientv3.LeaseID
+	modRevision int64
+}
+
+func newStoreElector(onStartedLeading func(), onStoppedLeading func()) (*storeElector, error) {
+	storeConfig := cbstoreconfig.GetConfigInfos()
+	if !strings.EqualFold(storeConfig.STORETYPE, "ETCD") {
+		return nil, errors.New(fmt.Sprintf("leader election requires ETCD store type, store type=%s", storeConfig.STORETYPE))
+	}
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(storeConfig.ETCD.ETCDSERVERPORT, ","),
+		DialTimeout: etcdRequestTimeout,
+	})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to create etcd client, error=%s", err))
+	}
+
+	leaseDuration := getLeaseDuration()
+	return &storeElector{
+		client:           client,
+		leaseDuration:    leaseDuration,
+		renewDeadline:    leaseDuration * 2 / 3,
+		retryPeriod:      leaseDuration / 5,
+		onStartedLeading: onStartedLeading,
+		onStoppedLeading: onStoppedLeading,
+	}, nil
+}
+
+// run 리더 선출 수행 (ctx 취소 시 lease 반납)
+func (se *storeElector) run(ctx context.Context) {
+	defer se.client.Close()
+	leading := false
+	var lastRenewTime time.Time
+	for {
+		if ctx.Err() != nil {
+			if leading {
+				se.release()
+			}
+			return
+		}
+		acquired, err := se.tryAcquireOrRenew(leading)
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to acquire or renew leader lease, error=%s", err))
+		}
+		switch {
+		case acquired:
+			lastRenewTime = time.Now()
+			if !leading {
+				leading = true
+				go se.onStartedLeading()
+			}
+		case leading && (err == nil || time.Since(lastRenewTime) > se.renewDeadline):
+			// 다른 인스턴스가 리더로 선출되었거나, 갱신 기한 내에 lease 를 갱신하지 못한 경우
+			se.onStoppedLeading()
+			return
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(se.retryPeriod):
+		}
+	}
+}
+
+// release 현재 인스턴스가 보유한 리더 키 삭제 및 lease 반납
+func (se *storeElector) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+	_, err := se.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(types.Leader), "=", se.modRevision)).
+		Then(clientv3.OpDelete(types.Leader)).
+		Commit()
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to release leader lease, error=%s", err))
+	}
+	if _, err = se.client.Revoke(ctx, se.leaseId); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to revoke leader lease, error=%s", err))
+	}
+}
+
+// tryAcquireOrRenew 리더 lease 획득 (리더 키가 없을 경우) 또는 갱신 (리더인 경우)
+//   - 다른 인스턴스가 리더 키를 보유하고 있으면 (false, nil) 을 반환합니다.
+func (se *storeElector) tryAcquireOrRenew(leading bool) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	recordBytes, err := json.Marshal(LeaseRecord{
+		HolderIdentity: GetIdentity(),
+		RenewTime:      time.Now(),
+		LeaseDuration:  int(se.leaseDuration.Seconds()),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if leading {
+		// lease 만료 시 리더 키가 삭제되므로, 갱신 실패는 리더 자격 상실로 처리
+		if _, err = se.client.KeepAliveOnce(ctx, se.leaseId); err != nil {
+			return false, errors.New(fmt.Sprintf("failed to keep alive leader lease, error=%s", err))
+		}
+		resp, err := se.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(types.Leader), "=", se.modRevision)).
+			Then(clientv3.OpPut(types.Leader, string(recordBytes), clientv3.WithLease(se.leaseId))).
+			Commit()
+		if err != nil {
+			return false, errors.New(fmt.Sprintf("failed to renew leader lease, error=%s", err))
+		}
+		if !resp.Succeeded {
+			return false, nil
+		}
+		se.modRevision = resp.Header.Revision
+		return true, nil
+	}
+
+	leaseResp, err := se.client.Grant(ctx, int64(se.leaseDuration.Seconds()))
+	if err != nil {
+		return false, errors.New(fmt.Sprintf("failed to grant leader lease, error=%s", err))
+	}
+	resp, err := se.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(types.Leader), "=", 0)).
+		Then(clientv3.OpPut(types.Leader, string(recordBytes), clientv3.WithLease(leaseResp.ID))).
+		Commit()
+	if err != nil || !resp.Succeeded {
+		_, _ = se.client.Revoke(ctx, leaseResp.ID)
+		if err != nil {
+			return false, errors.New(fmt.Sprintf("failed to acquire leader lease, error=%s", err))
+		}
+		return false, nil
+	}
+	se.leaseId = leaseResp.ID
+	se.modRevision = resp.Header.Revision
+	return true, nil
+}
@@ -1,15 +1,28 @@
 package monitoring
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
+	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/lifecycle"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/benchmark"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/flow"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/heartbeat"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/latency"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/leader"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/process"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/pull"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/pull/puller"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/ingest"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	push_mcis "github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis"
 	push_mck8s "github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mck8s"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/reconcile"
 	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
@@ -30,17 +43,27 @@ func SetConfigurationToMemoryDB() {
 	}
 }
 
-func startMCISPushModule(wg *sync.WaitGroup) error {
+func startMCISPushModule(ctx context.Context, wg *sync.WaitGroup) error {
 
 	// 콜렉터 매니저를 생성합니다.
 	// 콜렉터 매니저는 collector 생성, 삭제 기능을 제공합니다.
 	// 배포방식이 helm 일 경우, k8s와의 conn 및 configmap 을 생성합니다.
-	cm, err := push_mcis.NewCollectorManager()
+	cm, err := push_mcis.NewCollectorManager(ctx)
 	if err != nil {
 		util.GetLogger().Error("failed to initialize collector manager")
 		return err
 	}
 
+	// 컨슈머 그룹 방식일 경우, 토픽 스케줄러 없이 콜렉터를 하나의 컨슈머 그룹으로 구동합니다.
+	// 토픽 파티션 분배는 kafka 에 위임하며, 스케일 인/아웃은 collector_count 변경으로 수행합니다.
+	if strings.EqualFold(config.GetInstance().Monitoring.CollectorMode, types.ConsumerGroupCollectorMode) {
+		if err := cm.StartGroupCollector(wg); err != nil {
+			util.GetLogger().Error(err)
+			return err
+		}
+		return nil
+	}
+
 	// 콜렉터 스케줄러를 생성합니다.
 	// 콜렉터에게 분배할 topic 들을 관리하며 콜렉터의 배포 정책이 MaxAgentHost 일 경우,
 	// 콜렉터 매니저의 콜렉터 생성 및 삭제 기능을 활용하여 콜렉터 스케일 인/아웃을 수행합니다.
@@ -54,10 +77,10 @@ func startMCISPushModule(wg *sync.WaitGroup) error {
 }
 
 // startMCK8SPushModule MCK8S 수집 모듈 구동
-func startMCK8SPushModule(wg *sync.WaitGroup) error {
+func startMCK8SPushModule(ctx context.Context, wg *sync.WaitGroup) error {
 
 	// 콜렉터 매니저 생성
-	cm, err := push_mck8s.NewCollectorManager(wg)
+	cm, err := push_mck8s.NewCollectorManager(ctx, wg)
 	if err != nil {
 		util.GetLogger().Error(err)
 		return err
@@ -73,26 +96,50 @@ func startMCK8SPushModule(wg *sync.WaitGroup) error {
 	return nil
 }
 
-func startMCISPullModule(wg *sync.WaitGroup) error {
+func startMCISPullModule(ctx context.Context, wg *sync.WaitGroup) error {
 
 	// PULL 매니저 생성
-	pm, err := pull.NewPullManager()
+	pm, err := pull.NewPullManager(wg)
 	if err != nil {
 		util.GetLogger().Error("Failed to initialize collector manager")
 		return err
 	}
-	pa, err := puller.NewPullAggregator()
+	pa, err := puller.NewPullAggregator(wg)
 	if err != nil {
 		util.GetLogger().Error("Failed to initialize Aggregator")
 		return err
 	}
 	// PULL 콜러 실행
 	wg.Add(1)
-	go pm.StartPullCaller()
+	go pm.StartPullCaller(ctx)
 	// PULL Aggregator 실행
 	wg.Add(1)
-	go pa.StartAggregate()
+	go pa.StartAggregate(ctx)
+
+	return nil
+}
 
+// startHeartbeatModule 에이전트 하트비트 상태 점검 모듈 구동 (push, pull 공통)
+func startHeartbeatModule(ctx context.Context, wg *sync.WaitGroup) error {
+	lc, err := heartbeat.NewLivenessChecker(wg)
+	if err != nil {
+		util.GetLogger().Error("failed to initialize liveness checker")
+		return err
+	}
+	wg.Add(1)
+	go lc.StartLivenessCheck(ctx)
+	return nil
+}
+
+// startReconcileModule Tumblebug 인벤토리 기반 에이전트 정합성 점검 모듈 구동
+func startReconcileModule(ctx context.Context, wg *sync.WaitGroup) error {
+	r, err := reconcile.NewReconciler(wg)
+	if err != nil {
+		util.GetLogger().Error("failed to initialize agent reconciler")
+		return err
+	}
+	wg.Add(1)
+	go r.StartReconcile(ctx)
 	return nil
 }
 
@@ -101,35 +148,147 @@ func startMCK8SPullModule(wg *sync.WaitGroup) error {
 	return nil
 }
 
-func NewMechanism(wg *sync.WaitGroup) error {
+func NewMechanism(ctx context.Context, wg *sync.WaitGroup) error {
 
 	// Set Conf to InMemoryDB => Dragonfly의 config파일을 cb-store에 저장
 	// cb-store의 기록 정보는 dragonfly의 모듈이 restart해도 지워지지 않습니다.
 	SetConfigurationToMemoryDB()
 
-	// Monitoring Policy => Push or Pull
+	// Monitoring Policy => Push or Pull (신규 에이전트 기본 수집 방식)
 	switch config.GetDefaultConfig().GetMonConfig().DefaultPolicy {
-	case types.PushPolicy:
-		// MCIS 수집 모듈 구동
-		if err := startMCISPushModule(wg); err != nil {
+	case types.PushPolicy, types.PullPolicy:
+		break
+	default:
+		errMsg := "wrong monitoring mechanism config detected. change config to \"push\" or \"pull\""
+		util.GetLogger().Error(errMsg)
+		return errors.New(errMsg)
+	}
+
+	// kafka 보안 설정 사용 시 콜렉터 토픽 구성 체크 (공용 토픽 방식 미지원)
+	if err := kafkasec.ValidateTopicMode(config.GetInstance().Kafka, config.GetInstance().Monitoring); err != nil {
+		util.GetLogger().Error(err)
+		return err
+	}
+
+	// 리더 선출 (HA) - 리더 인스턴스에서만 스케줄러, 콜렉터, PULL 모듈, 하트비트 점검 모듈을 구동합니다.
+	// REST, gRPC API 서버는 모든 인스턴스에서 구동됩니다.
+	return leader.Run(ctx, lifecycle.GetInstance().Abort, func() error {
+		return startLeaderModules(ctx, wg)
+	})
+}
+
+// startLeaderModules 리더 인스턴스 전용 수집 모듈 구동
+func startLeaderModules(ctx context.Context, wg *sync.WaitGroup) error {
+
+	// 모니터링 기본 정책 수집 모듈 구동 (혼합 정책 사용 시 PUSH, PULL 수집 모듈 모두 구동)
+	if agentcommon.IsAgentTypeEnabled(types.PushPolicy) {
+		// MCIS PUSH 수집 모듈 구동
+		if err := startMCISPushModule(ctx, wg); err != nil {
 			return err
 		}
-		// MCK8S 수집 모듈 구동
-		if err := startMCK8SPushModule(wg); err != nil {
+		// MCK8S PUSH 수집 모듈 구동
+		if err := startMCK8SPushModule(ctx, wg); err != nil {
 			return err
 		}
-		break
-	case types.PullPolicy:
-		// MCIS 수집 모듈 구동
-		if err := startMCISPullModule(wg); err != nil {
+	}
+	if agentcommon.IsAgentTypeEnabled(types.PullPolicy) {
+		// MCIS PULL 수집 모듈 구동
+		if err := startMCISPullModule(ctx, wg); err != nil {
 			return err
 		}
-		break
-		// TODO: MCK8S 수집 모듈 구동
-	default:
-		errMsg := "wrong monitoring mechanism config detected. change config to \"push\" or \"pull\""
-		util.GetLogger().Error(errMsg)
-		return errors.New(errMsg)
+		// TODO: MCK8S PULL 수집 모듈 구동
+	}
+
+	// NATS JetStream, HTTP(kafka 미설정 시) 메트릭 수신 집계 모듈 구동
+	if config.GetInstance().Ingestion.NatsEnabled || !ingest.KafkaConfigured() {
+		wg.Add(1)
+		go ingest.GetInstance().Start(ctx, wg)
+	}
+	if config.GetInstance().Ingestion.NatsEnabled {
+		wg.Add(1)
+		go ingest.StartNatsConsumer(ctx, wg)
+	}
+
+	// 에이전트 하트비트 상태 점검 모듈 구동
+	if err := startHeartbeatModule(ctx, wg); err != nil {
+		return err
+	}
+
+	// 에이전트 인벤토리 정합성 점검 모듈 구동
+	if config.GetInstance().Reconcile.Enabled {
+		if err := startReconcileModule(ctx, wg); err != nil {
+			return err
+		}
+	}
+
+	// MCIS 벤치마크 캠페인 스케줄러 구동
+	if err := startBenchmarkModule(ctx, wg); err != nil {
+		return err
+	}
+
+	// MCIS VM 간 지연 시간 매트릭스 측정 모듈 구동
+	if config.GetInstance().LatencyMatrix.Enabled {
+		if err := startLatencyModule(ctx, wg); err != nil {
+			return err
+		}
+	}
+
+	// MCIS 에이전트 상위 프로세스 사용량 수집 모듈 구동
+	if config.GetInstance().ProcessCollection.Enabled {
+		if err := startProcessModule(ctx, wg); err != nil {
+			return err
+		}
+	}
+
+	// MCIS VM 간 패킷 흐름 캡처 모듈 구동
+	if config.GetInstance().PacketFlow.Enabled {
+		if err := startFlowModule(ctx, wg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startBenchmarkModule(ctx context.Context, wg *sync.WaitGroup) error {
+	s, err := benchmark.NewScheduler(wg)
+	if err != nil {
+		util.GetLogger().Error("failed to initialize benchmark scheduler")
+		return err
+	}
+	wg.Add(1)
+	go s.StartScheduler(ctx)
+	return nil
+}
+
+func startLatencyModule(ctx context.Context, wg *sync.WaitGroup) error {
+	s, err := latency.NewScheduler(wg)
+	if err != nil {
+		util.GetLogger().Error("failed to initialize latency matrix scheduler")
+		return err
 	}
+	wg.Add(1)
+	go s.StartScheduler(ctx)
+	return nil
+}
+
+func startProcessModule(ctx context.Context, wg *sync.WaitGroup) error {
+	c, err := process.NewCollector(wg)
+	if err != nil {
+		util.GetLogger().Error("failed to initialize process collector")
+		return err
+	}
+	wg.Add(1)
+	go c.StartCollect(ctx)
+	return nil
+}
+
+func startFlowModule(ctx context.Context, wg *sync.WaitGroup) error {
+	s, err := flow.NewScheduler(wg)
+	if err != nil {
+		util.GetLogger().Error("failed to initialize packet flow scheduler")
+		return err
+	}
+	wg.Add(1)
+	go s.StartScheduler(ctx)
 	return nil
 }
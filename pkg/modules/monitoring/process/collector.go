@@ -0,0 +1,90 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	defaultCollectInterval = 60
+	defaultMaxConcurrency  = 20
+)
+
+// Collector MCIS 에이전트 상위 프로세스 사용량 주기 수집
+type Collector struct {
+	WaitGroup *sync.WaitGroup
+}
+
+func NewCollector(wg *sync.WaitGroup) (*Collector, error) {
+	return &Collector{WaitGroup: wg}, nil
+}
+
+// StartCollect 수집 주기마다 전체 MCIS 에이전트 상위 프로세스 사용량 수집 (ctx 취소 시 종료)
+func (c *Collector) StartCollect(ctx context.Context) {
+	defer c.WaitGroup.Done()
+	for {
+		processConfig := config.GetInstance().ProcessCollection
+		interval := processConfig.Interval
+		if interval <= 0 {
+			interval = defaultCollectInterval
+		}
+
+		collectAll(ctx, processConfig.TopN, processConfig.MaxConcurrency)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+	}
+}
+
+// collectAll 동일 수집 회차의 프로세스가 같은 시각으로 조회되도록 초 단위 수집 시각 사용
+//   - 에이전트 수와 관계없이 동시 수집 요청은 maxConcurrency 개로 제한합니다.
+//   - ctx 취소 시 남은 에이전트는 요청하지 않고, 진행 중인 요청 완료 후 반환합니다.
+func collectAll(ctx context.Context, topN int, maxConcurrency int) {
+	agentList, err := common.ListAgent()
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to get agent list, error=%s", err))
+		return
+	}
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultMaxConcurrency
+	}
+
+	now := time.Now().Truncate(time.Second)
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrency)
+	defer wg.Wait()
+	for _, agentInfo := range agentList {
+		// MCK8S, 비활성, 비정상, 삭제된 VM 에이전트 제외
+		if util.CheckMCK8SType(agentInfo.ServiceType) || agentInfo.PublicIp == "" || agentInfo.Orphaned {
+			continue
+		}
+		if agentInfo.AgentState == string(common.Disable) || agentInfo.AgentHealth == string(common.Unhealthy) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case semaphore <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(agentInfo common.AgentInfo) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := mcis.CollectVMProcess(agentInfo, topN, now); err != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to collect process usage, vmId=%s, error=%s", agentInfo.VmId, err))
+			}
+		}(agentInfo)
+	}
+}
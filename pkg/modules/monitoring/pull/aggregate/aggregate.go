@@ -0,0 +1,203 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb1-client/models"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// CounterFieldSet 누적 카운터 필드 (diskio, network 메트릭, disk 메트릭에 병합된 diskio 필드 포함)
+var CounterFieldSet = map[string]bool{
+	"kb_read": true, "kb_written": true, "ops_read": true, "ops_write": true, "read_time": true, "write_time": true,
+	"bytes_in": true, "bytes_out": true, "pkts_in": true, "pkts_out": true, "err_in": true, "err_out": true, "drop_in": true, "drop_out": true,
+}
+
+// RateFieldSuffix 카운터 필드 초당 변화량 저장 필드 접미사 (예: bytes_in => bytes_in_per_sec)
+const RateFieldSuffix = "_per_sec"
+
+// RateField 카운터 필드의 초당 변화량 저장 필드 이름
+func RateField(field string) string {
+	return field + RateFieldSuffix
+}
+
+// Sample PULL 수집 시각 별 시리즈 필드 값
+type Sample struct {
+	Time   time.Time
+	Fields map[string]float64
+}
+
+// fieldPoint 필드 단위 샘플 값
+type fieldPoint struct {
+	time  time.Time
+	value float64
+}
+
+// AggregateSamples 집계 구간 내 시리즈 샘플 집계 (필드 별 결과 반환)
+//   - 게이지 필드는 aggregateType(min, max, avg, last) 기준으로 샘플 값을 집계합니다.
+//   - counterFields 에 포함된 필드는 PUSH 메트릭과 동일하게 누적 카운터 의미를 유지하도록 마지막 샘플 값을 그대로 반환합니다.
+//   - 카운터 필드의 연속 샘플 간 초당 변화량은 RateField 이름(예: bytes_in_per_sec)으로 집계하며, avg 의 경우 전체 변화량 / 전체 경과 시간으로 계산합니다.
+//   - 카운터 초기화(음수 변화량), 동일 시각 샘플 구간은 제외하며, 변화량을 계산할 수 없는 경우 초당 변화량 필드는 결과에서 제외합니다.
+func AggregateSamples(samples []Sample, aggregateType string, counterFields map[string]bool) map[string]float64 {
+	pointMap := map[string][]fieldPoint{}
+	for _, sample := range samples {
+		for field, value := range sample.Fields {
+			pointMap[field] = append(pointMap[field], fieldPoint{time: sample.Time, value: value})
+		}
+	}
+
+	result := map[string]float64{}
+	for field, pointList := range pointMap {
+		sort.SliceStable(pointList, func(i, j int) bool {
+			return pointList[i].time.Before(pointList[j].time)
+		})
+		if counterFields[field] {
+			result[field] = pointList[len(pointList)-1].value
+			if rate, ok := aggregateRate(pointList, aggregateType); ok {
+				result[RateField(field)] = rate
+			}
+			continue
+		}
+		if aggregated, ok := aggregateGauge(pointList, aggregateType); ok {
+			result[field] = aggregated
+		}
+	}
+	return result
+}
+
+func aggregateGauge(pointList []fieldPoint, aggregateType string) (float64, bool) {
+	if len(pointList) == 0 {
+		return 0, false
+	}
+	valueList := make([]float64, len(pointList))
+	for idx, point := range pointList {
+		valueList[idx] = point.value
+	}
+	return aggregateValue(valueList, aggregateType)
+}
+
+func aggregateRate(pointList []fieldPoint, aggregateType string) (float64, bool) {
+	var rateList []float64
+	var deltaSum, elapsedSum float64
+	for idx := 1; idx < len(pointList); idx++ {
+		elapsed := pointList[idx].time.Sub(pointList[idx-1].time).Seconds()
+		delta := pointList[idx].value - pointList[idx-1].value
+		if elapsed <= 0 || delta < 0 {
+			continue
+		}
+		rateList = append(rateList, delta/elapsed)
+		deltaSum += delta
+		elapsedSum += elapsed
+	}
+	if len(rateList) == 0 {
+		return 0, false
+	}
+	if types.AggregateType(aggregateType) == types.AVG {
+		return deltaSum / elapsedSum, true
+	}
+	return aggregateValue(rateList, aggregateType)
+}
+
+func aggregateValue(valueList []float64, aggregateType string) (float64, bool) {
+	switch types.AggregateType(aggregateType) {
+	case types.MIN:
+		minVal := valueList[0]
+		for _, value := range valueList[1:] {
+			if value < minVal {
+				minVal = value
+			}
+		}
+		return minVal, true
+	case types.MAX:
+		maxVal := valueList[0]
+		for _, value := range valueList[1:] {
+			if value > maxVal {
+				maxVal = value
+			}
+		}
+		return maxVal, true
+	case types.AVG:
+		var sum float64
+		for _, value := range valueList {
+			sum += value
+		}
+		return sum / float64(len(valueList)), true
+	case types.LAST:
+		return valueList[len(valueList)-1], true
+	}
+	return 0, false
+}
+
+// ParseRowSamples InfluxDB 조회 시리즈 수집 시각 별 샘플 변환
+//   - time 컬럼(RFC3339)을 수집 시각으로 사용하며, 수치가 아닌 필드 값은 제외합니다.
+func ParseRowSamples(row models.Row) []Sample {
+	timeIdx := -1
+	for idx, column := range row.Columns {
+		if column == "time" {
+			timeIdx = idx
+			break
+		}
+	}
+	if timeIdx < 0 {
+		return nil
+	}
+
+	var samples []Sample
+	for _, value := range row.Values {
+		if len(value) <= timeIdx {
+			continue
+		}
+		timeStr, ok := value[timeIdx].(string)
+		if !ok {
+			continue
+		}
+		sampleTime, err := time.Parse(time.RFC3339Nano, timeStr)
+		if err != nil {
+			continue
+		}
+		sample := Sample{Time: sampleTime, Fields: map[string]float64{}}
+		for idx, column := range row.Columns {
+			if idx == timeIdx || idx >= len(value) {
+				continue
+			}
+			if fieldVal, ok := toFloat(value[idx]); ok {
+				sample.Fields[column] = fieldVal
+			}
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// SeriesKey 메트릭 이름, 태그 조합 기준 시리즈 식별 키 생성
+func SeriesKey(measurement string, tagArr map[string]string) string {
+	tagKeyArr := make([]string, 0, len(tagArr))
+	for tagKey := range tagArr {
+		tagKeyArr = append(tagKeyArr, tagKey)
+	}
+	sort.Strings(tagKeyArr)
+
+	keyArr := []string{measurement}
+	for _, tagKey := range tagKeyArr {
+		keyArr = append(keyArr, fmt.Sprintf("%s=%s", tagKey, tagArr[tagKey]))
+	}
+	return strings.Join(keyArr, ",")
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		floatVal, err := v.Float64()
+		return floatVal, err == nil
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,210 @@
+package test
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb1-client/models"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/pull/aggregate"
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// sampleAt 기준 시각으로부터 sec 초 경과 시점 샘플 생성
+func sampleAt(sec int, fields map[string]float64) aggregate.Sample {
+	return aggregate.Sample{Time: baseTime.Add(time.Duration(sec) * time.Second), Fields: fields}
+}
+
+func TestAggregateSamples(t *testing.T) {
+	counterFields := map[string]bool{"bytes_in": true, "bytes_out": true}
+	gaugeSamples := []aggregate.Sample{
+		sampleAt(0, map[string]float64{"cpu_utilization": 10}),
+		sampleAt(10, map[string]float64{"cpu_utilization": 40}),
+		sampleAt(20, map[string]float64{"cpu_utilization": 20}),
+		sampleAt(30, map[string]float64{"cpu_utilization": 30}),
+	}
+	// 0~10s: 100/s, 10~30s: 50/s
+	counterSamples := []aggregate.Sample{
+		sampleAt(0, map[string]float64{"bytes_in": 1000}),
+		sampleAt(10, map[string]float64{"bytes_in": 2000}),
+		sampleAt(30, map[string]float64{"bytes_in": 3000}),
+	}
+
+	testCases := []struct {
+		name          string
+		samples       []aggregate.Sample
+		aggregateType string
+		expected      map[string]float64
+	}{
+		{name: "gauge avg", samples: gaugeSamples, aggregateType: "avg", expected: map[string]float64{"cpu_utilization": 25}},
+		{name: "gauge max", samples: gaugeSamples, aggregateType: "max", expected: map[string]float64{"cpu_utilization": 40}},
+		{name: "gauge min", samples: gaugeSamples, aggregateType: "min", expected: map[string]float64{"cpu_utilization": 10}},
+		{name: "gauge last", samples: gaugeSamples, aggregateType: "last", expected: map[string]float64{"cpu_utilization": 30}},
+		{
+			name: "gauge unsorted samples",
+			samples: []aggregate.Sample{
+				sampleAt(20, map[string]float64{"cpu_utilization": 20}),
+				sampleAt(0, map[string]float64{"cpu_utilization": 10}),
+				sampleAt(10, map[string]float64{"cpu_utilization": 40}),
+			},
+			aggregateType: "last",
+			expected:      map[string]float64{"cpu_utilization": 20},
+		},
+		{
+			name: "gauge missing field",
+			samples: []aggregate.Sample{
+				sampleAt(0, map[string]float64{"mem_used": 100, "mem_free": 900}),
+				sampleAt(10, map[string]float64{"mem_used": 300}),
+			},
+			aggregateType: "avg",
+			expected:      map[string]float64{"mem_used": 200, "mem_free": 900},
+		},
+		{name: "counter avg (time weighted)", samples: counterSamples, aggregateType: "avg", expected: map[string]float64{"bytes_in": 3000, "bytes_in_per_sec": 2000.0 / 30}},
+		{name: "counter max", samples: counterSamples, aggregateType: "max", expected: map[string]float64{"bytes_in": 3000, "bytes_in_per_sec": 100}},
+		{name: "counter min", samples: counterSamples, aggregateType: "min", expected: map[string]float64{"bytes_in": 3000, "bytes_in_per_sec": 50}},
+		{name: "counter last", samples: counterSamples, aggregateType: "last", expected: map[string]float64{"bytes_in": 3000, "bytes_in_per_sec": 50}},
+		{
+			name: "counter unsorted samples",
+			samples: []aggregate.Sample{
+				counterSamples[2], counterSamples[0], counterSamples[1],
+			},
+			aggregateType: "last",
+			expected:      map[string]float64{"bytes_in": 3000, "bytes_in_per_sec": 50},
+		},
+		{
+			name: "counter reset",
+			samples: []aggregate.Sample{
+				sampleAt(0, map[string]float64{"bytes_in": 5000}),
+				sampleAt(10, map[string]float64{"bytes_in": 6000}),
+				sampleAt(20, map[string]float64{"bytes_in": 100}),
+				sampleAt(30, map[string]float64{"bytes_in": 600}),
+			},
+			aggregateType: "avg",
+			expected:      map[string]float64{"bytes_in": 600, "bytes_in_per_sec": 1500.0 / 20},
+		},
+		{
+			name:          "counter single sample",
+			samples:       []aggregate.Sample{sampleAt(0, map[string]float64{"bytes_in": 1000})},
+			aggregateType: "avg",
+			expected:      map[string]float64{"bytes_in": 1000},
+		},
+		{
+			name: "counter same timestamp",
+			samples: []aggregate.Sample{
+				sampleAt(0, map[string]float64{"bytes_in": 1000}),
+				sampleAt(0, map[string]float64{"bytes_in": 1200}),
+				sampleAt(10, map[string]float64{"bytes_in": 1500}),
+			},
+			aggregateType: "max",
+			expected:      map[string]float64{"bytes_in": 1500, "bytes_in_per_sec": 30},
+		},
+		{
+			name: "counter and gauge",
+			samples: []aggregate.Sample{
+				sampleAt(0, map[string]float64{"bytes_out": 0, "disk_used": 10}),
+				sampleAt(10, map[string]float64{"bytes_out": 500, "disk_used": 30}),
+			},
+			aggregateType: "avg",
+			expected:      map[string]float64{"bytes_out": 500, "bytes_out_per_sec": 50, "disk_used": 20},
+		},
+		{name: "empty samples", samples: nil, aggregateType: "avg", expected: map[string]float64{}},
+		{name: "unknown aggregate type", samples: gaugeSamples, aggregateType: "sum", expected: map[string]float64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := aggregate.AggregateSamples(tc.samples, tc.aggregateType, counterFields)
+			if len(result) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, result)
+			}
+			for field, expected := range tc.expected {
+				if math.Abs(result[field]-expected) > 1e-9 {
+					t.Errorf("field %s: expected %v, got %v", field, expected, result[field])
+				}
+			}
+		})
+	}
+}
+
+func TestRateField(t *testing.T) {
+	if result := aggregate.RateField("kb_read"); result != "kb_read_per_sec" {
+		t.Errorf("expected kb_read_per_sec, got %s", result)
+	}
+}
+
+func TestParseRowSamples(t *testing.T) {
+	testCases := []struct {
+		name     string
+		row      models.Row
+		expected []aggregate.Sample
+	}{
+		{
+			name: "numeric fields",
+			row: models.Row{
+				Columns: []string{"time", "bytes_in", "bytes_out"},
+				Values: [][]interface{}{
+					{"2024-01-01T00:00:00Z", json.Number("100"), json.Number("200.5")},
+					{"2024-01-01T00:00:10.5Z", json.Number("300"), nil},
+				},
+			},
+			expected: []aggregate.Sample{
+				{Time: baseTime, Fields: map[string]float64{"bytes_in": 100, "bytes_out": 200.5}},
+				{Time: baseTime.Add(10500 * time.Millisecond), Fields: map[string]float64{"bytes_in": 300}},
+			},
+		},
+		{
+			name: "non-numeric value and invalid time",
+			row: models.Row{
+				Columns: []string{"time", "cpu_utilization", "osType"},
+				Values: [][]interface{}{
+					{"invalid", json.Number("1"), "linux"},
+					{"2024-01-01T00:00:00Z", json.Number("12.5"), "linux"},
+				},
+			},
+			expected: []aggregate.Sample{
+				{Time: baseTime, Fields: map[string]float64{"cpu_utilization": 12.5}},
+			},
+		},
+		{
+			name:     "no time column",
+			row:      models.Row{Columns: []string{"cpu_utilization"}, Values: [][]interface{}{{json.Number("1")}}},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := aggregate.ParseRowSamples(tc.row)
+			if len(result) != len(tc.expected) {
+				t.Fatalf("expected %d samples, got %d", len(tc.expected), len(result))
+			}
+			for idx, sample := range result {
+				if !sample.Time.Equal(tc.expected[idx].Time) || !reflect.DeepEqual(sample.Fields, tc.expected[idx].Fields) {
+					t.Errorf("sample %d: expected %v, got %v", idx, tc.expected[idx], sample)
+				}
+			}
+		})
+	}
+}
+
+func TestSeriesKey(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tagArr   map[string]string
+		expected string
+	}{
+		{name: "sorted tags", tagArr: map[string]string{"vmId": "vm01", "nsId": "ns01", "mcisId": "mcis01"}, expected: "net,mcisId=mcis01,nsId=ns01,vmId=vm01"},
+		{name: "no tags", tagArr: nil, expected: "net"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := aggregate.SeriesKey("net", tc.tagArr); result != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, result)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package pull
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/pull/puller"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 )
 
 type PullManager struct {
@@ -16,39 +18,54 @@ type PullManager struct {
 	WaitGroup *sync.WaitGroup
 }
 
-func NewPullManager() (*PullManager, error) {
+func NewPullManager(wg *sync.WaitGroup) (*PullManager, error) {
 	pullManager := PullManager{
-		WaitGroup: &sync.WaitGroup{},
+		WaitGroup: wg,
 	}
 	return &pullManager, nil
 }
 
-func (pm *PullManager) StartPullCaller() error {
-	for {
+// StartPullCaller PULL 스케줄러 구동 (ctx 취소 시 진행 중인 PULL 요청 완료 후 종료)
+//   - 에이전트 목록은 puller_interval 주기로 동기화하며, 에이전트 별 PULL 요청은 워커 풀에서 수행합니다.
+func (pm *PullManager) StartPullCaller(ctx context.Context) error {
+	defer pm.WaitGroup.Done()
 
-		pullingInterval := time.Duration(config.GetInstance().Monitoring.PullerInterval)
+	scheduler := puller.NewPullScheduler(config.GetInstance().Monitoring.PullerWorkerCount)
+	var workerWaitGroup sync.WaitGroup
+	scheduler.StartWorker(&workerWaitGroup)
+	defer func() {
+		scheduler.Stop()
+		workerWaitGroup.Wait()
+	}()
 
-		// PULL 콜러 모듈 실행
-		err := pm.syncAgentList()
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
+	var lastSync time.Time
+	var deferredCnt int
+	for {
+		now := time.Now()
+		pullingInterval := time.Duration(config.GetInstance().Monitoring.PullerInterval) * time.Second
 
-		// 에이전트가 없을 경우
-		if len(pm.AgentList) == 0 {
-			time.Sleep(pullingInterval * time.Second)
-			continue
+		// PULL 대상 에이전트 목록 동기화
+		if now.Sub(lastSync) >= pullingInterval {
+			if err := pm.syncAgentList(); err != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to sync pull agent list, error=%s", err))
+			} else {
+				scheduler.SyncAgent(pm.AgentList, pullingInterval, now)
+			}
+			if deferredCnt > 0 {
+				fmt.Printf("[%s] <PULL> deferred pull requests (no idle worker): %d\n", now.Format(time.RFC3339), deferredCnt)
+				deferredCnt = 0
+			}
+			lastSync = now
 		}
 
-		pullCaller, err := puller.NewPullCaller(pm.AgentList)
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
-		go pullCaller.StartPull()
+		// PULL 주기가 도래한 에이전트 요청 할당
+		deferredCnt += scheduler.Dispatch(now)
 
-		time.Sleep(pullingInterval * time.Second)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(puller.DispatchInterval):
+		}
 	}
 }
 
@@ -59,7 +76,6 @@ func (pm *PullManager) StopPullCaller() error {
 func (pm *PullManager) syncAgentList() error {
 	syncedAgentList, err := common.ListAgent()
 	if err != nil {
-		fmt.Println(err)
 		return err
 	}
 	pm.AgentList = syncedAgentList
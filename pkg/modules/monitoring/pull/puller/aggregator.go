@@ -1,174 +1,146 @@
 package puller
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
-	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
-
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
-	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
-	"github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/metric"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/pull/aggregate"
 	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
-	"github.com/sirupsen/logrus"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	// pullAggregateGrace 집계 구간 종료 후 PULL 메트릭 쓰기 완료 대기 시간
+	pullAggregateGrace = 5 * time.Second
+	// pullRetention PULL 원본 메트릭 보관 기간 (PULL 에이전트 메트릭 조회 및 카운터 변화량 계산 용도)
+	pullRetention = 5 * time.Minute
 )
 
 type PullAggregator struct {
 	Storage   v1.Storage
-	CBStore   cbstore.CBStore
-	AgentList map[string]common.AgentInfo
+	WaitGroup *sync.WaitGroup
+
+	lastWindowEnd time.Time
+	// lastCounter 시리즈 별 이전 집계 구간의 마지막 카운터 샘플 (구간 경계 변화량 계산 용도)
+	lastCounter map[string]aggregate.Sample
 }
 
-func NewPullAggregator() (*PullAggregator, error) {
+func NewPullAggregator(wg *sync.WaitGroup) (*PullAggregator, error) {
 	pullAggregator := PullAggregator{
-		Storage: *v1.GetInstance(),
-		CBStore: *cbstore.GetInstance(),
+		Storage:     *v1.GetInstance(),
+		WaitGroup:   wg,
+		lastCounter: map[string]aggregate.Sample{},
 	}
 	return &pullAggregator, nil
 }
 
-func (pa *PullAggregator) StartAggregate() error {
-	metricArr := []types.Metric{types.Cpu, types.CpuFrequency, types.Memory, types.Disk, types.Network, types.DiskIO}
-	aggregateInterval := time.Duration(config.GetInstance().Monitoring.PullerAggregateInterval)
+// StartAggregate PULL 메트릭 집계 구동 (ctx 취소 시 진행 중인 집계 완료 후 종료)
+func (pa *PullAggregator) StartAggregate(ctx context.Context) error {
+	defer pa.WaitGroup.Done()
+	measurementArr := []string{}
+	for _, metricKind := range []types.Metric{types.Cpu, types.CpuFrequency, types.Memory, types.Disk, types.Network, types.DiskIO} {
+		measurementArr = append(measurementArr, metricKind.ToAgentMetricKey())
+	}
 	for {
-		time.Sleep(aggregateInterval * time.Second)
-
-		err := pa.syncAgentList()
-		if err != nil {
-			fmt.Println(err)
-			return err
+		aggregateInterval := time.Duration(config.GetInstance().Monitoring.PullerAggregateInterval) * time.Second
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(aggregateInterval):
 		}
+		pa.AggregateMetric(measurementArr, config.GetInstance().Monitoring.AggregateType, aggregateInterval, time.Now())
+	}
+}
 
-		if len(pa.AgentList) == 0 {
-			time.Sleep(aggregateInterval * time.Second)
-			continue
-		}
+// AggregateMetric 이전 집계 이후 종료된 집계 구간 별 PULL 메트릭 집계
+//   - 집계 구간은 aggregateInterval 단위로 정렬되며, 구간 별 집계 결과를 구간 시작 시각 기준으로 저장합니다.
+//   - diskio, network 카운터 필드는 PUSH 메트릭과 동일하게 누적 값으로 저장하고, 구간 초당 변화량은 *_per_sec 필드로 함께 저장합니다.
+//   - 집계 완료 후 보관 기간이 지난 PULL 원본 메트릭을 삭제합니다.
+func (pa *PullAggregator) AggregateMetric(measurementArr []string, aggregateType string, aggregateInterval time.Duration, now time.Time) {
+	if aggregateInterval <= 0 {
+		return
+	}
+	windowEnd := now.Add(-pullAggregateGrace).Truncate(aggregateInterval)
+	windowStart := pa.lastWindowEnd
+	// 최초 집계 또는 보관 기간 이상 집계가 지연된 경우 마지막 구간만 집계
+	if windowStart.IsZero() || windowEnd.Sub(windowStart) > pullRetention {
+		windowStart = windowEnd.Add(-aggregateInterval)
+	}
 
-		go pa.AggregateMetric(pa.AgentList, metricArr, config.GetInstance().Monitoring.AggregateType)
+	for start := windowStart; start.Before(windowEnd); start = start.Add(aggregateInterval) {
+		end := start.Add(aggregateInterval)
+		for _, measurement := range measurementArr {
+			if err := pa.aggregateWindow(measurement, aggregateType, start, end); err != nil {
+				util.GetLogger().Error(err)
+			}
+		}
+		pa.lastWindowEnd = end
+	}
 
+	// 보관 기간이 지난 카운터 샘플 제거
+	for seriesKey, sample := range pa.lastCounter {
+		if sample.Time.Before(windowEnd.Add(-pullRetention)) {
+			delete(pa.lastCounter, seriesKey)
+		}
+	}
+	for _, measurement := range measurementArr {
+		if err := pa.Storage.DeleteMetricBefore(v1.PullDatabase, measurement, windowEnd.Add(-pullRetention)); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to delete pull metric %s, error=%s", measurement, err))
+		}
 	}
 }
 
-func (pa *PullAggregator) AggregateMetric(agentList map[string]common.AgentInfo, metricArr []types.Metric, aggregateType string) {
-	for _, targetAgent := range agentList {
-		if targetAgent.AgentType == types.PushPolicy {
+func (pa *PullAggregator) aggregateWindow(measurement string, aggregateType string, start time.Time, end time.Time) error {
+	rows, err := pa.Storage.ReadPullWindow(measurement, start, end)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to read pull metric %s, error=%s", measurement, err))
+	}
+	for _, row := range rows {
+		samples := aggregate.ParseRowSamples(row)
+		if len(samples) == 0 {
 			continue
 		}
-		for _, metricKind := range metricArr {
-			var receivedMetric interface{}
-			var err error
-			var mappedMetric interface{}
-			monRequestInfo := types.DBMetricRequestInfo{
-				MonitoringMechanism: config.GetInstance().Monitoring.DefaultPolicy == types.PushPolicy,
-				NsID:                targetAgent.NsId,
-				ServiceType:         targetAgent.ServiceType,
-				ServiceID:           targetAgent.McisId,
-				VMID:                targetAgent.VmId,
-				MetricName:          metricKind.ToAgentMetricKey(),
-				Period:              "m",
-				AggegateType:        aggregateType,
-				Duration:            "5m",
-			}
-			receivedMetric, err = pa.Storage.ReadMetric(monRequestInfo)
-			if err != nil {
-				logrus.Println(err)
-			}
-			if receivedMetric == nil {
-				continue
-			}
-			mappedMetric, err = metric.MappingMonMetric(metricKind.ToString(), &receivedMetric)
-			var metricName string
-			var valueLength float64
-			tagArr := map[string]string{}
-			reqValue := map[string]interface{}{}
-			if metricKind.ToString() == types.Network.ToString() || metricKind.ToString() == types.DiskIO.ToString() {
-				for k, v := range mappedMetric.(map[string]interface{}) {
-					if k == "values" {
-						for _, vv := range v.([]interface{}) {
-							for metricKey, metricValue := range vv.(map[string]interface{}) {
-								valueLength += 1
-								if metricKey == "time" {
-									continue
-								}
-								compare, _ := metricValue.(json.Number).Float64()
-								if reqValue[metricKey] == nil || aggregateType == types.LAST.ToString() {
-									reqValue[metricKey] = compare
-								} else {
-									origin, _ := reqValue[metricKey].(float64)
-									var vSum float64
-									if aggregateType == types.MAX.ToString() {
-										if origin < compare {
-											reqValue[metricKey] = compare
-										}
-									}
-									if aggregateType == types.MIN.ToString() {
-										if origin > compare {
-											reqValue[metricKey] = compare
-										}
-									}
-									if aggregateType == types.AVG.ToString() {
-										vSum += compare
-										reqValue[metricKey] = vSum / valueLength
-
-									}
-								}
-							}
-						}
-					}
-					if k == "name" {
-						metricName = v.(string)
-					}
-					if k == "tags" {
-						for tKey, tValue := range v.(map[string]string) {
-							tagArr[tKey] = tValue
-						}
-					}
-				}
-			} else {
-				convertedMetric := mappedMetric.(map[string]interface{})
-				metricName = convertedMetric["name"].(string)
-				for k, v := range convertedMetric["tags"].(map[string]string) {
-					tagArr[k] = v
-				}
-				for _, value := range convertedMetric["values"].([]interface{}) {
-					for k, v := range value.(map[string]interface{}) {
-						if k == "time" {
-							continue
-						}
-						if v == nil {
-							v = json.Number("0")
-						}
-						inputData, _ := v.(json.Number).Float64()
-						reqValue[k] = inputData
-
-					}
-				}
+		seriesKey := aggregate.SeriesKey(measurement, row.Tags)
+		if prevSample, ok := pa.lastCounter[seriesKey]; ok {
+			samples = append([]aggregate.Sample{prevSample}, samples...)
+		}
 
-			}
-			err = pa.Storage.WriteOnDemandMetric(v1.DefaultDatabase, metricName, tagArr, reqValue)
-			if err != nil {
-				logrus.Println(err)
-			}
-			err = pa.Storage.DeleteMetric(v1.PullDatabase, metricName, "5m")
-			if err != nil {
-				logrus.Println(err)
-			}
+		fieldArr := map[string]interface{}{}
+		for field, value := range aggregate.AggregateSamples(samples, aggregateType, aggregate.CounterFieldSet) {
+			fieldArr[field] = value
+		}
+		pa.updateLastCounter(seriesKey, samples)
+		if len(fieldArr) == 0 {
+			continue
+		}
+		if err := v1.GetWritePipeline().WritePoint(v1.DefaultDatabase, measurement, row.Tags, fieldArr, start); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to write aggregated pull metric %s, error=%s", measurement, err))
 		}
 	}
+	return nil
 }
 
-func (pa *PullAggregator) CalculateMetric() (map[string]interface{}, error) {
-	return nil, nil
-}
-
-func (pa *PullAggregator) syncAgentList() error {
-	syncedAgentList, err := common.ListAgent()
-	if err != nil {
-		fmt.Println(err)
-		return err
+// updateLastCounter 시리즈 마지막 샘플의 카운터 필드 값 기록
+func (pa *PullAggregator) updateLastCounter(seriesKey string, samples []aggregate.Sample) {
+	var lastSample aggregate.Sample
+	for _, sample := range samples {
+		if lastSample.Fields == nil || sample.Time.After(lastSample.Time) {
+			lastSample = sample
+		}
 	}
-	pa.AgentList = syncedAgentList
-	return nil
+	counterSample := aggregate.Sample{Time: lastSample.Time, Fields: map[string]float64{}}
+	for field, value := range lastSample.Fields {
+		if aggregate.CounterFieldSet[field] {
+			counterSample.Fields[field] = value
+		}
+	}
+	if len(counterSample.Fields) == 0 {
+		return
+	}
+	pa.lastCounter[seriesKey] = counterSample
 }
@@ -1,139 +1,125 @@
 package puller
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
 
 	agentmetadata "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
 	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
-
-	"github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 )
 
 const (
 	AgentUnhealthyCnt = 5
 )
 
-type PullCaller struct {
-	AgentList map[string]agentmetadata.AgentInfo
+// PullMetricArr PULL 수집 대상 메트릭
+var PullMetricArr = []types.Metric{types.Cpu, types.CpuFrequency, types.Memory, types.Disk, types.DiskIO, types.Network}
+
+// pullJob 에이전트 PULL 요청 정보 (할당 시점 에이전트 정보)
+type pullJob struct {
+	uuid      string
+	agent     agentmetadata.AgentInfo
+	metricArr []types.Metric
+	timeout   time.Duration
+	legacy    bool
 }
 
-func NewPullCaller(agentList map[string]agentmetadata.AgentInfo) (PullCaller, error) {
-	return PullCaller{AgentList: agentList}, nil
+// pullResult 에이전트 PULL 요청 결과
+type pullResult struct {
+	success bool
+	legacy  bool // 메트릭 일괄 요청 미지원 에이전트 여부
+	checked bool // 메트릭 일괄 요청 지원 여부 확인 여부
 }
 
-func (pc PullCaller) StartPull() {
-	for uuid, agent := range pc.AgentList {
-		// Check agent status
-		if agent.AgentState == string(agentmetadata.Disable) {
-			continue
-		}
-		// Check agent health
-		if agent.AgentHealth == string(agentmetadata.Unhealthy) {
-			// Call healthcheck API
-			err := pc.healthcheck(uuid, agent)
-			if err != nil {
-				fmt.Println(err)
-			}
-			continue
-		}
-		go pc.pullMetric(uuid, agent)
+// callAgent 에이전트 PULL 요청 (비정상 에이전트의 경우 헬스체크 요청)
+//   - 요청 제한 시간이 지나면 요청을 취소하여 느린 에이전트가 워커를 점유하지 않도록 합니다.
+func callAgent(job pullJob) pullResult {
+	ctx, cancel := context.WithTimeout(context.Background(), job.timeout)
+	defer cancel()
+
+	if job.agent.AgentHealth == string(agentmetadata.Unhealthy) {
+		return pullResult{success: healthcheck(ctx, job.agent), legacy: job.legacy}
 	}
-	fmt.Println(fmt.Sprintf("[%s] finished pulling loop", time.Now().Local().String()))
+	return pullMetric(ctx, job)
 }
 
-func (pc PullCaller) healthcheck(uuid string, agent agentmetadata.AgentInfo) error {
-	client := http.Client{
-		Timeout: mcis.AgentTimeout * time.Second,
-	}
+func healthcheck(ctx context.Context, agent agentmetadata.AgentInfo) bool {
 	agentUrl := fmt.Sprintf("http://%s:%d/cb-dragonfly/healthcheck", agent.PublicIp, types.AgentPort)
-	resp, _ := client.Get(agentUrl)
-	if resp != nil {
-		if resp.StatusCode == http.StatusNoContent {
-			_, _, err := agentmetadata.PutAgent(agentmetadata.AgentInstallInfo{
-				NsId:        agent.NsId,
-				McisId:      agent.McisId,
-				VmId:        agent.VmId,
-				CspType:     agent.CspType,
-				PublicIp:    agent.PublicIp,
-				ServiceType: agent.ServiceType,
-			}, 0, agentmetadata.Enable, agentmetadata.Healthy)
-			if err != nil {
-				return err
-			}
-		}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, agentUrl, nil)
+	if err != nil {
+		return false
 	}
-	return nil
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == http.StatusNoContent
 }
 
-func (pc PullCaller) pullMetric(uuid string, agent agentmetadata.AgentInfo) {
-
-	pullerIdx := time.Now().Unix()
-	metricArr := []types.Metric{types.Cpu, types.CpuFrequency, types.Memory, types.Disk, types.DiskIO, types.Network}
-	for _, pullMetric := range metricArr {
-
-		if agent.AgentState == string(agentmetadata.Disable) || agent.AgentHealth == string(agentmetadata.Unhealthy) {
-			// TODO: Call healthcheck API
-			continue
+// pullMetric 에이전트 수집 메트릭 일괄 요청 후 PULL 데이터베이스 저장
+//   - 메트릭 일괄 요청을 지원하지 않는 에이전트는 메트릭 별 요청으로 전환합니다.
+func pullMetric(ctx context.Context, job pullJob) pullResult {
+	result := pullResult{legacy: job.legacy}
+
+	var metricMap map[types.Metric]map[string]interface{}
+	if !job.legacy {
+		resultMap, statusCode, err := mcis.GetVMOnDemandMultiMonInfo(ctx, job.metricArr, job.agent.PublicIp)
+		if (err != nil && statusCode == http.StatusNotFound) || (err == nil && len(resultMap) == 0 && len(job.metricArr) > 1) {
+			result.legacy = true
+			result.checked = true
+		} else if err != nil {
+			util.GetLogger().Warn(fmt.Sprintf("failed to pull agent metric, uuid=%s, error=%s", agentmetadata.MakeAgentUUIDByInfo(job.agent), err))
+			return result
+		} else {
+			metricMap = resultMap
+			result.checked = true
 		}
-
-		fmt.Printf("[%d][%s][%s] CALL API: http://%s:%d/cb-dragonfly/metric/%s\n", pullerIdx, time.Now().Local().String(), uuid, agent.PublicIp, types.AgentPort, pullMetric.ToAgentMetricKey())
-
-		// Pulling agent
-		result, statusCode, err := mcis.GetVMOnDemandMonInfo(pullMetric.ToString(), agent.PublicIp)
-
-		// Update Agent Health
-		if statusCode == http.StatusOK && agent.AgentHealth == string(agentmetadata.Unhealthy) {
-			_, _, err := agentmetadata.PutAgent(agentmetadata.AgentInstallInfo{
-				ServiceType: agent.ServiceType,
-				NsId:        agent.NsId,
-				McisId:      agent.McisId,
-				VmId:        agent.VmId,
-				CspType:     agent.CspType,
-			}, 0, agentmetadata.Enable, agentmetadata.Healthy)
+	}
+	if result.legacy {
+		metricMap = map[types.Metric]map[string]interface{}{}
+		for _, metric := range job.metricArr {
+			resultMap, _, err := mcis.GetVMOnDemandMultiMonInfo(ctx, []types.Metric{metric}, job.agent.PublicIp)
 			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
 				continue
 			}
-		}
-		if statusCode != http.StatusOK {
-			agent.AgentUnhealthyRespCnt += 1
-			if agent.AgentUnhealthyRespCnt > AgentUnhealthyCnt {
-				_, _, err := agentmetadata.PutAgent(agentmetadata.AgentInstallInfo{
-					NsId:        agent.NsId,
-					McisId:      agent.McisId,
-					VmId:        agent.VmId,
-					CspType:     agent.CspType,
-					PublicIp:    agent.PublicIp,
-					ServiceType: agent.ServiceType,
-				}, agent.AgentUnhealthyRespCnt, agentmetadata.Enable, agentmetadata.Unhealthy)
-				if err != nil {
-					continue
-				}
+			for metricKind, metricData := range resultMap {
+				metricMap[metricKind] = metricData
 			}
 		}
-
-		if result == nil {
-			continue
-		}
-
-		// 메트릭 정보 파싱
-		metricData := result.(map[string]interface{})
-		metricName := metricData["name"].(string)
-		if metricName == "" {
+	}
+	if len(metricMap) == 0 {
+		return result
+	}
+	result.success = true
+
+	// 메트릭 정보 InfluxDB 저장 (수집 시각 기준, 집계 시 수집 시각 간격으로 카운터 변화량 계산)
+	pulledAt := time.Now()
+	for _, metricData := range metricMap {
+		metricName, _ := metricData["name"].(string)
+		metricVal, _ := metricData["values"].(map[string]interface{})
+		if metricName == "" || len(metricVal) == 0 {
 			continue
 		}
 		tagArr := map[string]string{}
-		for k, v := range metricData["tags"].(map[string]interface{}) {
-			tagArr[k] = v.(string)
+		if tags, ok := metricData["tags"].(map[string]interface{}); ok {
+			for k, v := range tags {
+				if tagStr, ok := v.(string); ok {
+					tagArr[k] = tagStr
+				}
+			}
 		}
-		metricVal := metricData["values"].(map[string]interface{})
-
-		// 메트릭 정보 InfluxDB 저장
-		err = v1.GetInstance().WriteOnDemandMetric(v1.PullDatabase, metricName, tagArr, metricVal)
-		if err != nil {
-			fmt.Println(err)
+		if err := v1.GetWritePipeline().WritePoint(v1.PullDatabase, metricName, tagArr, metricVal, pulledAt); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to write pull metric %s, error=%s", metricName, err))
 		}
 	}
+	return result
 }
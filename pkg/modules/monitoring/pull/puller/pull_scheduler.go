@@ -0,0 +1,220 @@
+package puller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	agentmetadata "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/metric/mcis"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/pull/schedule"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	DefaultPullWorkerCnt = 20
+	// DispatchInterval PULL 요청 할당 주기
+	DispatchInterval = time.Second
+)
+
+// pullTarget PULL 대상 에이전트 요청 정보
+type pullTarget struct {
+	agent     agentmetadata.AgentInfo
+	metricArr []types.Metric
+	interval  time.Duration
+}
+
+// PullScheduler PULL 대상 에이전트 별 주기 관리 및 워커 풀 기반 PULL 요청
+//   - 에이전트 별 수집 프로파일 주기(미지정 시 puller_interval)에 요청 시점 분산(jitter)을 적용합니다.
+//   - 유휴 워커가 없을 경우 요청을 다음 할당 시점으로 연기하여 느린 에이전트가 다른 에이전트 요청을 지연시키지 않도록 합니다.
+type PullScheduler struct {
+	WorkerCnt int
+
+	mutex     sync.Mutex
+	targets   map[string]*pullTarget
+	scheduler *schedule.Scheduler
+	jobCh     chan pullJob
+}
+
+func NewPullScheduler(workerCnt int) *PullScheduler {
+	if workerCnt <= 0 {
+		workerCnt = DefaultPullWorkerCnt
+	}
+	return &PullScheduler{
+		WorkerCnt: workerCnt,
+		targets:   map[string]*pullTarget{},
+		scheduler: schedule.New(),
+		jobCh:     make(chan pullJob),
+	}
+}
+
+// StartWorker PULL 워커 구동 (Stop 호출 시 진행 중인 요청 완료 후 종료)
+func (ps *PullScheduler) StartWorker(wg *sync.WaitGroup) {
+	for i := 0; i < ps.WorkerCnt; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range ps.jobCh {
+				result := callAgent(job)
+				ps.complete(job, result, time.Now())
+			}
+		}()
+	}
+}
+
+// Stop PULL 요청 할당 종료 (Stop 이후 Dispatch 호출 불가)
+func (ps *PullScheduler) Stop() {
+	close(ps.jobCh)
+}
+
+// SyncAgent PULL 대상 에이전트 목록 동기화
+//   - 신규 에이전트는 PULL 주기 내 임의 시점에 첫 요청을 수행합니다.
+//   - PUSH 에이전트, 비활성화 에이전트, 삭제된 VM 에이전트는 PULL 대상에서 제외합니다.
+func (ps *PullScheduler) SyncAgent(agentList map[string]agentmetadata.AgentInfo, defaultInterval time.Duration, now time.Time) {
+	profileCache := map[string]*agentmetadata.CollectionProfile{}
+	syncedTargets := map[string]*pullTarget{}
+	intervals := map[string]time.Duration{}
+	for uuid, agent := range agentList {
+		if agent.AgentType != types.PullPolicy || util.CheckMCK8SType(agent.ServiceType) {
+			continue
+		}
+		if agent.AgentState == string(agentmetadata.Disable) || agent.Orphaned {
+			continue
+		}
+		interval, metricArr := resolvePullSpec(agent, defaultInterval, profileCache)
+		syncedTargets[uuid] = &pullTarget{agent: agent, metricArr: metricArr, interval: interval}
+		intervals[uuid] = interval
+	}
+
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	ps.targets = syncedTargets
+	ps.scheduler.Sync(intervals, now)
+}
+
+// Dispatch PULL 주기가 도래한 에이전트 요청을 유휴 워커에 할당 (오래 대기한 에이전트 우선)
+//   - 유휴 워커가 없어 할당하지 못한 에이전트 수를 반환합니다.
+func (ps *PullScheduler) Dispatch(now time.Time) int {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	return ps.scheduler.Dispatch(now, func(uuid string, legacy bool) bool {
+		target, ok := ps.targets[uuid]
+		if !ok {
+			return true
+		}
+		job := pullJob{
+			uuid:      uuid,
+			agent:     target.agent,
+			metricArr: target.metricArr,
+			timeout:   pullTimeout(target.interval),
+			legacy:    legacy,
+		}
+		select {
+		case ps.jobCh <- job:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+// complete PULL 요청 결과 반영 (다음 요청 시점 설정, 에이전트 헬스상태 및 하트비트 갱신)
+func (ps *PullScheduler) complete(job pullJob, result pullResult, now time.Time) {
+	var healthUpdate agentmetadata.AgentHealth
+	var unhealthyRespCnt int
+
+	ps.mutex.Lock()
+	if result.checked {
+		ps.scheduler.SetLegacy(job.uuid, result.legacy, now)
+	}
+	failCnt, scheduled := ps.scheduler.Complete(job.uuid, result.success, now)
+	if target, ok := ps.targets[job.uuid]; ok && scheduled {
+		if result.success {
+			if target.agent.AgentHealth == string(agentmetadata.Unhealthy) || target.agent.AgentUnhealthyRespCnt != 0 {
+				target.agent.AgentHealth = string(agentmetadata.Healthy)
+				target.agent.AgentUnhealthyRespCnt = 0
+				healthUpdate = agentmetadata.Healthy
+			}
+		} else if failCnt > AgentUnhealthyCnt && target.agent.AgentHealth != string(agentmetadata.Unhealthy) {
+			target.agent.AgentHealth = string(agentmetadata.Unhealthy)
+			target.agent.AgentUnhealthyRespCnt = failCnt
+			healthUpdate = agentmetadata.Unhealthy
+			unhealthyRespCnt = failCnt
+		}
+	}
+	ps.mutex.Unlock()
+
+	if healthUpdate != "" {
+		if err := agentmetadata.UpdateAgentHealth(job.uuid, unhealthyRespCnt, healthUpdate); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to update agent health with UUID %s, error=%s", job.uuid, err))
+		} else {
+			fmt.Printf("[%s] <PULL> update %s AgentStatus %s\n", now.Format(time.RFC3339), job.uuid, healthUpdate)
+		}
+	}
+	// 에이전트 하트비트 갱신 (PULL 요청 당 1회)
+	if result.success {
+		if err := agentmetadata.UpdateAgentLastSeen(job.uuid, now); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to update agent last seen with UUID %s, error=%s", job.uuid, err))
+		}
+	}
+}
+
+// resolvePullSpec 에이전트 수집 프로파일 기준 PULL 주기, 요청 메트릭 조회
+//   - 프로파일 수집 주기가 없을 경우 defaultInterval 을 적용합니다.
+//   - 프로파일 입력 플러그인에 포함된 PULL 메트릭만 요청하며, 포함된 메트릭이 없을 경우 전체 PULL 메트릭을 요청합니다.
+func resolvePullSpec(agent agentmetadata.AgentInfo, defaultInterval time.Duration, profileCache map[string]*agentmetadata.CollectionProfile) (time.Duration, []types.Metric) {
+	interval := defaultInterval
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	cacheKey := fmt.Sprintf("%s/%s/%s", agent.Profile, agent.NsId, agent.McisId)
+	profile, ok := profileCache[cacheKey]
+	if !ok {
+		var err error
+		profile, err = agentmetadata.ResolveProfile(agentmetadata.AgentInstallInfo{
+			ServiceType: agent.ServiceType,
+			NsId:        agent.NsId,
+			McisId:      agent.McisId,
+			VmId:        agent.VmId,
+			CspType:     agent.CspType,
+			Profile:     agent.Profile,
+		})
+		if err != nil {
+			util.GetLogger().Warn(fmt.Sprintf("failed to resolve collection profile of agent %s, error=%s", agentmetadata.MakeAgentUUIDByInfo(agent), err))
+		}
+		profileCache[cacheKey] = profile
+	}
+	if profile == nil {
+		return interval, PullMetricArr
+	}
+
+	if profile.Interval > 0 {
+		interval = time.Duration(profile.Interval) * time.Second
+	}
+	inputSet := map[string]bool{}
+	for _, input := range profile.Inputs {
+		inputSet[input.Name] = true
+	}
+	var metricArr []types.Metric
+	for _, metric := range PullMetricArr {
+		if inputSet[metric.ToAgentMetricKey()] {
+			metricArr = append(metricArr, metric)
+		}
+	}
+	if len(metricArr) == 0 {
+		metricArr = PullMetricArr
+	}
+	return interval, metricArr
+}
+
+// pullTimeout 에이전트 요청 제한 시간 (PULL 주기를 넘지 않도록 설정)
+func pullTimeout(interval time.Duration) time.Duration {
+	timeout := mcis.AgentTimeout * time.Second
+	if interval < timeout {
+		timeout = interval
+	}
+	return timeout
+}
@@ -0,0 +1,165 @@
+package schedule
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// JitterRatio PULL 주기 대비 요청 시점 분산 비율
+	JitterRatio = 0.1
+	// LegacyProbeInterval 메트릭 일괄 요청 미지원 에이전트의 일괄 요청 재확인 주기 (에이전트 업그레이드 반영)
+	LegacyProbeInterval = 10 * time.Minute
+)
+
+// Target PULL 대상 스케줄 정보
+type Target struct {
+	Interval      time.Duration
+	NextPull      time.Time
+	Running       bool
+	FailCnt       int       // 연속 PULL 실패 횟수
+	Legacy        bool      // 메트릭 일괄 요청 미지원 여부 (메트릭 별 요청)
+	LegacyProbeAt time.Time // 메트릭 일괄 요청 재확인 시점
+	probing       bool      // 메트릭 일괄 요청 재확인 진행 여부
+}
+
+// Scheduler PULL 대상 별 요청 주기 관리
+//   - 대상 별 PULL 주기에 요청 시점 분산(jitter)을 적용하며, 주기가 도래한 대상을 오래 대기한 순서로 할당합니다.
+type Scheduler struct {
+	mutex   sync.Mutex
+	targets map[string]*Target
+}
+
+func New() *Scheduler {
+	return &Scheduler{targets: map[string]*Target{}}
+}
+
+// Sync PULL 대상 목록 동기화 (키 별 PULL 주기)
+//   - 신규 대상은 PULL 주기 내 임의 시점에 첫 요청을 수행합니다.
+//   - PULL 주기가 짧아져 다음 요청 시점이 새 주기를 넘는 경우 새 주기 내 임의 시점으로 재설정합니다.
+//   - 목록에 없는 대상은 스케줄에서 제외합니다.
+func (s *Scheduler) Sync(intervals map[string]time.Duration, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, interval := range intervals {
+		if interval < time.Second {
+			interval = time.Second
+		}
+		target, ok := s.targets[key]
+		if !ok {
+			s.targets[key] = &Target{
+				Interval: interval,
+				NextPull: now.Add(time.Duration(rand.Int63n(int64(interval)))),
+			}
+			continue
+		}
+		if target.Interval != interval {
+			target.Interval = interval
+			if target.NextPull.After(now.Add(interval)) {
+				target.NextPull = now.Add(time.Duration(rand.Int63n(int64(interval))))
+			}
+		}
+	}
+	for key := range s.targets {
+		if _, ok := intervals[key]; !ok {
+			delete(s.targets, key)
+		}
+	}
+}
+
+// Dispatch PULL 주기가 도래한 대상 요청 할당 (오래 대기한 대상 우선)
+//   - send 는 대상 키, 메트릭 별 요청 여부를 전달받아 할당 성공 여부를 반환합니다.
+//   - 메트릭 일괄 요청 미지원 대상도 재확인 시점이 지나면 일괄 요청으로 할당합니다.
+//   - send 가 실패하면 할당을 중단하고 할당하지 못한 대상 수를 반환합니다.
+func (s *Scheduler) Dispatch(now time.Time, send func(key string, legacy bool) bool) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var dueKeys []string
+	for key, target := range s.targets {
+		if !target.Running && !target.NextPull.After(now) {
+			dueKeys = append(dueKeys, key)
+		}
+	}
+	sort.Slice(dueKeys, func(i, j int) bool {
+		return s.targets[dueKeys[i]].NextPull.Before(s.targets[dueKeys[j]].NextPull)
+	})
+
+	for idx, key := range dueKeys {
+		target := s.targets[key]
+		probing := target.Legacy && !now.Before(target.LegacyProbeAt)
+		if !send(key, target.Legacy && !probing) {
+			return len(dueKeys) - idx
+		}
+		target.Running = true
+		target.probing = probing
+	}
+	return 0
+}
+
+// SetLegacy 메트릭 일괄 요청 지원 여부 반영
+//   - 일괄 요청 미지원으로 전환되거나 재확인 결과 미지원인 경우 다음 재확인 시점을 설정합니다.
+func (s *Scheduler) SetLegacy(key string, legacy bool, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	target, ok := s.targets[key]
+	if !ok {
+		return
+	}
+	if legacy && (!target.Legacy || target.probing) {
+		target.LegacyProbeAt = now.Add(LegacyProbeInterval)
+	}
+	target.Legacy = legacy
+	target.probing = false
+}
+
+// Complete PULL 요청 결과 반영 (다음 요청 시점 설정, 연속 실패 횟수 갱신)
+//   - 연속 실패 횟수를 반환하며, 스케줄에서 제외된 대상의 경우 false 를 반환합니다.
+func (s *Scheduler) Complete(key string, success bool, now time.Time) (int, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	target, ok := s.targets[key]
+	if !ok {
+		return 0, false
+	}
+	target.Running = false
+	target.probing = false
+	target.NextPull = NextPullTime(target.NextPull, target.Interval, now)
+	if success {
+		target.FailCnt = 0
+	} else {
+		target.FailCnt += 1
+	}
+	return target.FailCnt, true
+}
+
+// Get 대상 스케줄 정보 조회
+func (s *Scheduler) Get(key string) (Target, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	target, ok := s.targets[key]
+	if !ok {
+		return Target{}, false
+	}
+	return *target, true
+}
+
+// NextPullTime 다음 PULL 요청 시점 (이전 예정 시점 + 주기 ± jitter, 요청이 지연된 경우 현재 시점 기준)
+func NextPullTime(prevPull time.Time, interval time.Duration, now time.Time) time.Time {
+	jitterRange := int64(float64(interval) * JitterRatio)
+	var jitter time.Duration
+	if jitterRange > 0 {
+		jitter = time.Duration(rand.Int63n(2*jitterRange+1) - jitterRange)
+	}
+	next := prevPull.Add(interval + jitter)
+	if next.Before(now) {
+		next = now.Add(time.Duration(rand.Int63n(jitterRange + 1)))
+	}
+	return next
+}
@@ -0,0 +1,234 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/pull/schedule"
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// dispatchAll 주기가 도래한 대상 전체 할당 (할당 순서, 메트릭 별 요청 여부 반환)
+func dispatchAll(s *schedule.Scheduler, now time.Time) ([]string, map[string]bool) {
+	var keys []string
+	legacyMap := map[string]bool{}
+	s.Dispatch(now, func(key string, legacy bool) bool {
+		keys = append(keys, key)
+		legacyMap[key] = legacy
+		return true
+	})
+	return keys, legacyMap
+}
+
+func TestNextPullTime(t *testing.T) {
+	interval := 10 * time.Second
+	jitterRange := time.Duration(float64(interval) * schedule.JitterRatio)
+
+	testCases := []struct {
+		name     string
+		prevPull time.Time
+		now      time.Time
+		min      time.Time
+		max      time.Time
+	}{
+		{
+			name:     "on schedule",
+			prevPull: baseTime,
+			now:      baseTime.Add(2 * time.Second),
+			min:      baseTime.Add(interval - jitterRange),
+			max:      baseTime.Add(interval + jitterRange),
+		},
+		{
+			name:     "late request",
+			prevPull: baseTime,
+			now:      baseTime.Add(3 * interval),
+			min:      baseTime.Add(3 * interval),
+			max:      baseTime.Add(3*interval + jitterRange),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				next := schedule.NextPullTime(tc.prevPull, interval, tc.now)
+				if next.Before(tc.min) || next.After(tc.max) {
+					t.Fatalf("expected next pull between %s and %s, got %s", tc.min, tc.max, next)
+				}
+			}
+		})
+	}
+
+	t.Run("sub-jitter interval", func(t *testing.T) {
+		next := schedule.NextPullTime(baseTime, time.Nanosecond*5, baseTime)
+		if !next.Equal(baseTime.Add(5 * time.Nanosecond)) {
+			t.Fatalf("expected next pull without jitter, got %s", next)
+		}
+	})
+}
+
+func TestSync(t *testing.T) {
+	s := schedule.New()
+	s.Sync(map[string]time.Duration{"a": 10 * time.Second, "b": 60 * time.Second}, baseTime)
+
+	// 신규 대상은 주기 내 임의 시점에 첫 요청
+	for key, interval := range map[string]time.Duration{"a": 10 * time.Second, "b": 60 * time.Second} {
+		target, ok := s.Get(key)
+		if !ok {
+			t.Fatalf("expected target %s to be scheduled", key)
+		}
+		if target.Interval != interval {
+			t.Errorf("expected interval %s of %s, got %s", interval, key, target.Interval)
+		}
+		if target.NextPull.Before(baseTime) || !target.NextPull.Before(baseTime.Add(interval)) {
+			t.Errorf("expected first pull of %s within interval, got %s", key, target.NextPull)
+		}
+	}
+
+	// 주기 단축 시 새 주기를 넘는 요청 시점 재설정, 목록에 없는 대상 제외
+	now := baseTime.Add(time.Second)
+	s.Sync(map[string]time.Duration{"b": time.Second, "c": 0}, now)
+	if _, ok := s.Get("a"); ok {
+		t.Errorf("expected target a to be removed")
+	}
+	b, _ := s.Get("b")
+	if b.Interval != time.Second {
+		t.Errorf("expected interval of b to be updated, got %s", b.Interval)
+	}
+	if b.NextPull.After(now.Add(time.Second)) {
+		t.Errorf("expected next pull of b within new interval, got %s", b.NextPull)
+	}
+	c, ok := s.Get("c")
+	if !ok || c.Interval != time.Second {
+		t.Errorf("expected target c with minimum interval, got %+v", c)
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	interval := 10 * time.Second
+	s := schedule.New()
+	s.Sync(map[string]time.Duration{"a": interval, "b": interval, "c": interval}, baseTime)
+
+	// 주기 경과 후 전체 대상 할당 (오래 대기한 대상 우선)
+	now := baseTime.Add(interval)
+	keys, _ := dispatchAll(s, now)
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 dispatched targets, got %v", keys)
+	}
+	for i := 1; i < len(keys); i++ {
+		prev, _ := s.Get(keys[i-1])
+		cur, _ := s.Get(keys[i])
+		if cur.NextPull.Before(prev.NextPull) {
+			t.Errorf("expected dispatch order by next pull, got %v", keys)
+		}
+	}
+
+	// 진행 중인 대상은 재할당하지 않음
+	if keys, _ = dispatchAll(s, now.Add(interval)); len(keys) != 0 {
+		t.Errorf("expected running targets to be skipped, got %v", keys)
+	}
+
+	// 요청 완료 후 다음 주기에 재할당
+	for _, key := range []string{"a", "b", "c"} {
+		if _, ok := s.Complete(key, true, now); !ok {
+			t.Fatalf("expected target %s to be completed", key)
+		}
+		if target, _ := s.Get(key); target.Running || target.NextPull.Before(now) {
+			t.Errorf("expected next pull of %s after completion, got %+v", key, target)
+		}
+	}
+
+	// 유휴 워커가 없는 경우 할당하지 못한 대상 수 반환
+	later := now.Add(2 * interval)
+	sent := 0
+	deferred := s.Dispatch(later, func(key string, legacy bool) bool {
+		if sent == 1 {
+			return false
+		}
+		sent += 1
+		return true
+	})
+	if deferred != 2 {
+		t.Errorf("expected 2 deferred targets, got %d", deferred)
+	}
+	keys, _ = dispatchAll(s, later)
+	if len(keys) != 2 {
+		t.Errorf("expected deferred targets to be dispatched next time, got %v", keys)
+	}
+}
+
+func TestDispatchLegacyProbe(t *testing.T) {
+	interval := 10 * time.Second
+	s := schedule.New()
+	s.Sync(map[string]time.Duration{"a": interval}, baseTime)
+
+	pull := func(now time.Time, legacyResult bool) bool {
+		_, legacyMap := dispatchAll(s, now)
+		legacy, ok := legacyMap["a"]
+		if !ok {
+			t.Fatalf("expected target a to be dispatched at %s", now)
+		}
+		if !legacy {
+			s.SetLegacy("a", legacyResult, now)
+		}
+		s.Complete("a", true, now)
+		return legacy
+	}
+
+	// 최초 일괄 요청, 미지원 확인 후 메트릭 별 요청
+	now := baseTime.Add(interval)
+	if pull(now, true) {
+		t.Fatalf("expected batched request at first pull")
+	}
+	target, _ := s.Get("a")
+	if !target.Legacy || !target.LegacyProbeAt.Equal(now.Add(schedule.LegacyProbeInterval)) {
+		t.Fatalf("expected legacy target with probe time, got %+v", target)
+	}
+	probeAt := target.LegacyProbeAt
+	if !pull(now.Add(2*interval), true) {
+		t.Errorf("expected per-metric request before probe time")
+	}
+
+	// 재확인 시점 이후 일괄 요청, 미지원인 경우 재확인 시점 연장
+	now = probeAt.Add(interval)
+	if pull(now, true) {
+		t.Errorf("expected batched request after probe time")
+	}
+	target, _ = s.Get("a")
+	if !target.Legacy || !target.LegacyProbeAt.Equal(now.Add(schedule.LegacyProbeInterval)) {
+		t.Errorf("expected probe time to be extended, got %+v", target)
+	}
+
+	// 재확인 결과 일괄 요청 지원 시 일괄 요청 유지
+	now = target.LegacyProbeAt.Add(interval)
+	if pull(now, false) {
+		t.Errorf("expected batched request after probe time")
+	}
+	target, _ = s.Get("a")
+	if target.Legacy {
+		t.Errorf("expected target to leave legacy mode")
+	}
+	if pull(now.Add(2*interval), false) {
+		t.Errorf("expected batched request after upgrade")
+	}
+}
+
+func TestComplete(t *testing.T) {
+	s := schedule.New()
+	s.Sync(map[string]time.Duration{"a": 10 * time.Second}, baseTime)
+
+	var failCnts []int
+	for _, success := range []bool{false, false, false, true, false} {
+		failCnt, _ := s.Complete("a", success, baseTime)
+		failCnts = append(failCnts, failCnt)
+	}
+	if expected := []int{1, 2, 3, 0, 1}; !reflect.DeepEqual(failCnts, expected) {
+		t.Errorf("expected fail counts %v, got %v", expected, failCnts)
+	}
+
+	// 스케줄에서 제외된 대상
+	s.Sync(map[string]time.Duration{}, baseTime)
+	if _, ok := s.Complete("a", true, baseTime); ok {
+		t.Errorf("expected removed target not to be completed")
+	}
+}
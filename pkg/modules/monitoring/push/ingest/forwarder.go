@@ -0,0 +1,135 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/telegraf"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// ErrForwardQueueFull kafka 전달 큐에 여유 공간이 없음
+var ErrForwardQueueFull = errors.New("ingestion forward queue is full")
+
+// Forwarder HTTP 수신 메트릭 kafka 전달 모듈
+//   - HTTP 수신은 모든 인스턴스에서 처리하므로, 수신 인스턴스에서 직접 집계하지 않고 에이전트 토픽으로 전달합니다.
+//   - 에이전트 UUID 를 메세지 키로 전달하므로, 에이전트 샘플은 토픽(파티션)을 배정받은 콜렉터 한 곳에서만 집계됩니다.
+type Forwarder struct {
+	producer *kafka.Producer
+}
+
+var forwarderOnce sync.Once
+var forwarder *Forwarder
+var forwarderErr error
+
+// GetForwarder kafka 전달 모듈 조회 (kafka 설정 시 최초 HTTP 수신에서 producer 생성)
+func GetForwarder() (*Forwarder, error) {
+	forwarderOnce.Do(func() {
+		forwarder, forwarderErr = newForwarder()
+	})
+	return forwarder, forwarderErr
+}
+
+func newForwarder() (*Forwarder, error) {
+	bufferSize := config.GetInstance().Ingestion.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	producerConfig := &kafka.ConfigMap{
+		"bootstrap.servers":            config.GetInstance().Kafka.EndpointUrl,
+		"queue.buffering.max.messages": bufferSize,
+		"linger.ms":                    100,
+		"compression.type":             "gzip",
+	}
+	if err := kafkasec.ApplySecurityConfig(producerConfig, config.GetInstance().Kafka); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to set ingestion kafka security config, error=%s", err))
+	}
+	producer, err := kafka.NewProducer(producerConfig)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to create ingestion kafka producer, error=%s", err))
+	}
+	f := &Forwarder{producer: producer}
+	go f.handleEvents()
+	return f, nil
+}
+
+// handleEvents kafka 전달 결과 처리 (전달 실패 메세지 로그 기록)
+func (f *Forwarder) handleEvents() {
+	for event := range f.producer.Events() {
+		switch ev := event.(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to forward ingestion metric, agent=%s, error=%s", string(ev.Key), ev.TopicPartition.Error))
+			}
+		case kafka.Error:
+			util.GetLogger().Error(fmt.Sprintf("ingestion kafka producer error, error=%s", ev))
+		}
+	}
+}
+
+// Forward 에이전트 메트릭을 에이전트 토픽으로 전달 (전달 큐가 가득 찬 경우 ErrForwardQueueFull 반환)
+//   - 메트릭의 에이전트 식별 태그(nsId, mcisId, vmId, cspType)는 에이전트 메타데이터 값으로 설정합니다.
+//   - 수신 방식은 메세지 헤더로 전달하여 콜렉터의 거부 메세지 기록에 사용합니다.
+func (f *Forwarder) Forward(agentInfo agentcommon.AgentInfo, transport string, metrics []telegraf.Metric) error {
+	agentUUID := agentcommon.MakeAgentUUIDByInfo(agentInfo)
+	topic := agentcommon.GetAgentTopic(agentUUID)
+	for _, metric := range metrics {
+		setAgentTags(&metric, agentInfo)
+		value, err := json.Marshal(metric)
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to marshal ingestion metric, error=%s", err))
+		}
+		err = f.producer.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Key:            []byte(agentUUID),
+			Value:          value,
+			Headers:        []kafka.Header{{Key: types.TransportHeader, Value: []byte(transport)}},
+		}, nil)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if errors.As(err, &kafkaErr) && kafkaErr.Code() == kafka.ErrQueueFull {
+				return ErrForwardQueueFull
+			}
+			return errors.New(fmt.Sprintf("failed to forward ingestion metric, error=%s", err))
+		}
+	}
+	return nil
+}
+
+// FlushForwarder 종료 전 kafka 전달 큐 flush (HTTP 수신 이력이 없을 경우 무시)
+func FlushForwarder(ctx context.Context) error {
+	if forwarder == nil {
+		return nil
+	}
+	for forwarder.producer.Len() > 0 {
+		select {
+		case <-ctx.Done():
+			return errors.New(fmt.Sprintf("failed to flush ingestion forward queue, remain=%d", forwarder.producer.Len()))
+		default:
+		}
+		forwarder.producer.Flush(int((100 * time.Millisecond).Milliseconds()))
+	}
+	forwarder.producer.Close()
+	return nil
+}
+
+// setAgentTags 메트릭 에이전트 식별 태그 설정
+func setAgentTags(metric *telegraf.Metric, agentInfo agentcommon.AgentInfo) {
+	if metric.Tags == nil {
+		metric.Tags = map[string]interface{}{}
+	}
+	metric.Tags[types.NsId] = agentInfo.NsId
+	metric.Tags[types.McisId] = agentInfo.McisId
+	metric.Tags[types.VmId] = agentInfo.VmId
+	metric.Tags[types.CspType] = agentInfo.CspType
+}
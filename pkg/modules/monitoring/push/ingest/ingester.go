@@ -0,0 +1,115 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const defaultBufferSize = 100000
+
+// ErrIngestBufferFull 수신 샘플 버퍼에 여유 공간이 없음
+var ErrIngestBufferFull = errors.New("ingestion buffer is full")
+
+// Ingester NATS, HTTP 수신 샘플 집계 모듈
+//   - 수신한 샘플을 버퍼에 저장하고, MCIS 콜렉터 집계 주기마다 kafka 콜렉터와 동일한 Aggregator 로 집계합니다.
+//   - 리더 인스턴스에서만 구동하므로, 에이전트 샘플은 한 인스턴스에서만 집계됩니다.
+//   - HTTP 수신 메트릭은 kafka 설정 시 Forwarder 로 kafka 콜렉터에 전달되며, 미설정 시 Relay 를 통해 Ingester 에서 집계됩니다.
+type Ingester struct {
+	samples    chan collector.AgentSample
+	aggregator collector.Aggregator
+}
+
+var once sync.Once
+var ingester *Ingester
+
+func GetInstance() *Ingester {
+	once.Do(func() {
+		bufferSize := config.GetInstance().Ingestion.BufferSize
+		if bufferSize <= 0 {
+			bufferSize = defaultBufferSize
+		}
+		ingester = &Ingester{
+			samples: make(chan collector.AgentSample, bufferSize),
+			aggregator: collector.Aggregator{
+				AggregateType: types.AggregateType(config.GetInstance().Monitoring.AggregateType),
+			},
+		}
+	})
+	return ingester
+}
+
+// Submit 에이전트 샘플 버퍼 저장 (버퍼 여유 공간이 부족할 경우 저장하지 않고 에러 반환)
+//   - 샘플의 에이전트 식별 태그(nsId, mcisId, vmId, cspType)는 에이전트 메타데이터 값으로 설정합니다.
+func (i *Ingester) Submit(agentInfo agentcommon.AgentInfo, transport string, metrics []collector.TelegrafMetric) error {
+	if len(i.samples)+len(metrics) > cap(i.samples) {
+		return ErrIngestBufferFull
+	}
+	agentUUID := agentcommon.MakeAgentUUIDByInfo(agentInfo)
+	receivedAt := time.Now().Unix()
+	for _, metric := range metrics {
+		setAgentTags(&metric, agentInfo)
+		select {
+		case i.samples <- collector.AgentSample{AgentUUID: agentUUID, Transport: transport, Metric: metric, ReceivedAt: receivedAt}:
+		default:
+			return ErrIngestBufferFull
+		}
+	}
+	return nil
+}
+
+// Start 수신 샘플 집계 실행 (ctx 취소 시 버퍼에 남은 샘플 집계 후 종료)
+func (i *Ingester) Start(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	interval := config.GetInstance().Monitoring.MCISCollectorInterval
+	if interval <= 0 {
+		interval = 1
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			i.aggregate()
+			util.GetLogger().Info("stop ingestion aggregator")
+			return
+		case <-ticker.C:
+			i.aggregate()
+		}
+	}
+}
+
+func (i *Ingester) aggregate() {
+	sampleCnt := len(i.samples)
+	samples := make([]collector.AgentSample, 0, sampleCnt)
+	for idx := 0; idx < sampleCnt; idx++ {
+		samples = append(samples, <-i.samples)
+	}
+	if len(samples) != 0 {
+		fmt.Printf("[%s] <MCIS> ingestion samples : %d\n", time.Now().Format(time.RFC3339), len(samples))
+	}
+	i.aggregator.AggregateSamples(samples, nil)
+}
+
+// GetPushAgent 수신 대상 MCIS PUSH 에이전트 메타데이터 조회
+func GetPushAgent(agentUUID string) (*agentcommon.AgentInfo, error) {
+	agentInfo, err := agentcommon.GetAgentByUUID(agentUUID)
+	if err != nil || agentInfo == nil {
+		return nil, errors.New(fmt.Sprintf("failed to get agent metadata with UUID %s", agentUUID))
+	}
+	if !util.CheckMCISType(agentInfo.ServiceType) {
+		return nil, errors.New(fmt.Sprintf("agent %s is not mcis agent", agentUUID))
+	}
+	if agentInfo.AgentType != "" && agentInfo.AgentType != types.PushPolicy {
+		return nil, errors.New(fmt.Sprintf("agent %s is not push agent", agentUUID))
+	}
+	return agentInfo, nil
+}
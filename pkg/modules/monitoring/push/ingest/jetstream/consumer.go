@@ -0,0 +1,177 @@
+package jetstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	DefaultFetchBatch   = 500
+	DefaultFetchExpires = 5 * time.Second
+	DefaultAckWait      = 30 * time.Second
+	reconnectWait       = 2 * time.Second
+	streamMaxAge        = 24 * time.Hour
+	clientName          = "cb-dragonfly"
+)
+
+// Config NATS JetStream 메트릭 수신 설정
+type Config struct {
+	Url                 string
+	Username            string
+	Password            string
+	CredentialsLocation string // 사용자 자격증명(.creds) 파일 경로 (JWT 인증)
+	TlsCaLocation       string
+	TlsCertLocation     string
+	TlsKeyLocation      string
+	Stream              string
+	Subject             string // 에이전트 메트릭 subject prefix ({prefix}.{에이전트 UUID})
+	Consumer            string // durable pull 컨슈머 이름
+	FetchBatch          int
+	FetchExpires        time.Duration
+	AckWait             time.Duration
+}
+
+// Handler 에이전트 메세지 처리, ACK 여부 반환 (false 반환 시 ack_wait 이후 재전송)
+type Handler func(agentUUID string, data []byte) bool
+
+// InvalidHandler 에이전트 UUID 를 확인할 수 없는 subject 메세지 처리 (재전송되지 않도록 ACK 처리)
+type InvalidHandler func(subject string)
+
+// Connect NATS 서버 접속
+//   - 연결이 끊어지면 ctx 취소 전까지 재접속을 계속 시도하며, 재접속 중 수신 요청은 실패 후 재시도합니다.
+//   - nats:// 또는 tls:// 주소를 지원하며, CA 인증서가 설정된 경우 TLS 로 접속합니다.
+func Connect(conf Config, errHandler func(err error)) (*nats.Conn, error) {
+	opts := []nats.Option{
+		nats.Name(clientName),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(reconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			if err != nil && errHandler != nil {
+				errHandler(errors.New(fmt.Sprintf("nats connection disconnected, error=%s", err)))
+			}
+		}),
+	}
+	if conf.CredentialsLocation != "" {
+		opts = append(opts, nats.UserCredentials(conf.CredentialsLocation))
+	} else if conf.Username != "" {
+		opts = append(opts, nats.UserInfo(conf.Username, conf.Password))
+	}
+	if conf.TlsCaLocation != "" {
+		opts = append(opts, nats.RootCAs(conf.TlsCaLocation))
+	}
+	if conf.TlsCertLocation != "" || conf.TlsKeyLocation != "" {
+		opts = append(opts, nats.ClientCert(conf.TlsCertLocation, conf.TlsKeyLocation))
+	}
+	nc, err := nats.Connect(conf.Url, opts...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to connect nats server, error=%s", err))
+	}
+	return nc, nil
+}
+
+// EnsureStream 메트릭 수신 스트림 생성 (이미 있을 경우 그대로 사용)
+func EnsureStream(js nats.JetStreamContext, conf Config) error {
+	_, err := js.StreamInfo(conf.Stream)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return errors.New(fmt.Sprintf("failed to get nats stream, error=%s", err))
+	}
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:      conf.Stream,
+		Subjects:  []string{conf.Subject + ".>"},
+		Retention: nats.LimitsPolicy,
+		Storage:   nats.FileStorage,
+		MaxAge:    streamMaxAge,
+	})
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to create nats stream, error=%s", err))
+	}
+	return nil
+}
+
+// GetAgentUUID 메세지 subject 의 에이전트 UUID 조회 ({prefix}.{에이전트 UUID})
+func GetAgentUUID(subject string, subjectPrefix string) (string, bool) {
+	agentUUID := strings.TrimPrefix(subject, subjectPrefix+".")
+	if agentUUID == subject || agentUUID == "" || strings.Contains(agentUUID, ".") {
+		return "", false
+	}
+	return agentUUID, true
+}
+
+// Consume durable pull 컨슈머로 메세지 수신 후 handler 처리 (ctx 취소 시 nil 반환)
+//   - 처리 완료(handler true 반환) 메세지만 ACK 하며, ACK 하지 않은 메세지는 ack_wait 이후 재전송됩니다.
+func Consume(ctx context.Context, nc *nats.Conn, conf Config, handler Handler, invalidHandler InvalidHandler) error {
+	conf = withDefault(conf)
+	js, err := nc.JetStream()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to get jetstream context, error=%s", err))
+	}
+	if err = EnsureStream(js, conf); err != nil {
+		return err
+	}
+	sub, err := js.PullSubscribe(conf.Subject+".>", conf.Consumer,
+		nats.BindStream(conf.Stream),
+		nats.DeliverAll(),
+		nats.AckExplicit(),
+		nats.AckWait(conf.AckWait),
+		nats.MaxAckPending(conf.FetchBatch*10),
+	)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to create nats consumer, error=%s", err))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		fetchCtx, cancel := context.WithTimeout(ctx, conf.FetchExpires)
+		msgs, err := sub.Fetch(conf.FetchBatch, nats.Context(fetchCtx))
+		cancel()
+		if err != nil {
+			if nc.IsClosed() {
+				return errors.New(fmt.Sprintf("nats connection closed, error=%s", err))
+			}
+			// 수신 메세지 없음, 재접속 중인 경우 다음 수신 요청
+			if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) && !errors.Is(err, nats.ErrTimeout) && nc.IsConnected() {
+				return errors.New(fmt.Sprintf("failed to fetch nats message, error=%s", err))
+			}
+		}
+		for _, msg := range msgs {
+			agentUUID, ok := GetAgentUUID(msg.Subject, conf.Subject)
+			if !ok {
+				if invalidHandler != nil {
+					invalidHandler(msg.Subject)
+				}
+				_ = msg.Ack()
+				continue
+			}
+			if handler(agentUUID, msg.Data) {
+				if err = msg.Ack(); err != nil {
+					return errors.New(fmt.Sprintf("failed to ack nats message, error=%s", err))
+				}
+			}
+		}
+	}
+}
+
+func withDefault(conf Config) Config {
+	if conf.FetchBatch <= 0 {
+		conf.FetchBatch = DefaultFetchBatch
+	}
+	if conf.FetchExpires <= 0 {
+		conf.FetchExpires = DefaultFetchExpires
+	}
+	if conf.AckWait <= 0 {
+		conf.AckWait = DefaultAckWait
+	}
+	return conf
+}
@@ -0,0 +1,272 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/ingest/jetstream"
+)
+
+const (
+	agentUUID     = "ns01_mcis_mcis01_vm01_aws"
+	subjectPrefix = "cbmetrics"
+)
+
+// receivedMsg handler 수신 메세지
+type receivedMsg struct {
+	agentUUID string
+	data      string
+}
+
+// msgRecorder handler 수신 메세지 기록
+type msgRecorder struct {
+	lock     sync.Mutex
+	msgs     []receivedMsg
+	invalids []string
+	notify   chan struct{}
+}
+
+func newMsgRecorder() *msgRecorder {
+	return &msgRecorder{notify: make(chan struct{}, 100)}
+}
+
+func (r *msgRecorder) handler(ack func(cnt int) bool) jetstream.Handler {
+	return func(agentUUID string, data []byte) bool {
+		r.lock.Lock()
+		r.msgs = append(r.msgs, receivedMsg{agentUUID: agentUUID, data: string(data)})
+		cnt := len(r.msgs)
+		r.lock.Unlock()
+		r.notify <- struct{}{}
+		return ack(cnt)
+	}
+}
+
+func (r *msgRecorder) invalidHandler(subject string) {
+	r.lock.Lock()
+	r.invalids = append(r.invalids, subject)
+	r.lock.Unlock()
+	r.notify <- struct{}{}
+}
+
+// wait cnt 개의 메세지(잘못된 subject 포함) 수신 대기
+func (r *msgRecorder) wait(t *testing.T, cnt int) {
+	t.Helper()
+	for i := 0; i < cnt; i++ {
+		select {
+		case <-r.notify:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timeout waiting nats message, received=%d, expected=%d", i, cnt)
+		}
+	}
+}
+
+func runServer(t *testing.T) *server.Server {
+	t.Helper()
+	ns, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		NoLog:     true,
+		NoSigs:    true,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go ns.Start()
+	if !ns.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(ns.Shutdown)
+	return ns
+}
+
+func getConfig(ns *server.Server) jetstream.Config {
+	return jetstream.Config{
+		Url:          ns.ClientURL(),
+		Stream:       "CBMETRICS",
+		Subject:      subjectPrefix,
+		Consumer:     "cb-dragonfly",
+		FetchExpires: 200 * time.Millisecond,
+		AckWait:      500 * time.Millisecond,
+	}
+}
+
+// startConsume 컨슈머 실행, 종료 함수 반환 (종료 시 Consume 반환 값 확인)
+func startConsume(t *testing.T, conf jetstream.Config, handler jetstream.Handler, invalidHandler jetstream.InvalidHandler) func() {
+	t.Helper()
+	nc, err := jetstream.Connect(conf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- jetstream.Consume(ctx, nc, conf, handler, invalidHandler)
+	}()
+	return func() {
+		cancel()
+		if err := <-errCh; err != nil {
+			t.Errorf("consume error, error=%s", err)
+		}
+		nc.Close()
+	}
+}
+
+func publish(t *testing.T, ns *server.Server, subject string, data string) {
+	t.Helper()
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nc.Close()
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = js.Publish(subject, []byte(data)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getPendingCnt(t *testing.T, ns *server.Server, conf jetstream.Config) (uint64, int) {
+	t.Helper()
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nc.Close()
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := js.ConsumerInfo(conf.Stream, conf.Consumer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info.NumPending, info.NumAckPending
+}
+
+func TestGetAgentUUID(t *testing.T) {
+	testCases := []struct {
+		name       string
+		subject    string
+		expected   string
+		expectedOk bool
+	}{
+		{name: "agent subject", subject: subjectPrefix + "." + agentUUID, expected: agentUUID, expectedOk: true},
+		{name: "prefix only", subject: subjectPrefix, expectedOk: false},
+		{name: "empty agent uuid", subject: subjectPrefix + ".", expectedOk: false},
+		{name: "other prefix", subject: "othermetrics." + agentUUID, expectedOk: false},
+		{name: "nested subject", subject: subjectPrefix + "." + agentUUID + ".cpu", expectedOk: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := jetstream.GetAgentUUID(tc.subject, subjectPrefix)
+			if actual != tc.expected || ok != tc.expectedOk {
+				t.Errorf("agent uuid, expected=%s/%v, actual=%s/%v", tc.expected, tc.expectedOk, actual, ok)
+			}
+		})
+	}
+}
+
+func TestConsume(t *testing.T) {
+	ns := runServer(t)
+	conf := getConfig(ns)
+	recorder := newMsgRecorder()
+	stop := startConsume(t, conf, recorder.handler(func(int) bool { return true }), recorder.invalidHandler)
+
+	// 스트림, 컨슈머가 생성된 이후 발행
+	waitConsumer(t, ns, conf)
+	publish(t, ns, subjectPrefix+"."+agentUUID, `{"name":"cpu"}`)
+	publish(t, ns, subjectPrefix+"."+agentUUID+".cpu", `{"name":"cpu"}`)
+	recorder.wait(t, 2)
+	stop()
+
+	expected := []receivedMsg{{agentUUID: agentUUID, data: `{"name":"cpu"}`}}
+	if len(recorder.msgs) != 1 || recorder.msgs[0] != expected[0] {
+		t.Errorf("received messages, expected=%v, actual=%v", expected, recorder.msgs)
+	}
+	if len(recorder.invalids) != 1 || recorder.invalids[0] != subjectPrefix+"."+agentUUID+".cpu" {
+		t.Errorf("invalid subjects, actual=%v", recorder.invalids)
+	}
+	// 처리 완료, 잘못된 subject 메세지 모두 ACK
+	if pending, ackPending := getPendingCnt(t, ns, conf); pending != 0 || ackPending != 0 {
+		t.Errorf("pending messages, pending=%d, ack pending=%d", pending, ackPending)
+	}
+}
+
+func TestConsumeRedelivery(t *testing.T) {
+	ns := runServer(t)
+	conf := getConfig(ns)
+	recorder := newMsgRecorder()
+	// 첫 수신 시 ACK 하지 않음 (수신 버퍼 가득 참)
+	stop := startConsume(t, conf, recorder.handler(func(cnt int) bool { return cnt > 1 }), recorder.invalidHandler)
+
+	waitConsumer(t, ns, conf)
+	publish(t, ns, subjectPrefix+"."+agentUUID, `{"name":"mem"}`)
+	recorder.wait(t, 2)
+	stop()
+
+	if len(recorder.msgs) != 2 || recorder.msgs[0] != recorder.msgs[1] {
+		t.Errorf("redelivered messages, actual=%v", recorder.msgs)
+	}
+	if pending, ackPending := getPendingCnt(t, ns, conf); pending != 0 || ackPending != 0 {
+		t.Errorf("pending messages, pending=%d, ack pending=%d", pending, ackPending)
+	}
+}
+
+func TestConsumeResume(t *testing.T) {
+	ns := runServer(t)
+	conf := getConfig(ns)
+
+	// 컨슈머 중지 중 발행된 메세지는 재시작 후 durable 컨슈머로 수신
+	recorder := newMsgRecorder()
+	stop := startConsume(t, conf, recorder.handler(func(int) bool { return true }), nil)
+	waitConsumer(t, ns, conf)
+	stop()
+	publish(t, ns, subjectPrefix+"."+agentUUID, `{"name":"disk"}`)
+
+	stop = startConsume(t, conf, recorder.handler(func(int) bool { return true }), nil)
+	recorder.wait(t, 1)
+	stop()
+	if len(recorder.msgs) != 1 || recorder.msgs[0].data != `{"name":"disk"}` {
+		t.Errorf("resumed messages, actual=%v", recorder.msgs)
+	}
+}
+
+func TestConsumeConnectFail(t *testing.T) {
+	ns := runServer(t)
+	conf := getConfig(ns)
+	ns.Shutdown()
+	if _, err := jetstream.Connect(conf, nil); err == nil {
+		t.Error("expected connect error")
+	}
+}
+
+// waitConsumer durable 컨슈머 생성 대기
+func waitConsumer(t *testing.T, ns *server.Server, conf jetstream.Config) {
+	t.Helper()
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nc.Close()
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, err = js.ConsumerInfo(conf.Stream, conf.Consumer); err == nil {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("nats consumer is not created, error=%s", err)
+}
@@ -0,0 +1,83 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/ingest/jetstream"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/telegraf"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const natsRetryDelay = 5 * time.Second
+
+// StartNatsConsumer NATS JetStream 메트릭 수신 실행
+//   - 에이전트는 <nats_subject>.<agentUUID> 주제로 발행하며, 주제의 에이전트 UUID 로 메타데이터를 조회해 식별 태그를 설정합니다.
+//   - 동일 에이전트 샘플이 여러 인스턴스로 분산 집계되지 않도록 리더 인스턴스에서만 실행합니다.
+//   - 수신 버퍼가 가득 찬 경우 ACK 하지 않으며, ack_wait 이후 재전송됩니다.
+func StartNatsConsumer(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		if err := consumeNats(ctx, getJetStreamConfig(config.GetInstance().Ingestion)); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to consume nats metric, error=%s", err))
+		}
+		select {
+		case <-ctx.Done():
+			util.GetLogger().Info("stop nats ingestion consumer")
+			return
+		case <-time.After(natsRetryDelay):
+		}
+	}
+}
+
+func getJetStreamConfig(ingestionConf config.Ingestion) jetstream.Config {
+	return jetstream.Config{
+		Url:                 ingestionConf.NatsUrl,
+		Username:            ingestionConf.NatsUsername,
+		Password:            ingestionConf.NatsPassword,
+		CredentialsLocation: ingestionConf.NatsCredentialsLocation,
+		TlsCaLocation:       ingestionConf.NatsTlsCaLocation,
+		TlsCertLocation:     ingestionConf.NatsTlsCertLocation,
+		TlsKeyLocation:      ingestionConf.NatsTlsKeyLocation,
+		Stream:              ingestionConf.NatsStream,
+		Subject:             ingestionConf.NatsSubject,
+		Consumer:            ingestionConf.NatsConsumer,
+	}
+}
+
+func consumeNats(ctx context.Context, conf jetstream.Config) error {
+	nc, err := jetstream.Connect(conf, func(err error) {
+		util.GetLogger().Error(err)
+	})
+	if err != nil {
+		return err
+	}
+	defer nc.Close()
+	util.GetLogger().Info(fmt.Sprintf("start nats ingestion consumer, stream=%s, consumer=%s", conf.Stream, conf.Consumer))
+	return jetstream.Consume(ctx, nc, conf, handleNatsMsg, func(subject string) {
+		util.GetLogger().Error(fmt.Sprintf("invalid nats metric subject %s", subject))
+	})
+}
+
+// handleNatsMsg 수신 메세지 처리, ACK 여부 반환 (처리 불가 메세지는 재전송되지 않도록 ACK 처리)
+func handleNatsMsg(agentUUID string, data []byte) bool {
+	agentInfo, err := GetPushAgent(agentUUID)
+	if err != nil {
+		agentcommon.RecordDeadLetter(agentUUID, types.NatsTransport, agentcommon.UnknownAgent, err.Error(), data)
+		return true
+	}
+	metrics, err := telegraf.ParseJSON(data)
+	if err != nil {
+		agentcommon.RecordDeadLetter(agentUUID, types.NatsTransport, agentcommon.InvalidFormat, err.Error(), data)
+		return true
+	}
+	if err = GetInstance().Submit(*agentInfo, types.NatsTransport, metrics); err != nil {
+		return false
+	}
+	return true
+}
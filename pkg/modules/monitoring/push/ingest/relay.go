@@ -0,0 +1,110 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+
+	agentcommon "github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/leader"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/ingest/jetstream"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/natssec"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/telegraf"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// ErrIngestUnavailable 수신 메트릭을 집계 모듈로 전달할 수 없음 (kafka 미설정, 리더가 아닌 인스턴스에서 NATS 미사용)
+var ErrIngestUnavailable = errors.New("ingestion aggregator is not available on this instance")
+
+// KafkaConfigured kafka 설정 여부 (HTTP 수신 메트릭 kafka 전달 여부)
+func KafkaConfigured() bool {
+	return config.GetInstance().Kafka.EndpointUrl != ""
+}
+
+// Relay HTTP 수신 메트릭 집계 경로 전달
+//   - kafka 설정 시 Forwarder 로 에이전트 토픽에 전달하여 토픽 담당 콜렉터에서 집계합니다.
+//   - kafka 미설정 시 리더 인스턴스는 Ingester 에 직접 저장하고, 그 외 인스턴스는 에이전트 NATS subject 로 발행하여 리더에서 집계합니다.
+//   - 전달 큐, 수신 버퍼가 가득 찬 경우 ErrForwardQueueFull, ErrIngestBufferFull 을 반환합니다.
+func Relay(agentInfo agentcommon.AgentInfo, transport string, metrics []telegraf.Metric) error {
+	if KafkaConfigured() {
+		forwarder, err := GetForwarder()
+		if err != nil {
+			return err
+		}
+		return forwarder.Forward(agentInfo, transport, metrics)
+	}
+	if leader.IsLeader() {
+		return GetInstance().Submit(agentInfo, transport, metrics)
+	}
+	if config.GetInstance().Ingestion.NatsEnabled {
+		return publishNats(agentInfo, metrics)
+	}
+	return ErrIngestUnavailable
+}
+
+var publisherMutex sync.Mutex
+var publisherConn *nats.Conn
+var publisherJs nats.JetStreamContext
+
+// publishNats 에이전트 메트릭 NATS subject 발행 (최초 발행 시 NATS 접속, 접속 실패 시 다음 발행에서 재시도)
+//   - 리더의 NATS 수신 모듈에서 에이전트 메타데이터로 식별 태그를 다시 설정하므로, 메트릭은 수신 값 그대로 발행합니다.
+func publishNats(agentInfo agentcommon.AgentInfo, metrics []telegraf.Metric) error {
+	js, err := getPublisher()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(struct {
+		Metrics []telegraf.Metric `json:"metrics"`
+	}{Metrics: metrics})
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to marshal ingestion metric, error=%s", err))
+	}
+	subject := natssec.GetAgentSubject(config.GetInstance().Ingestion.NatsSubject, agentcommon.MakeAgentUUIDByInfo(agentInfo))
+	if _, err = js.Publish(subject, data); err != nil {
+		return errors.New(fmt.Sprintf("failed to publish ingestion metric, error=%s", err))
+	}
+	return nil
+}
+
+func getPublisher() (nats.JetStreamContext, error) {
+	publisherMutex.Lock()
+	defer publisherMutex.Unlock()
+
+	if publisherJs != nil {
+		return publisherJs, nil
+	}
+	nc, err := jetstream.Connect(getJetStreamConfig(config.GetInstance().Ingestion), func(err error) {
+		util.GetLogger().Error(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	js, err := nc.JetStream()
+	if err != nil {
+		nc.Close()
+		return nil, errors.New(fmt.Sprintf("failed to get nats jetstream context, error=%s", err))
+	}
+	publisherConn, publisherJs = nc, js
+	return publisherJs, nil
+}
+
+// ClosePublisher 종료 전 NATS 발행 연결 종료 (발행 이력이 없을 경우 무시)
+func ClosePublisher(ctx context.Context) error {
+	publisherMutex.Lock()
+	defer publisherMutex.Unlock()
+
+	if publisherConn == nil {
+		return nil
+	}
+	if err := publisherConn.FlushWithContext(ctx); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to flush ingestion nats publisher, error=%s", err))
+	}
+	publisherConn.Close()
+	publisherConn, publisherJs = nil, nil
+	return nil
+}
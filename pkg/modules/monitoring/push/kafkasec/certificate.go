@@ -0,0 +1,95 @@
+package kafkasec
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+)
+
+// agentCertValidity 에이전트 클라이언트 인증서 유효기간
+const agentCertValidity = 365 * 24 * time.Hour
+
+// IssueAgentCertificate 에이전트 클라이언트 인증서 발급 (CN: 에이전트 UUID)
+//   - 에이전트 CA(agent_ca_cert_location, agent_ca_key_location) 로 서명하며, 브로커는 에이전트 CA 를 신뢰하도록 설정되어 있어야 합니다.
+//   - PEM 형식의 인증서, 키를 반환합니다.
+func IssueAgentCertificate(kafkaConf config.Kafka, agentUUID string) (string, string, error) {
+	caCert, caKey, err := loadAgentCA(kafkaConf)
+	if err != nil {
+		return "", "", err
+	}
+
+	agentKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", errors.New(fmt.Sprintf("failed to generate agent key, error=%s", err))
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", errors.New(fmt.Sprintf("failed to generate certificate serial number, error=%s", err))
+	}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: agentUUID},
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     now.Add(agentCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, caCert, &agentKey.PublicKey, caKey)
+	if err != nil {
+		return "", "", errors.New(fmt.Sprintf("failed to create agent certificate, error=%s", err))
+	}
+	keyDER, err := x509.MarshalECPrivateKey(agentKey)
+	if err != nil {
+		return "", "", errors.New(fmt.Sprintf("failed to marshal agent key, error=%s", err))
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM), nil
+}
+
+func loadAgentCA(kafkaConf config.Kafka) (*x509.Certificate, interface{}, error) {
+	certBytes, err := ioutil.ReadFile(kafkaConf.AgentCaCertLocation)
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprintf("failed to read agent ca certificate, error=%s", err))
+	}
+	certBlock, _ := pem.Decode(certBytes)
+	if certBlock == nil {
+		return nil, nil, errors.New("failed to decode agent ca certificate")
+	}
+	caCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprintf("failed to parse agent ca certificate, error=%s", err))
+	}
+
+	keyBytes, err := ioutil.ReadFile(kafkaConf.AgentCaKeyLocation)
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprintf("failed to read agent ca key, error=%s", err))
+	}
+	keyBlock, _ := pem.Decode(keyBytes)
+	if keyBlock == nil {
+		return nil, nil, errors.New("failed to decode agent ca key")
+	}
+	// PKCS#8, PKCS#1(RSA), SEC1(EC) 형식 순서로 확인
+	if caKey, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes); err == nil {
+		return caCert, caKey, nil
+	}
+	if caKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes); err == nil {
+		return caCert, caKey, nil
+	}
+	caKey, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprintf("failed to parse agent ca key, error=%s", err))
+	}
+	return caCert, caKey, nil
+}
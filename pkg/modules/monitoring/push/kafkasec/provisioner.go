@@ -0,0 +1,115 @@
+package kafkasec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// kafka 관리 CLI
+//   - kafka 2.4 브로커는 SCRAM 계정 관리 API(KIP-554)를 제공하지 않으므로 zookeeper 기반 CLI 로 SCRAM 계정, ACL 을 등록합니다.
+const (
+	configsCommand = "kafka-configs.sh"
+	aclsCommand    = "kafka-acls.sh"
+)
+
+// GetAgentPrincipal 에이전트 kafka principal (SASL: 에이전트 UUID 계정, SSL: 클라이언트 인증서 DN)
+func GetAgentPrincipal(kafkaConf config.Kafka, agentUUID string) string {
+	if IsMutualTLSEnabled(kafkaConf) {
+		return fmt.Sprintf("User:CN=%s", agentUUID)
+	}
+	return fmt.Sprintf("User:%s", agentUUID)
+}
+
+// CreateScramUser 에이전트 SCRAM 계정 생성 (기존 계정이 있을 경우 비밀번호 변경)
+//   - 비밀번호가 프로세스 목록에 노출되지 않도록 CLI 인자 대신 권한이 0600 인 임시 설정 파일(--add-config-file)로 전달합니다. (kafka CLI 2.6 이상)
+func CreateScramUser(kafkaConf config.Kafka, username string, password string) error {
+	configFile, err := os.CreateTemp("", "cb-dragonfly-scram-*.properties")
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to create scram config file, error=%s", err))
+	}
+	defer os.Remove(configFile.Name())
+
+	// SCRAM 자격증명 설정 (properties 형식, CreateTemp 파일 권한 0600)
+	scramConfig := fmt.Sprintf("%s=password=%s\n", strings.ToUpper(kafkaConf.SaslMechanism), escapeProperty(password))
+	if _, err = configFile.WriteString(scramConfig); err != nil {
+		configFile.Close()
+		return errors.New(fmt.Sprintf("failed to write scram config file, error=%s", err))
+	}
+	if err = configFile.Close(); err != nil {
+		return errors.New(fmt.Sprintf("failed to write scram config file, error=%s", err))
+	}
+	return runAdminCommand(kafkaConf, configsCommand,
+		"--zookeeper", kafkaConf.ZookeeperConnect,
+		"--alter", "--add-config-file", configFile.Name(),
+		"--entity-type", "users", "--entity-name", username,
+	)
+}
+
+// escapeProperty java properties 파일 값 이스케이프
+func escapeProperty(val string) string {
+	replacer := strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\r", "\\r", "\t", "\\t", "\f", "\\f")
+	return replacer.Replace(val)
+}
+
+// DeleteScramUser 에이전트 SCRAM 계정 삭제
+func DeleteScramUser(kafkaConf config.Kafka, username string) error {
+	return runAdminCommand(kafkaConf, configsCommand,
+		"--zookeeper", kafkaConf.ZookeeperConnect,
+		"--alter", "--delete-config", strings.ToUpper(kafkaConf.SaslMechanism),
+		"--entity-type", "users", "--entity-name", username,
+	)
+}
+
+// AddProducerACL 에이전트 토픽 쓰기 권한 등록 (에이전트는 자신의 토픽에만 메세지 전송 가능)
+func AddProducerACL(kafkaConf config.Kafka, principal string, topic string) error {
+	if !kafkaConf.AclEnabled {
+		return nil
+	}
+	return runAdminCommand(kafkaConf, aclsCommand,
+		"--authorizer-properties", fmt.Sprintf("zookeeper.connect=%s", kafkaConf.ZookeeperConnect),
+		"--add", "--allow-principal", principal,
+		"--producer", "--topic", topic,
+	)
+}
+
+// RemoveProducerACL 에이전트 토픽 쓰기 권한 삭제
+func RemoveProducerACL(kafkaConf config.Kafka, principal string, topic string) error {
+	if !kafkaConf.AclEnabled {
+		return nil
+	}
+	return runAdminCommand(kafkaConf, aclsCommand,
+		"--authorizer-properties", fmt.Sprintf("zookeeper.connect=%s", kafkaConf.ZookeeperConnect),
+		"--remove", "--force", "--allow-principal", principal,
+		"--producer", "--topic", topic,
+	)
+}
+
+func runAdminCommand(kafkaConf config.Kafka, command string, args ...string) error {
+	if kafkaConf.ZookeeperConnect == "" {
+		return errors.New("empty zookeeper address for kafka admin command")
+	}
+	commandPath := filepath.Join(kafkaConf.AdminBinPath, command)
+	output, err := exec.Command(commandPath, args...).CombinedOutput()
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to run kafka admin command, command=%s %s, output=%s", commandPath, quote(args), string(output)))
+		return errors.New(fmt.Sprintf("failed to run %s, error=%s", command, err))
+	}
+	return nil
+}
+
+// quote CLI 인자 표시용
+func quote(args []string) string {
+	quoted := make([]string, len(args))
+	for idx, arg := range args {
+		quoted[idx] = strconv.Quote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
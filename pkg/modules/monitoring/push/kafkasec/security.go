@@ -0,0 +1,231 @@
+package kafkasec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	apiv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// kafka 접속 보안 프로토콜
+const (
+	Plaintext     = "PLAINTEXT"
+	SaslPlaintext = "SASL_PLAINTEXT"
+	SaslSsl       = "SASL_SSL"
+	Ssl           = "SSL"
+)
+
+// SASL 인증 방식
+const (
+	ScramSha256 = "SCRAM-SHA-256"
+	ScramSha512 = "SCRAM-SHA-512"
+)
+
+// GetSecurityProtocol 보안 프로토콜 조회 (미설정 시 PLAINTEXT)
+func GetSecurityProtocol(kafkaConf config.Kafka) string {
+	if kafkaConf.SecurityProtocol == "" {
+		return Plaintext
+	}
+	return strings.ToUpper(kafkaConf.SecurityProtocol)
+}
+
+// IsSaslEnabled SASL/SCRAM 인증 사용 여부 (에이전트 별 SCRAM 계정 발급)
+func IsSaslEnabled(kafkaConf config.Kafka) bool {
+	protocol := GetSecurityProtocol(kafkaConf)
+	return protocol == SaslPlaintext || protocol == SaslSsl
+}
+
+// IsTLSEnabled TLS 암호화 사용 여부
+func IsTLSEnabled(kafkaConf config.Kafka) bool {
+	protocol := GetSecurityProtocol(kafkaConf)
+	return protocol == SaslSsl || protocol == Ssl
+}
+
+// IsMutualTLSEnabled mTLS 인증 사용 여부 (에이전트 별 클라이언트 인증서 발급)
+func IsMutualTLSEnabled(kafkaConf config.Kafka) bool {
+	return GetSecurityProtocol(kafkaConf) == Ssl
+}
+
+// IsSecurityEnabled 에이전트 별 자격증명 발급이 필요한 보안 설정 여부
+func IsSecurityEnabled(kafkaConf config.Kafka) bool {
+	return IsSaslEnabled(kafkaConf) || IsMutualTLSEnabled(kafkaConf)
+}
+
+// Validate kafka 클라이언트(콜렉터, 관리 클라이언트) 보안 설정 값 체크
+func Validate(kafkaConf config.Kafka) error {
+	switch GetSecurityProtocol(kafkaConf) {
+	case Plaintext:
+		return nil
+	case SaslPlaintext, SaslSsl:
+		mechanism := strings.ToUpper(kafkaConf.SaslMechanism)
+		if mechanism != ScramSha256 && mechanism != ScramSha512 {
+			return errors.New(fmt.Sprintf("unsupported sasl mechanism %s, mechanism must be %s or %s", kafkaConf.SaslMechanism, ScramSha256, ScramSha512))
+		}
+		if kafkaConf.SaslUsername == "" || kafkaConf.SaslPassword == "" {
+			return errors.New("empty sasl username or password for collector")
+		}
+	case Ssl:
+		if kafkaConf.SslCertificateLocation == "" || kafkaConf.SslKeyLocation == "" {
+			return errors.New("empty ssl certificate or key location for collector")
+		}
+	default:
+		return errors.New(fmt.Sprintf("unsupported security protocol %s", kafkaConf.SecurityProtocol))
+	}
+	return nil
+}
+
+// ValidateAgentCA 에이전트 클라이언트 인증서 발급용 CA 설정 값 체크 (mTLS 사용 시, 드래곤플라이 서버 전용)
+//   - deployment 콜렉터에는 에이전트 CA 가 전달되지 않으므로 콜렉터 설정 체크(Validate)와 분리합니다.
+func ValidateAgentCA(kafkaConf config.Kafka) error {
+	if !IsMutualTLSEnabled(kafkaConf) {
+		return nil
+	}
+	if kafkaConf.AgentCaCertLocation == "" || kafkaConf.AgentCaKeyLocation == "" {
+		return errors.New("empty agent ca certificate or key location")
+	}
+	return nil
+}
+
+// ValidateTopicMode 에이전트 별 자격증명 사용 시 콜렉터 토픽 구성 체크
+//   - 토픽 쓰기 권한(ACL)은 토픽 단위로 등록되므로, 공용 토픽 방식에서는 에이전트가 다른 에이전트의 메트릭을 전송할 수 있습니다.
+//   - 보안 설정이 활성화된 경우 컨슈머 그룹 공용 토픽 방식을 허용하지 않습니다.
+func ValidateTopicMode(kafkaConf config.Kafka, monConfig config.Monitoring) error {
+	if !IsSecurityEnabled(kafkaConf) {
+		return nil
+	}
+	if strings.EqualFold(monConfig.CollectorMode, types.ConsumerGroupCollectorMode) && strings.EqualFold(monConfig.CollectorTopicMode, types.SharedTopicMode) {
+		return errors.New(fmt.Sprintf("collector_topic_mode %s is not allowed with kafka security protocol %s, use %s topic mode", types.SharedTopicMode, GetSecurityProtocol(kafkaConf), types.AgentTopicMode))
+	}
+	return nil
+}
+
+// ApplySecurityConfig kafka 클라이언트(콜렉터, 관리 클라이언트) 설정에 보안 설정 적용
+func ApplySecurityConfig(kafkaConfig *kafka.ConfigMap, kafkaConf config.Kafka) error {
+	if err := Validate(kafkaConf); err != nil {
+		return err
+	}
+	protocol := GetSecurityProtocol(kafkaConf)
+	if protocol == Plaintext {
+		return nil
+	}
+	securityConfig := kafka.ConfigMap{
+		"security.protocol": strings.ToLower(protocol),
+	}
+	if IsSaslEnabled(kafkaConf) {
+		securityConfig["sasl.mechanisms"] = strings.ToUpper(kafkaConf.SaslMechanism)
+		securityConfig["sasl.username"] = kafkaConf.SaslUsername
+		securityConfig["sasl.password"] = kafkaConf.SaslPassword
+	}
+	if IsTLSEnabled(kafkaConf) && kafkaConf.SslCaLocation != "" {
+		securityConfig["ssl.ca.location"] = kafkaConf.SslCaLocation
+	}
+	if IsMutualTLSEnabled(kafkaConf) {
+		securityConfig["ssl.certificate.location"] = kafkaConf.SslCertificateLocation
+		securityConfig["ssl.key.location"] = kafkaConf.SslKeyLocation
+		if kafkaConf.SslKeyPassword != "" {
+			securityConfig["ssl.key.password"] = kafkaConf.SslKeyPassword
+		}
+	}
+	for key, val := range securityConfig {
+		if err := kafkaConfig.SetKey(key, val); err != nil {
+			return errors.New(fmt.Sprintf("failed to set kafka security config %s, error=%s", key, err))
+		}
+	}
+	return nil
+}
+
+/** ### Deployment Collector Env Start ### */
+
+// deployment 콜렉터 kafka 보안 설정 환경변수
+const (
+	envSecurityProtocol       = "kafka_security_protocol"
+	envSaslMechanism          = "kafka_sasl_mechanism"
+	envSaslUsername           = "kafka_sasl_username"
+	envSaslPassword           = "kafka_sasl_password"
+	envSslCaLocation          = "kafka_ssl_ca_location"
+	envSslCertificateLocation = "kafka_ssl_certificate_location"
+	envSslKeyLocation         = "kafka_ssl_key_location"
+	envSslKeyPassword         = "kafka_ssl_key_password"
+)
+
+// kafka 보안 설정 Secret 키
+const (
+	secretSaslPassword   = "sasl_password"
+	secretSslKeyPassword = "ssl_key_password"
+)
+
+// ApplySecuritySecret deployment 콜렉터에 전달할 kafka 비밀번호를 Secret 으로 배포 (기존 Secret 이 있을 경우 갱신)
+func ApplySecuritySecret(clientSet kubernetes.Interface, namespace string, kafkaConf config.Kafka) error {
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: types.KafkaSecretName},
+		Type:       apiv1.SecretTypeOpaque,
+		StringData: map[string]string{
+			secretSaslPassword:   kafkaConf.SaslPassword,
+			secretSslKeyPassword: kafkaConf.SslKeyPassword,
+		},
+	}
+	secretsClient := clientSet.CoreV1().Secrets(namespace)
+	_, err := secretsClient.Get(context.TODO(), types.KafkaSecretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = secretsClient.Create(context.TODO(), secret, metav1.CreateOptions{})
+	} else if err == nil {
+		_, err = secretsClient.Update(context.TODO(), secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to apply kafka security secret, error=%s", err))
+	}
+	return nil
+}
+
+// GetSecurityEnv deployment 콜렉터에 전달할 kafka 보안 설정 환경변수
+//   - 인증서, 키 파일은 콜렉터 파드에 동일한 경로로 마운트되어 있어야 합니다.
+//   - 비밀번호는 평문 환경변수 대신 Secret(ApplySecuritySecret) 참조로 전달합니다.
+func GetSecurityEnv(kafkaConf config.Kafka) []apiv1.EnvVar {
+	return []apiv1.EnvVar{
+		{Name: envSecurityProtocol, Value: kafkaConf.SecurityProtocol},
+		{Name: envSaslMechanism, Value: kafkaConf.SaslMechanism},
+		{Name: envSaslUsername, Value: kafkaConf.SaslUsername},
+		{Name: envSaslPassword, ValueFrom: getSecretEnvSource(secretSaslPassword)},
+		{Name: envSslCaLocation, Value: kafkaConf.SslCaLocation},
+		{Name: envSslCertificateLocation, Value: kafkaConf.SslCertificateLocation},
+		{Name: envSslKeyLocation, Value: kafkaConf.SslKeyLocation},
+		{Name: envSslKeyPassword, ValueFrom: getSecretEnvSource(secretSslKeyPassword)},
+	}
+}
+
+func getSecretEnvSource(key string) *apiv1.EnvVarSource {
+	optional := true
+	return &apiv1.EnvVarSource{
+		SecretKeyRef: &apiv1.SecretKeySelector{
+			LocalObjectReference: apiv1.LocalObjectReference{Name: types.KafkaSecretName},
+			Key:                  key,
+			Optional:             &optional,
+		},
+	}
+}
+
+// GetSecurityConfigFromEnv deployment 콜렉터 환경변수 기반 kafka 보안 설정 조회
+func GetSecurityConfigFromEnv() config.Kafka {
+	return config.Kafka{
+		SecurityProtocol:       os.Getenv(envSecurityProtocol),
+		SaslMechanism:          os.Getenv(envSaslMechanism),
+		SaslUsername:           os.Getenv(envSaslUsername),
+		SaslPassword:           os.Getenv(envSaslPassword),
+		SslCaLocation:          os.Getenv(envSslCaLocation),
+		SslCertificateLocation: os.Getenv(envSslCertificateLocation),
+		SslKeyLocation:         os.Getenv(envSslKeyLocation),
+		SslKeyPassword:         os.Getenv(envSslKeyPassword),
+	}
+}
+
+/** ### Deployment Collector Env End ### */
@@ -0,0 +1,33 @@
+package agentfilter
+
+// Agent 샘플 집계 여부 판단을 위한 에이전트 메타데이터 정보
+type Agent struct {
+	Disabled bool // 에이전트 비활성화 (토픽 삭제) 여부
+	Pull     bool // PULL 수집 방식 에이전트 여부
+}
+
+// Lookup 에이전트 메타데이터 조회 (메타데이터가 없을 경우 nil, nil 반환)
+type Lookup func(agentUUID string) (*Agent, error)
+
+// Filter 집계 대상이 아닌 에이전트 샘플 제외
+//   - 메타데이터가 삭제된 에이전트, 비활성화 에이전트, PULL 에이전트 샘플을 제외합니다.
+//   - 메타데이터 조회에 실패한 경우 일시적인 저장소 장애로 수집 데이터가 유실되지 않도록 샘플을 유지합니다.
+//   - 에이전트 별 메타데이터는 한 번만 조회합니다.
+func Filter[T any](samples []T, agentUUID func(T) string, lookup Lookup) []T {
+	excluded := map[string]bool{}
+	filtered := samples[:0]
+	for _, sample := range samples {
+		uuid := agentUUID(sample)
+		exclude, ok := excluded[uuid]
+		if !ok {
+			agent, err := lookup(uuid)
+			exclude = err == nil && (agent == nil || agent.Disabled || agent.Pull)
+			excluded[uuid] = exclude
+		}
+		if exclude {
+			continue
+		}
+		filtered = append(filtered, sample)
+	}
+	return filtered
+}
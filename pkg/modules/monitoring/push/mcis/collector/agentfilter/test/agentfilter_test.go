@@ -0,0 +1,61 @@
+package test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector/agentfilter"
+)
+
+type sample struct {
+	agentUUID string
+	value     int
+}
+
+func sampleUUID(s sample) string {
+	return s.agentUUID
+}
+
+func TestFilter(t *testing.T) {
+	agents := map[string]*agentfilter.Agent{
+		"push":     {},
+		"disabled": {Disabled: true},
+		"pull":     {Pull: true},
+	}
+	lookupCnt := map[string]int{}
+	lookup := func(agentUUID string) (*agentfilter.Agent, error) {
+		lookupCnt[agentUUID]++
+		if agentUUID == "store-error" {
+			return nil, errors.New("store unavailable")
+		}
+		return agents[agentUUID], nil
+	}
+
+	testCases := []struct {
+		name     string
+		samples  []sample
+		expected []sample
+	}{
+		{name: "push agent", samples: []sample{{"push", 1}, {"push", 2}}, expected: []sample{{"push", 1}, {"push", 2}}},
+		{name: "deleted agent", samples: []sample{{"deleted", 1}, {"push", 2}}, expected: []sample{{"push", 2}}},
+		{name: "disabled agent", samples: []sample{{"disabled", 1}}, expected: []sample{}},
+		{name: "pull agent", samples: []sample{{"pull", 1}, {"push", 2}, {"pull", 3}}, expected: []sample{{"push", 2}}},
+		{name: "metadata lookup error", samples: []sample{{"store-error", 1}}, expected: []sample{{"store-error", 1}}},
+		{name: "empty", samples: []sample{}, expected: []sample{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lookupCnt = map[string]int{}
+			filtered := agentfilter.Filter(tc.samples, sampleUUID, lookup)
+			if !reflect.DeepEqual(filtered, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, filtered)
+			}
+			for agentUUID, cnt := range lookupCnt {
+				if cnt != 1 {
+					t.Errorf("expected agent %s metadata to be looked up once, got %d", agentUUID, cnt)
+				}
+			}
+		})
+	}
+}
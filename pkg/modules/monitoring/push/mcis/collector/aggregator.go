@@ -9,6 +9,9 @@ import (
 	"time"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector/agentfilter"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector/window"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/telegraf"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
@@ -18,25 +21,53 @@ import (
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 )
 
-type TelegrafMetric struct {
-	Name      string                 `json:"name"`
-	Tags      map[string]interface{} `json:"tags"`
-	Fields    map[string]interface{} `json:"fields"`
-	Timestamp int64                  `json:"timestamp"`
-	TagInfo   map[string]interface{} `json:"tagInfo"`
+type TelegrafMetric = telegraf.Metric
+
+// AgentSample 에이전트 수집 샘플 (kafka, HTTP, NATS 수신 공통)
+type AgentSample struct {
+	AgentUUID  string
+	Transport  string // 수신 방식 (kafka, http, nats)
+	Metric     TelegrafMetric
+	ReceivedAt int64 // 수신 시각 (unix), telegraf timestamp 가 없을 경우 이벤트 시각으로 사용
 }
 
 type Aggregator struct {
-	AggregateType types.AggregateType
+	AggregateType   types.AggregateType
+	WindowInterval  int // 집계 윈도우 크기 (s), 0 일 경우 MCIS 콜렉터 집계 주기 사용
+	AllowedLateness int // 지연 샘플 허용 시간 (s), 0 일 경우 모니터링 설정 값 사용
+
+	windowState *window.State
+	deadLetters *agentmetadata.DeadLetterBuffer // 집계 주기 내 거부 메세지
 }
 
+// getWindowConfig 집계 윈도우 크기, 허용 지연 시간 조회 (Aggregator 설정 값 > 모니터링 설정 값)
+func (a *Aggregator) getWindowConfig() (int64, int64) {
+	windowInterval := a.WindowInterval
+	if windowInterval <= 0 {
+		windowInterval = config.GetInstance().Monitoring.MCISCollectorInterval
+	}
+	if windowInterval <= 0 {
+		windowInterval = 1
+	}
+	allowedLateness := a.AllowedLateness
+	if allowedLateness <= 0 {
+		allowedLateness = config.GetInstance().Monitoring.AllowedLateness
+	}
+	if allowedLateness < 0 {
+		allowedLateness = 0
+	}
+	return int64(windowInterval), int64(allowedLateness)
+}
+
+// AggregateMetric kafka 메세지를 이벤트 시각(telegraf timestamp) 기준 윈도우로 집계하여 저장
+//   - 지연 도착한 샘플이 열린 윈도우에 추가되면 해당 윈도우의 집계 point 를 갱신합니다.
+//   - 워터마크가 지나 닫힌 윈도우의 샘플은 폐기합니다.
 func (a *Aggregator) AggregateMetric(kafkaConn *kafka.Consumer, topics []string) ([]string, error) {
 
 	currentTime := time.Now().Unix()
 	stayConnCount := 0
 
-	var msgSlice [][]byte
-	var msgTopic []string
+	var samples []AgentSample
 	for {
 		stayConnCount += 1
 		msg, err := kafkaConn.ReadMessage(1 * time.Second)
@@ -45,8 +76,18 @@ func (a *Aggregator) AggregateMetric(kafkaConn *kafka.Consumer, topics []string)
 		}
 		if msg != nil {
 			msgTime := msg.Timestamp.Unix()
-			msgSlice = append(msgSlice, msg.Value)
-			msgTopic = append(msgTopic, *msg.TopicPartition.Topic)
+			response := TelegrafMetric{}
+			// 파싱할 수 없는 메세지는 집계에서 제외하고 거부 메세지로 기록
+			if err := json.Unmarshal(msg.Value, &response); err != nil {
+				a.getDeadLetters().Add(getAgentUUID(msg), getTransport(msg), agentmetadata.InvalidFormat, err.Error(), msg.Value)
+			} else {
+				samples = append(samples, AgentSample{
+					AgentUUID:  getAgentUUID(msg),
+					Transport:  getTransport(msg),
+					Metric:     response,
+					ReceivedAt: msgTime,
+				})
+			}
 			if msgTime > currentTime {
 				break
 			}
@@ -57,58 +98,82 @@ func (a *Aggregator) AggregateMetric(kafkaConn *kafka.Consumer, topics []string)
 			break
 		}
 	}
-	fmt.Println(fmt.Sprintf("%v : %d\n", topics, len(msgSlice)))
+	fmt.Println(fmt.Sprintf("%v : %d\n", topics, len(samples)))
 
-	tagInfo := make(map[string]map[string]string)
-	if len(msgSlice) != 0 {
-		uniqueResponseSlice := make(map[string]map[string]map[string][]float64)
-		for idx, value := range msgSlice {
-			vmTopic := msgTopic[idx]
+	return a.AggregateSamples(samples, topics), nil
+}
 
-			response := TelegrafMetric{}
-			_ = json.Unmarshal(value, &response)
+// AggregateSamples 에이전트 샘플을 이벤트 시각 기준 윈도우로 집계하여 저장 (kafka, HTTP, NATS 수신 공통)
+//   - topics 가 nil 일 경우 샘플이 수신된 에이전트 기준으로 헬스상태를 갱신합니다.
+//   - 샘플이 수신된 에이전트 UUID 목록을 반환합니다.
+func (a *Aggregator) AggregateSamples(samples []AgentSample, topics []string) []string {
+	// 메타데이터 삭제, 토픽 삭제(비활성화) 또는 PULL 수집 방식으로 변경된 에이전트 샘플은 집계에서 제외
+	agentCache := map[string]*agentmetadata.AgentInfo{}
+	samples = filterExcludedSamples(samples, agentCache)
 
-			if _, ok := tagInfo[vmTopic]; ok {
-				for key, tag := range response.Tags {
-					if key == types.NsId || key == types.McisId || key == types.VmId || key == types.OsType || key == types.CspType {
-						tagInfo[vmTopic][key] = tag.(string)
-					}
-				}
-			} else {
-				tagInfo[vmTopic] = make(map[string]string)
-				for key, tag := range response.Tags {
-					if key == types.NsId || key == types.McisId || key == types.VmId || key == types.OsType || key == types.CspType {
-						tagInfo[vmTopic][key] = tag.(string)
-					}
-				}
+	var msgTopic []string
+	for _, sample := range samples {
+		msgTopic = append(msgTopic, sample.AgentUUID)
+	}
+
+	// 컨슈머 그룹 방식, HTTP, NATS 수신의 경우 분배받은 토픽을 알 수 없으므로, 메세지가 수신된 에이전트 기준으로 처리
+	if topics == nil {
+		topics = util.Unique(msgTopic, true)
+	}
+
+	windowInterval, allowedLateness := a.getWindowConfig()
+	if a.windowState == nil {
+		a.windowState = window.NewState(windowInterval, allowedLateness)
+	}
+	a.windowState.WindowInterval, a.windowState.AllowedLateness = windowInterval, allowedLateness
+
+	if len(samples) != 0 {
+		// raw 모드 설정 대상 조회 (조회 실패 시 원본 샘플 저장 생략)
+		rawModeFilter, err := agentmetadata.GetRawModeFilter()
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to get raw mode filter, error=%s", err))
+		}
+
+		droppedCnt := 0
+		for _, sample := range samples {
+			response := sample.Metric
+
+			// 메트릭 이름, 에이전트 식별 태그 검증 실패 시 거부 메세지로 기록
+			if reason, detail := validateSample(sample, agentCache); reason != "" {
+				payload, _ := json.Marshal(response)
+				a.getDeadLetters().Add(sample.AgentUUID, sample.Transport, reason, detail, payload)
+				continue
 			}
 
-			if _, ok := uniqueResponseSlice[vmTopic]; ok {
-				if _, ok := uniqueResponseSlice[vmTopic][response.Name]; ok {
-					for metricName, val := range response.Fields {
-						uniqueResponseSlice[vmTopic][response.Name][metricName] = append(uniqueResponseSlice[vmTopic][response.Name][metricName], val.(float64))
-					}
-				} else {
-					uniqueResponseSlice[vmTopic][response.Name] = make(map[string][]float64)
-					for metricName, val := range response.Fields {
-						uniqueResponseSlice[vmTopic][response.Name][metricName] = append(uniqueResponseSlice[vmTopic][response.Name][metricName], val.(float64))
-					}
-				}
-			} else {
-				uniqueResponseSlice[vmTopic] = make(map[string]map[string][]float64)
-				uniqueResponseSlice[vmTopic][response.Name] = make(map[string][]float64)
-				for metricName, val := range response.Fields {
-					uniqueResponseSlice[vmTopic][response.Name][metricName] = append(uniqueResponseSlice[vmTopic][response.Name][metricName], val.(float64))
-				}
+			// 이벤트 시각은 telegraf timestamp 기준 (timestamp 가 없을 경우 메세지 수신 시각 사용)
+			eventTime := response.Timestamp
+			if eventTime == 0 {
+				eventTime = sample.ReceivedAt
+			}
+			if !a.windowState.AddSample(sample.AgentUUID, eventTime, time.Now(), response.Name, response.Tags, response.Fields) {
+				droppedCnt++
+			}
+
+			// raw 모드 대상 에이전트의 경우 원본 샘플 저장 (집계 메트릭과 동일한 필드 이름 사용)
+			nsId, _ := response.Tags[types.NsId].(string)
+			mcisId, _ := response.Tags[types.McisId].(string)
+			if rawModeFilter.IsEnabled(nsId, mcisId) {
+				writeRawSample(response, eventTime)
 			}
 		}
-		result, err := a.CalculateMetric(uniqueResponseSlice, tagInfo, a.AggregateType.ToString())
-		if err != nil {
-			util.GetLogger().Error(err)
+
+		responseMap, tagMap, rewriteCnt := a.windowState.PopUpdatedWindows()
+		for windowStart, uniqueResponseSlice := range responseMap {
+			result, err := a.CalculateMetric(uniqueResponseSlice, tagMap[windowStart], a.AggregateType.ToString())
+			if err != nil {
+				util.GetLogger().Error(err)
+				continue
+			}
+			// 비동기 쓰기 파이프라인에 윈도우 시작 시각 기준으로 저장 요청 (지연 샘플 반영 시 기존 point 갱신)
+			v1.GetWritePipeline().WriteMetricAt(v1.DefaultDatabase, result, time.Unix(windowStart, 0).UTC())
 		}
-		err = v1.GetInstance().WriteMetric(v1.DefaultDatabase, result)
-		if err != nil {
-			return []string{}, err
+		if rewriteCnt > 0 || droppedCnt > 0 {
+			fmt.Printf("[%s] <MCIS> late samples => updated windows: %d, dropped samples: %d\n", time.Now().Format(time.RFC3339), rewriteCnt, droppedCnt)
 		}
 
 		for _, topic := range topics {
@@ -142,8 +207,126 @@ func (a *Aggregator) AggregateMetric(kafkaConn *kafka.Consumer, topics []string)
 		}
 	}
 
+	// 워터마크가 지난 윈도우 제거
+	a.windowState.EvictClosedWindows(time.Now())
+
+	// 거부 메세지 기록
+	a.getDeadLetters().Flush()
+
 	currentTopics := util.Unique(msgTopic, true)
-	return currentTopics, nil
+
+	// 메세지가 수신된 토픽(에이전트) 하트비트 갱신
+	for _, topic := range currentTopics {
+		if err := agentmetadata.UpdateAgentLastSeen(topic, time.Now()); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to update agent last seen with UUID %s, error=%s", topic, err))
+		}
+	}
+	return currentTopics
+}
+
+func (a *Aggregator) getDeadLetters() *agentmetadata.DeadLetterBuffer {
+	if a.deadLetters == nil {
+		a.deadLetters = &agentmetadata.DeadLetterBuffer{}
+	}
+	return a.deadLetters
+}
+
+// filterExcludedSamples 메타데이터가 삭제된 에이전트, 비활성화 에이전트, PULL 에이전트 샘플 제외
+func filterExcludedSamples(samples []AgentSample, agentCache map[string]*agentmetadata.AgentInfo) []AgentSample {
+	return agentfilter.Filter(samples, func(sample AgentSample) string {
+		return sample.AgentUUID
+	}, func(agentUUID string) (*agentfilter.Agent, error) {
+		agentInfo, err := agentmetadata.FindAgentByUUID(agentUUID)
+		if err != nil {
+			util.GetLogger().Warn(fmt.Sprintf("failed to get agent metadata with UUID %s, error=%s", agentUUID, err))
+			return nil, err
+		}
+		agentCache[agentUUID] = agentInfo
+		if agentInfo == nil {
+			return nil, nil
+		}
+		return &agentfilter.Agent{
+			Disabled: agentInfo.AgentState == string(agentmetadata.Disable),
+			Pull:     agentInfo.AgentType == types.PullPolicy,
+		}, nil
+	})
+}
+
+// validateSample 에이전트 샘플 검증, 거부 사유 반환 (정상 샘플일 경우 빈 값 반환)
+//   - 에이전트 메타데이터가 있을 경우 식별 태그(nsId, mcisId, vmId)가 메타데이터와 일치하는지 확인합니다.
+//   - 에이전트 메타데이터 조회 결과는 집계 주기 내에서 재사용합니다.
+func validateSample(sample AgentSample, agentCache map[string]*agentmetadata.AgentInfo) (agentmetadata.RejectReason, string) {
+	metric := sample.Metric
+	if metric.Name == "" {
+		return agentmetadata.InvalidFormat, "empty metric name"
+	}
+	if !agentmetadata.IsKnownMeasurement(metric.Name) {
+		return agentmetadata.UnknownMeasurement, fmt.Sprintf("unknown measurement %s", metric.Name)
+	}
+
+	tags := map[string]string{}
+	for _, key := range []string{types.NsId, types.McisId, types.VmId} {
+		tagStr, ok := metric.Tags[key].(string)
+		if !ok || tagStr == "" {
+			return agentmetadata.InvalidTag, fmt.Sprintf("missing %s tag", key)
+		}
+		tags[key] = tagStr
+	}
+
+	agentInfo, ok := agentCache[sample.AgentUUID]
+	if !ok {
+		agentInfo, _ = agentmetadata.GetAgentByUUID(sample.AgentUUID)
+		agentCache[sample.AgentUUID] = agentInfo
+	}
+	if agentInfo == nil {
+		return "", ""
+	}
+	if tags[types.NsId] != agentInfo.NsId || tags[types.McisId] != agentInfo.McisId || tags[types.VmId] != agentInfo.VmId {
+		return agentmetadata.InvalidTag, fmt.Sprintf("tag mismatch with agent metadata, nsId=%s, mcisId=%s, vmId=%s", tags[types.NsId], tags[types.McisId], tags[types.VmId])
+	}
+	return "", ""
+}
+
+// writeRawSample telegraf 원본 샘플을 raw 데이터베이스에 수집 시각 기준으로 저장
+//   - telegraf 태그(cpu, interface, device 등)를 유지하여 같은 시각의 샘플이 덮어쓰이지 않도록 합니다.
+func writeRawSample(metric TelegrafMetric, eventTime int64) {
+	metricCols, err := mappingOnDemandMetric(true, types.GetMetricType(metric.Name), metric.Fields)
+	if err != nil || len(metricCols) == 0 {
+		return
+	}
+	for key, val := range metricCols {
+		if val == nil {
+			delete(metricCols, key)
+		}
+	}
+	if len(metricCols) == 0 {
+		return
+	}
+	tagArr := map[string]string{}
+	for key, tag := range metric.Tags {
+		if tagStr, ok := tag.(string); ok {
+			tagArr[key] = tagStr
+		}
+	}
+	_ = v1.GetWritePipeline().WritePoint(v1.RawDatabase, metric.Name, tagArr, metricCols, time.Unix(eventTime, 0).UTC())
+}
+
+// getAgentUUID 메세지 키(에이전트 UUID)가 있을 경우 메세지 키, 없을 경우 토픽 이름을 에이전트 UUID 로 사용
+func getAgentUUID(msg *kafka.Message) string {
+	if len(msg.Key) != 0 {
+		return string(msg.Key)
+	}
+	return *msg.TopicPartition.Topic
+}
+
+// getTransport 메세지 수신 방식 조회 (HTTP 수신 후 kafka 로 전달된 메세지는 헤더의 수신 방식 사용)
+func getTransport(msg *kafka.Message) string {
+	for _, header := range msg.Headers {
+		if header.Key == types.TransportHeader && len(header.Value) != 0 {
+			return string(header.Value)
+		}
+	}
+	return types.KafkaTransport
 }
 
 func (a *Aggregator) CalculateMetric(responseMap map[string]map[string]map[string][]float64, tagMap map[string]map[string]string, aggregateType string) (map[string]interface{}, error) {
@@ -181,7 +364,7 @@ func (a *Aggregator) CalculateMetric(responseMap map[string]map[string]map[strin
 			if metricName == "tagInfo" {
 				continue
 			}
-			tmpMetric, err := mappingOnDemandMetric(config.GetInstance().Monitoring.DefaultPolicy == types.PushPolicy, types.GetMetricType(metricName), metricVal.(map[string]interface{}))
+			tmpMetric, err := mappingOnDemandMetric(true, types.GetMetricType(metricName), metricVal.(map[string]interface{}))
 			if err != nil {
 				util.GetLogger().Error(err)
 				return nil, err
@@ -1,12 +1,14 @@
 package collector
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"sync"
 	"time"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/sirupsen/logrus"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
@@ -40,6 +42,10 @@ func NewMetricCollector(aggregateType types.AggregateType, createOrder int) (Met
 		//"max.poll.interval": 6,
 		"auto.offset.reset": "earliest",
 	}
+	if err := kafkasec.ApplySecurityConfig(KafkaConfig, config.GetInstance().Kafka); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to set collector kafka security config, error=%s", err))
+		return MetricCollector{}, err
+	}
 
 	consumerKafkaConn, err := kafka.NewConsumer(KafkaConfig)
 	if err != nil {
@@ -64,13 +70,21 @@ func NewMetricCollector(aggregateType types.AggregateType, createOrder int) (Met
 //   - 콜렉터 매니저로부터 "close" 채널 값을 받으면 종료합니다. (고루틴 채널 중지 => 삭제)
 //   - 콜렉터 매니저로부터 topic 리스트 값을 받으면 kafka 에 해당 topic 을 기준으로 데이터를 가져옵니다.
 //   - kafka 에 요청한 topic 리스트 들 중 데이터가 3회 이상 넘어오지 않는 topic 의 경우 < 스케줄러가 활용하는 topic Queue > 에 삭제할 topic 으로 등록합니다.
-func (mc *MetricCollector) Collector(wg *sync.WaitGroup) error {
+//   - ctx 가 취소되면 처리 중인 topic 집계를 마친 후 kafka 연결을 종료합니다.
+func (mc *MetricCollector) Collector(ctx context.Context, wg *sync.WaitGroup) error {
 
 	deadOrAliveCnt := map[string]int{}
 
 	defer wg.Done()
 	for {
 		select {
+		case <-ctx.Done():
+			_ = mc.ConsumerKafkaConn.Unsubscribe()
+			if err := mc.ConsumerKafkaConn.Close(); err != nil {
+				logrus.Debug("Fail to collector kafka connection close")
+			}
+			fmt.Println(fmt.Sprintf("#### Group_%d collector Stop ####", mc.CreateOrder))
+			return nil
 		case processDecision := <-mc.Ch:
 			if len(processDecision) != 0 {
 				if processDecision[0] == "close" {
@@ -126,6 +140,9 @@ func (mc *MetricCollector) Collector(wg *sync.WaitGroup) error {
 						}
 					}
 				}
+			} else {
+				// 배치된 topic 이 없는 콜렉터는 이전 topic 구독 해제
+				_ = mc.ConsumerKafkaConn.Unsubscribe()
 			}
 			break
 		}
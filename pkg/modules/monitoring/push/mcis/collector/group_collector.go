@@ -0,0 +1,113 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
+)
+
+type GroupCollector struct {
+	CreateOrder       int
+	ConsumerKafkaConn *kafka.Consumer
+	Aggregator        Aggregator
+	Ch                chan []string
+}
+
+// NewGroupCollector
+//   - 컨슈머 그룹 기반 collector 입니다.
+//   - 모든 collector 가 동일한 컨슈머 그룹(MCISConsumerGroupId)에 참여하며, 토픽 파티션 분배는 kafka 에 위임합니다.
+//   - collector 수 변경(고루틴 수, deployment replicas) 시 kafka 가 파티션을 재분배합니다.
+//   - securityConf 는 kafka 접속 보안 설정이며, deployment 콜렉터는 환경변수 기반 설정을 사용합니다.
+func NewGroupCollector(kafkaEndpointUrl string, securityConf config.Kafka, aggregateType types.AggregateType, createOrder int) (GroupCollector, error) {
+
+	groupKafkaConfig := &kafka.ConfigMap{
+		"bootstrap.servers":  kafkaEndpointUrl,
+		"group.id":           types.MCISConsumerGroupId,
+		"enable.auto.commit": true,
+		"auto.offset.reset":  "earliest",
+		// 토픽 패턴 구독 시 신규 에이전트 토픽 반영 주기
+		"topic.metadata.refresh.interval.ms": types.TopicMetadataRefreshMs,
+	}
+	if err := kafkasec.ApplySecurityConfig(groupKafkaConfig, securityConf); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to set group collector kafka security config, error=%s", err))
+		return GroupCollector{}, err
+	}
+
+	consumerKafkaConn, err := kafka.NewConsumer(groupKafkaConfig)
+	if err != nil {
+		util.GetLogger().Error("Fail to create group collector kafka consumer, Kafka Connection Fail", err)
+		return GroupCollector{}, err
+	}
+	gc := GroupCollector{
+		ConsumerKafkaConn: consumerKafkaConn,
+		CreateOrder:       createOrder,
+		Aggregator: Aggregator{
+			AggregateType: aggregateType,
+		},
+		Ch: make(chan []string),
+	}
+	fmt.Println(fmt.Sprintf("#### Group_%d consumer group collector Create ####", createOrder))
+	return gc, nil
+}
+
+// GetSubscribeTopics 컨슈머 그룹 구독 토픽 조회 (agent: 에이전트 토픽 패턴, shared: 공용 토픽)
+func GetSubscribeTopics(topicMode string) []string {
+	if strings.EqualFold(topicMode, types.SharedTopicMode) {
+		return []string{types.MCISSharedTopic}
+	}
+	return []string{types.MCISAgentTopicPattern}
+}
+
+// Collector
+//   - 토픽 구성 방식에 따라 에이전트 토픽 패턴 또는 공용 토픽을 구독합니다.
+//   - 수집 주기(collectInterval) 마다 할당받은 파티션의 데이터를 가져와 가공 후 DB 에 저장합니다.
+//   - 콜렉터 매니저로부터 "close" 채널 값을 받거나 ctx 가 취소되면 컨슈머 그룹에서 탈퇴 후 종료합니다.
+func (gc *GroupCollector) Collector(ctx context.Context, wg *sync.WaitGroup, topicMode string, collectInterval int) error {
+
+	defer wg.Done()
+	topics := GetSubscribeTopics(topicMode)
+	if err := gc.ConsumerKafkaConn.SubscribeTopics(topics, nil); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to subscribe topics %s, error=%s", topics, err))
+		return err
+	}
+	fmt.Println(fmt.Sprintf("Group_%d consumer group collector Subscribed : %s", gc.CreateOrder, topics))
+
+	for {
+		select {
+		case <-ctx.Done():
+			gc.closeConsumer()
+			return nil
+		case processDecision := <-gc.Ch:
+			if len(processDecision) != 0 && processDecision[0] == "close" {
+				close(gc.Ch)
+				gc.closeConsumer()
+				return nil
+			}
+		case <-time.After(time.Duration(collectInterval) * time.Second):
+			start := time.Now()
+			aliveTopics, err := gc.Aggregator.AggregateMetric(gc.ConsumerKafkaConn, nil)
+			if err != nil {
+				util.GetLogger().Error(err)
+			}
+			fmt.Println(fmt.Sprintf("Group_%d consumer group collector Aggregated : %s, Aggregate Time: %s", gc.CreateOrder, aliveTopics, time.Since(start)))
+		}
+	}
+}
+
+// closeConsumer 컨슈머 그룹 탈퇴 (커밋된 오프셋 이후부터 다른 collector 가 이어서 수집)
+func (gc *GroupCollector) closeConsumer() {
+	_ = gc.ConsumerKafkaConn.Unsubscribe()
+	if err := gc.ConsumerKafkaConn.Close(); err != nil {
+		logrus.Debug("Fail to group collector kafka connection close")
+	}
+	fmt.Println(fmt.Sprintf("#### Group_%d consumer group collector Delete ####", gc.CreateOrder))
+}
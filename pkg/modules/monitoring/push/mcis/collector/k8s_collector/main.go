@@ -6,11 +6,16 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"sort"
 	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector"
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
@@ -48,6 +53,15 @@ func DeleteDeployment(clientSet *kubernetes.Clientset, createOrder int, collecto
 	}
 }
 
+// FlushWritePipeline 종료 전 메트릭 쓰기 버퍼 flush
+func FlushWritePipeline() {
+	flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := v1.GetWritePipeline().Flush(flushCtx); err != nil {
+		fmt.Println(err)
+	}
+}
+
 // deployment 로 배포된 collector
 // 일정 주기( aggreTime )를 가지고 configmap 을 조회
 // configmap 의 데이터( topicMaps ) 파싱하여, 자신의 collector idx 값을 가진 topics 들을 구독
@@ -66,6 +80,7 @@ func main() {
 	namespace := os.Getenv("namespace")
 	dfAddr := os.Getenv("df_addr")
 	collectInterval, _ := strconv.Atoi(os.Getenv("mcis_collector_interval"))
+	allowedLateness, _ := strconv.Atoi(os.Getenv("allowed_lateness"))
 	collectorUUID := os.Getenv("collect_uuid")
 	if kafkaEndpointUrl == "" || namespace == "" || dfAddr == "" {
 		fmt.Println("Get Env Error")
@@ -73,6 +88,27 @@ func main() {
 	}
 	/** Get Env Val End */
 
+	// SIGTERM 수신 시 처리 중인 집계를 마친 후 종료
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	/** Operate Consumer Group Collector Start */
+	// 컨슈머 그룹 방식일 경우, configmap 조회 없이 컨슈머 그룹에 참여하여 kafka 가 분배한 파티션을 수집
+	// 스케일 인/아웃은 deployment replicas 변경으로 수행
+	if os.Getenv("collector_mode") == types.ConsumerGroupCollectorMode {
+		gc, err := collector.NewGroupCollector(kafkaEndpointUrl, kafkasec.GetSecurityConfigFromEnv(), aggregateType, createOrder)
+		PrintPanicError(err)
+		gc.Aggregator.WindowInterval = collectInterval
+		gc.Aggregator.AllowedLateness = allowedLateness
+		// 컨슈머 그룹 탈퇴 후 종료
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		PrintPanicError(gc.Collector(ctx, &wg, os.Getenv("collector_topic_mode"), collectInterval))
+		FlushWritePipeline()
+		return
+	}
+	/** Operate Consumer Group Collector End */
+
 	/** Set Kafka, ConfigMap Conn Start */
 	KafkaConfig = &kafka.ConfigMap{
 		"bootstrap.servers":  kafkaEndpointUrl,
@@ -80,6 +116,7 @@ func main() {
 		"enable.auto.commit": true,
 		"auto.offset.reset":  "earliest",
 	}
+	PrintPanicError(kafkasec.ApplySecurityConfig(KafkaConfig, kafkasec.GetSecurityConfigFromEnv()))
 	consumerKafkaConn, err := kafka.NewConsumer(KafkaConfig)
 	PrintPanicError(err)
 	config, err := rest.InClusterConfig()
@@ -93,7 +130,9 @@ func main() {
 		ConsumerKafkaConn: consumerKafkaConn,
 		CreateOrder:       createOrder,
 		Aggregator: collector.Aggregator{
-			AggregateType: aggregateType,
+			AggregateType:   aggregateType,
+			WindowInterval:  collectInterval,
+			AllowedLateness: allowedLateness,
 		},
 	}
 	fmt.Println(fmt.Sprintf("#### Group_%d collector Create ####", createOrder))
@@ -101,7 +140,16 @@ func main() {
 
 	configMapFailCnt := 0
 	for {
-		time.Sleep(time.Duration(collectInterval) * time.Second)
+		select {
+		case <-ctx.Done():
+			// 종료 전 kafka 연결 종료 및 메트릭 쓰기 버퍼 flush
+			_ = mc.ConsumerKafkaConn.Unsubscribe()
+			_ = mc.ConsumerKafkaConn.Close()
+			FlushWritePipeline()
+			fmt.Println(fmt.Sprintf("#### Group_%d collector Delete ####", createOrder))
+			return
+		case <-time.After(time.Duration(collectInterval) * time.Second):
+		}
 		fmt.Println(fmt.Sprintf("#### Group_%d collector ####", createOrder))
 		fmt.Println("Get ConfigMap")
 		/** Get ConfigMap<Data: Collector UUID Map, BinaryData: Collector Topics> Start */
@@ -131,7 +179,9 @@ func main() {
 		}
 		var DeliveredTopicList []string
 		DeliveredTopicList, ok := topicMap[mc.CreateOrder]
-		if !ok {
+		if !ok || len(DeliveredTopicList) == 0 {
+			// 배치된 topic 이 없는 콜렉터는 이전 topic 구독 해제
+			_ = mc.ConsumerKafkaConn.Unsubscribe()
 			fmt.Println("No topic on this Collector")
 			continue
 		}
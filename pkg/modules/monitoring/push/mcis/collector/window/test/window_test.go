@@ -0,0 +1,215 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector/window"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+const (
+	agentUUID       = "ns01_mcis_mcis01_vm01_aws"
+	windowInterval  = 10
+	allowedLateness = 5
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// sample 이벤트 시각(baseTime 기준 초), cpu_utilization 값
+type sample struct {
+	eventTime int64
+	value     float64
+}
+
+func addSample(ws *window.State, s sample) bool {
+	tags := map[string]interface{}{types.NsId: "ns01", types.McisId: "mcis01", types.VmId: "vm01"}
+	fields := map[string]interface{}{"cpu_utilization": s.value}
+	return ws.AddSample(agentUUID, baseTime.Unix()+s.eventTime, baseTime, "cpu", tags, fields)
+}
+
+// getWindowValues 윈도우 시작 시각(baseTime 기준 초) > cpu_utilization 수집 값
+func getWindowValues(responseMap map[int64]map[string]window.Samples) map[int64][]float64 {
+	windowValues := map[int64][]float64{}
+	for windowStart, agentSamples := range responseMap {
+		windowValues[windowStart-baseTime.Unix()] = agentSamples[agentUUID]["cpu"]["cpu_utilization"]
+	}
+	return windowValues
+}
+
+func TestAddSample(t *testing.T) {
+	testCases := []struct {
+		name            string
+		samples         []sample
+		expectedDropped int
+		expectedValues  map[int64][]float64
+	}{
+		{
+			name:           "same window",
+			samples:        []sample{{eventTime: 1, value: 10}, {eventTime: 9, value: 20}},
+			expectedValues: map[int64][]float64{0: {10, 20}},
+		},
+		{
+			name:           "window aligned by interval",
+			samples:        []sample{{eventTime: 9, value: 10}, {eventTime: 10, value: 20}, {eventTime: 25, value: 30}},
+			expectedValues: map[int64][]float64{0: {10}, 10: {20}, 20: {30}},
+		},
+		{
+			name:           "late sample within allowed lateness",
+			samples:        []sample{{eventTime: 11, value: 20}, {eventTime: 5, value: 10}},
+			expectedValues: map[int64][]float64{0: {10}, 10: {20}},
+		},
+		{
+			name:            "late sample to window not yet evicted",
+			samples:         []sample{{eventTime: 1, value: 10}, {eventTime: 15, value: 20}, {eventTime: 2, value: 30}},
+			expectedDropped: 0,
+			expectedValues:  map[int64][]float64{0: {10, 30}, 10: {20}},
+		},
+		{
+			name:            "late sample to evicted window",
+			samples:         []sample{{eventTime: 22, value: 20}, {eventTime: 5, value: 10}},
+			expectedDropped: 1,
+			expectedValues:  map[int64][]float64{20: {20}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := window.NewState(windowInterval, allowedLateness)
+			droppedCnt := 0
+			for _, s := range tc.samples {
+				if !addSample(ws, s) {
+					droppedCnt++
+				}
+			}
+			if droppedCnt != tc.expectedDropped {
+				t.Errorf("dropped samples, expected=%d, actual=%d", tc.expectedDropped, droppedCnt)
+			}
+			responseMap, tagMap, rewriteCnt := ws.PopUpdatedWindows()
+			if actual := getWindowValues(responseMap); !reflect.DeepEqual(actual, tc.expectedValues) {
+				t.Errorf("window values, expected=%v, actual=%v", tc.expectedValues, actual)
+			}
+			if rewriteCnt != 0 {
+				t.Errorf("rewrite count, expected=0, actual=%d", rewriteCnt)
+			}
+			for windowStart := range responseMap {
+				if tagMap[windowStart][agentUUID][types.VmId] != "vm01" {
+					t.Errorf("tag info, window=%d, actual=%v", windowStart, tagMap[windowStart][agentUUID])
+				}
+			}
+		})
+	}
+}
+
+func TestLateSampleRewrite(t *testing.T) {
+	ws := window.NewState(windowInterval, allowedLateness)
+	addSample(ws, sample{eventTime: 1, value: 10})
+	addSample(ws, sample{eventTime: 12, value: 20})
+	if _, _, rewriteCnt := ws.PopUpdatedWindows(); rewriteCnt != 0 {
+		t.Fatalf("rewrite count, expected=0, actual=%d", rewriteCnt)
+	}
+
+	// 저장 이후 변경이 없으면 저장 대상 없음
+	if responseMap, _, _ := ws.PopUpdatedWindows(); len(responseMap) != 0 {
+		t.Fatalf("no updated window expected, actual=%v", getWindowValues(responseMap))
+	}
+
+	// 열린 윈도우에 지연 샘플이 추가되면 기존 샘플을 포함해 다시 저장
+	if !addSample(ws, sample{eventTime: 8, value: 30}) {
+		t.Fatal("late sample within allowed lateness dropped")
+	}
+	responseMap, _, rewriteCnt := ws.PopUpdatedWindows()
+	expected := map[int64][]float64{0: {10, 30}}
+	if actual := getWindowValues(responseMap); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("rewritten window values, expected=%v, actual=%v", expected, actual)
+	}
+	if rewriteCnt != 1 {
+		t.Errorf("rewrite count, expected=1, actual=%d", rewriteCnt)
+	}
+}
+
+func TestEvictClosedWindows(t *testing.T) {
+	testCases := []struct {
+		name            string
+		samples         []sample
+		pop             bool
+		evictAfter      time.Duration
+		expectedWindows int
+	}{
+		{
+			name:            "keep open windows",
+			samples:         []sample{{eventTime: 1, value: 10}, {eventTime: 12, value: 20}},
+			pop:             true,
+			evictAfter:      time.Second,
+			expectedWindows: 2,
+		},
+		{
+			name:            "close window behind watermark",
+			samples:         []sample{{eventTime: 1, value: 10}, {eventTime: 16, value: 20}},
+			pop:             true,
+			evictAfter:      time.Second,
+			expectedWindows: 1,
+		},
+		{
+			name:            "keep unwritten window behind watermark",
+			samples:         []sample{{eventTime: 1, value: 10}, {eventTime: 16, value: 20}},
+			pop:             false,
+			evictAfter:      time.Second,
+			expectedWindows: 2,
+		},
+		{
+			name:            "close all windows of idle agent",
+			samples:         []sample{{eventTime: 1, value: 10}, {eventTime: 12, value: 20}},
+			pop:             true,
+			evictAfter:      time.Duration(windowInterval+allowedLateness+1) * time.Second,
+			expectedWindows: 0,
+		},
+		{
+			name:            "remove expired agent",
+			samples:         []sample{{eventTime: 1, value: 10}},
+			pop:             false,
+			evictAfter:      window.AgentWindowExpire + time.Second,
+			expectedWindows: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := window.NewState(windowInterval, allowedLateness)
+			for _, s := range tc.samples {
+				addSample(ws, s)
+			}
+			if tc.pop {
+				ws.PopUpdatedWindows()
+			}
+			ws.EvictClosedWindows(baseTime.Add(tc.evictAfter))
+			if windowCnt := ws.WindowCnt(agentUUID); windowCnt != tc.expectedWindows {
+				t.Errorf("window count, expected=%d, actual=%d", tc.expectedWindows, windowCnt)
+			}
+		})
+	}
+}
+
+func TestFlushAfterIdle(t *testing.T) {
+	ws := window.NewState(windowInterval, allowedLateness)
+	addSample(ws, sample{eventTime: 1, value: 10})
+	ws.PopUpdatedWindows()
+
+	// 유휴 에이전트의 윈도우가 닫힌 이후 도착한 지연 샘플은 폐기
+	ws.EvictClosedWindows(baseTime.Add(time.Duration(windowInterval+allowedLateness+1) * time.Second))
+	if addSample(ws, sample{eventTime: 3, value: 20}) {
+		t.Error("late sample to closed window of idle agent accepted")
+	}
+	if responseMap, _, _ := ws.PopUpdatedWindows(); len(responseMap) != 0 {
+		t.Errorf("closed window rewritten, actual=%v", getWindowValues(responseMap))
+	}
+
+	// 이후 새로운 윈도우의 샘플은 정상 집계
+	if !addSample(ws, sample{eventTime: 30, value: 30}) {
+		t.Fatal("sample to new window dropped")
+	}
+	responseMap, _, rewriteCnt := ws.PopUpdatedWindows()
+	expected := map[int64][]float64{30: {30}}
+	if actual := getWindowValues(responseMap); !reflect.DeepEqual(actual, expected) || rewriteCnt != 0 {
+		t.Errorf("new window values, expected=%v, actual=%v, rewrite=%d", expected, actual, rewriteCnt)
+	}
+}
@@ -0,0 +1,164 @@
+package window
+
+import (
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+// AgentWindowExpire 메세지 수신이 없는 에이전트의 워터마크 정보 유지 시간
+const AgentWindowExpire = 10 * time.Minute
+
+// Samples 메트릭 이름 > 필드 > 수집 값
+type Samples = map[string]map[string][]float64
+
+// aggregateWindow 이벤트 시각(telegraf timestamp) 기준 집계 윈도우
+type aggregateWindow struct {
+	samples Samples
+	tagInfo map[string]string
+	updated bool // 마지막 저장 이후 샘플 추가 여부
+	written bool // 집계 결과 저장 여부
+}
+
+// agentWindow 에이전트 별 집계 윈도우, 워터마크 정보
+type agentWindow struct {
+	windows      map[int64]*aggregateWindow // 윈도우 시작 시각(unix) > 집계 윈도우
+	maxEventTime int64                      // 수신한 샘플의 최대 이벤트 시각 (워터마크 기준)
+	lastReceived time.Time                  // 마지막 메세지 수신 시각
+}
+
+// State 콜렉터 별 이벤트 시각 기준 집계 상태
+//   - 윈도우 크기는 MCIS 콜렉터 집계 주기이며, 윈도우 시작 시각은 집계 주기 단위로 정렬합니다.
+//   - 워터마크(에이전트 별 최대 이벤트 시각 - 허용 지연 시간) 이전에 끝나는 윈도우는 닫힌 윈도우로 판단하여 메모리에서 제거합니다.
+//   - 닫히지 않은 윈도우에 지연 샘플이 추가되면 윈도우 시작 시각으로 집계 결과를 다시 저장하여 기존 집계 point 를 갱신합니다.
+//   - 닫힌 윈도우의 지연 샘플은 기존 집계 point 를 덮어쓰지 않도록 폐기합니다.
+type State struct {
+	WindowInterval  int64 // 집계 윈도우 크기 (s)
+	AllowedLateness int64 // 지연 샘플 허용 시간 (s)
+
+	agents map[string]*agentWindow
+}
+
+// NewState 집계 상태 생성
+func NewState(windowInterval int64, allowedLateness int64) *State {
+	if windowInterval <= 0 {
+		windowInterval = 1
+	}
+	if allowedLateness < 0 {
+		allowedLateness = 0
+	}
+	return &State{
+		WindowInterval:  windowInterval,
+		AllowedLateness: allowedLateness,
+		agents:          map[string]*agentWindow{},
+	}
+}
+
+// AddSample 에이전트 샘플을 이벤트 시각에 해당하는 윈도우에 추가 (닫힌 윈도우의 샘플일 경우 false 반환)
+//   - receivedAt: 메세지 수신 시각 (에이전트 유휴 판단 기준)
+func (ws *State) AddSample(agentUUID string, eventTime int64, receivedAt time.Time, name string, tags map[string]interface{}, fields map[string]interface{}) bool {
+	agent, ok := ws.agents[agentUUID]
+	if !ok {
+		agent = &agentWindow{windows: map[int64]*aggregateWindow{}}
+		ws.agents[agentUUID] = agent
+	}
+	agent.lastReceived = receivedAt
+
+	windowStart := eventTime - eventTime%ws.WindowInterval
+	window, ok := agent.windows[windowStart]
+	if !ok {
+		if agent.maxEventTime != 0 && windowStart+ws.WindowInterval <= agent.maxEventTime-ws.AllowedLateness {
+			return false
+		}
+		window = &aggregateWindow{
+			samples: Samples{},
+			tagInfo: map[string]string{},
+		}
+		agent.windows[windowStart] = window
+	}
+	if eventTime > agent.maxEventTime {
+		agent.maxEventTime = eventTime
+	}
+
+	for key, tag := range tags {
+		if key == types.NsId || key == types.McisId || key == types.VmId || key == types.OsType || key == types.CspType {
+			if tagStr, ok := tag.(string); ok {
+				window.tagInfo[key] = tagStr
+			}
+		}
+	}
+	if _, ok := window.samples[name]; !ok {
+		window.samples[name] = map[string][]float64{}
+	}
+	for fieldName, val := range fields {
+		if fieldVal, ok := val.(float64); ok {
+			window.samples[name][fieldName] = append(window.samples[name][fieldName], fieldVal)
+		}
+	}
+	window.updated = true
+	return true
+}
+
+// PopUpdatedWindows 마지막 저장 이후 샘플이 추가된 윈도우 조회
+//   - 윈도우 시작 시각 > 에이전트 UUID > 샘플, 윈도우 시작 시각 > 에이전트 UUID > tagInfo 형태로 반환합니다.
+//   - 이미 저장된 윈도우의 갱신 건수를 함께 반환합니다.
+func (ws *State) PopUpdatedWindows() (map[int64]map[string]Samples, map[int64]map[string]map[string]string, int) {
+	responseMap := map[int64]map[string]Samples{}
+	tagMap := map[int64]map[string]map[string]string{}
+	rewriteCnt := 0
+	for agentUUID, agent := range ws.agents {
+		for windowStart, window := range agent.windows {
+			if !window.updated {
+				continue
+			}
+			if _, ok := responseMap[windowStart]; !ok {
+				responseMap[windowStart] = map[string]Samples{}
+				tagMap[windowStart] = map[string]map[string]string{}
+			}
+			responseMap[windowStart][agentUUID] = window.samples
+			tagMap[windowStart][agentUUID] = window.tagInfo
+			if window.written {
+				rewriteCnt++
+			}
+			window.updated = false
+			window.written = true
+		}
+	}
+	return responseMap, tagMap, rewriteCnt
+}
+
+// EvictClosedWindows 워터마크가 지난 윈도우 및 메세지 수신이 없는 에이전트 정보 제거
+//   - 허용 지연 시간 동안 메세지 수신이 없는 에이전트는 워터마크를 마지막 윈도우 종료 시점 이후로 이동하여 모든 윈도우를 닫습니다.
+//   - 저장되지 않은 샘플이 남은 윈도우는 PopUpdatedWindows 로 저장될 때까지 유지합니다.
+func (ws *State) EvictClosedWindows(now time.Time) {
+	idleTimeout := time.Duration(ws.WindowInterval+ws.AllowedLateness) * time.Second
+	for agentUUID, agent := range ws.agents {
+		idleTime := now.Sub(agent.lastReceived)
+		if idleTime > AgentWindowExpire {
+			delete(ws.agents, agentUUID)
+			continue
+		}
+		if idleTime > idleTimeout {
+			for windowStart := range agent.windows {
+				if windowStart+ws.WindowInterval+ws.AllowedLateness > agent.maxEventTime {
+					agent.maxEventTime = windowStart + ws.WindowInterval + ws.AllowedLateness
+				}
+			}
+		}
+		watermark := agent.maxEventTime - ws.AllowedLateness
+		for windowStart, window := range agent.windows {
+			if windowStart+ws.WindowInterval <= watermark && !window.updated {
+				delete(agent.windows, windowStart)
+			}
+		}
+	}
+}
+
+// WindowCnt 에이전트 별 메모리에 유지 중인 윈도우 수
+func (ws *State) WindowCnt(agentUUID string) int {
+	agent, ok := ws.agents[agentUUID]
+	if !ok {
+		return 0
+	}
+	return len(agent.windows)
+}
@@ -6,8 +6,10 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/collector"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
@@ -29,16 +31,22 @@ import (
 // 3. K8sClientSet
 //  - 동작 방식(deployType)이 helm 일 경우, k8s와 통신하기 위한 conn 객체입니다.
 //  - 해당 객체는 k8s in-cluster 모드에만 동작합니다.
+// 4. GroupCollectorAddrSlice
+//  - 컨슈머 그룹 방식(collector_mode: consumer_group)으로 생성한 Go-routine 기반 collector 의 주소값을 보관하는 배열 변수입니다.
+// 5. Context
+//  - 프로세스 종료 시 취소되는 context 로, collector 는 처리 중인 topic 집계를 마친 후 종료합니다.
 type CollectManager struct {
-	CollectorAddrSlice []*collector.MetricCollector
-	CollectorPolicy    string
-	K8sClientSet       *kubernetes.Clientset
-	WaitGroup          *sync.WaitGroup
+	CollectorAddrSlice      []*collector.MetricCollector
+	GroupCollectorAddrSlice []*collector.GroupCollector
+	CollectorPolicy         string
+	K8sClientSet            *kubernetes.Clientset
+	WaitGroup               *sync.WaitGroup
+	Context                 context.Context
 }
 
-func NewCollectorManager() (*CollectManager, error) {
+func NewCollectorManager(ctx context.Context) (*CollectManager, error) {
 
-	manager := CollectManager{}
+	manager := CollectManager{Context: ctx}
 	if config.GetInstance().Monitoring.DeployType == types.Helm {
 		if err := manager.InitDFK8sEnv(); err != nil {
 			return &manager, err
@@ -80,6 +88,13 @@ func (manager *CollectManager) InitDFK8sEnv() (err error) {
 		}
 		fmt.Println("Created ConfigMap: ", result.GetObjectMeta().GetName())
 	}
+
+	// collector 에 전달할 kafka 비밀번호는 Secret 으로 배포합니다.
+	if kafkasec.IsSecurityEnabled(config.GetInstance().Kafka) {
+		if err = kafkasec.ApplySecuritySecret(manager.K8sClientSet, config.GetInstance().Dragonfly.HelmNamespace, config.GetInstance().Kafka); err != nil {
+			return err
+		}
+	}
 	return
 }
 
@@ -109,8 +124,10 @@ func (manager *CollectManager) CreateCollector() error {
 			{Name: "namespace", Value: config.GetInstance().Dragonfly.HelmNamespace},
 			{Name: "df_addr", Value: fmt.Sprintf("%s:%d", config.GetInstance().Dragonfly.DragonflyIP, config.GetInstance().Dragonfly.HelmPort)},
 			{Name: "mcis_collector_interval", Value: strconv.Itoa(config.GetInstance().Monitoring.MCISCollectorInterval)},
+			{Name: "allowed_lateness", Value: strconv.Itoa(config.GetInstance().Monitoring.AllowedLateness)},
 			{Name: "collect_uuid", Value: collectorUUID},
 		}
+		env = append(env, kafkasec.GetSecurityEnv(config.GetInstance().Kafka)...)
 		deploymentTemplate := util.DeploymentTemplate(types.DeploymentName, collectorCreateOrder, collectorUUID, env, types.MCISCollectorImage)
 		fmt.Println("Creating deployment...")
 		result, err := manager.K8sClientSet.AppsV1().Deployments(config.GetInstance().Dragonfly.HelmNamespace).Create(context.TODO(), deploymentTemplate, metav1.CreateOptions{})
@@ -122,7 +139,7 @@ func (manager *CollectManager) CreateCollector() error {
 	// dev, compose 배포일 경우, collector 를 newCollector.Collector 메소드를 통해 Go-routine 으로 동작 시킵니다.
 	case types.Dev, types.Compose:
 		go func() {
-			err := newCollector.Collector(manager.WaitGroup)
+			err := newCollector.Collector(manager.Context, manager.WaitGroup)
 			if err != nil {
 				util.GetLogger().Error("failed to  create Collector")
 			}
@@ -144,3 +161,127 @@ func (manager *CollectManager) DeleteCollector() error {
 	manager.CollectorAddrSlice = manager.CollectorAddrSlice[:lastCollectorIdx]
 	return nil
 }
+
+/* Consumer Group Collector */
+
+// StartGroupCollector 컨슈머 그룹 방식 콜렉터 구동
+// 토픽 스케줄러 없이 collector_count 만큼 콜렉터를 구동하고, 수집 주기마다 collector_count 변경 여부를 확인하여 스케일 인/아웃을 수행합니다.
+func (manager *CollectManager) StartGroupCollector(wg *sync.WaitGroup) error {
+	manager.WaitGroup = wg
+	if err := manager.ScaleGroupCollector(config.GetInstance().Monitoring.CollectorCount); err != nil {
+		return err
+	}
+	manager.WaitGroup.Add(1)
+	go func() {
+		defer manager.WaitGroup.Done()
+		for {
+			select {
+			case <-manager.Context.Done():
+				return
+			case <-time.After(time.Duration(config.GetInstance().Monitoring.MCISCollectorInterval) * time.Second):
+			}
+			if err := manager.ScaleGroupCollector(config.GetInstance().Monitoring.CollectorCount); err != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to scale group collector, error=%s", err))
+			}
+			manager.applyGroupTopicDeletion()
+		}
+	}()
+	return nil
+}
+
+// applyGroupTopicDeletion 컨슈머 그룹 방식 토픽 삭제 요청 반영
+//   - 토픽 분배는 kafka 에 위임하므로 토픽 추가 요청은 별도 처리하지 않습니다.
+//   - 삭제 토픽 에이전트는 비활성화 처리되며, 콜렉터는 비활성화 및 PULL 에이전트 샘플을 집계에서 제외합니다.
+func (manager *CollectManager) applyGroupTopicDeletion() {
+	_, delTopicList, err := getQueuedTopics(util.GetRingQueue())
+	if err != nil {
+		return
+	}
+	for _, delTopic := range delTopicList {
+		disableDeletedTopicAgent(delTopic)
+	}
+	if len(delTopicList) != 0 {
+		util.GetLogger().Info(fmt.Sprintf("group collector topics deleted: %s", delTopicList))
+	}
+}
+
+// ScaleGroupCollector 컨슈머 그룹 방식 콜렉터 수 변경 (dev, compose: 고루틴 수, helm: deployment replicas)
+func (manager *CollectManager) ScaleGroupCollector(collectorCount int) error {
+	if collectorCount < 1 {
+		collectorCount = 1
+	}
+	switch config.GetInstance().Monitoring.DeployType {
+	case types.Helm:
+		return manager.applyGroupDeployment(collectorCount)
+	case types.Dev, types.Compose:
+		for len(manager.GroupCollectorAddrSlice) < collectorCount {
+			if err := manager.createGroupCollector(); err != nil {
+				return err
+			}
+		}
+		for len(manager.GroupCollectorAddrSlice) > collectorCount {
+			lastCollectorIdx := len(manager.GroupCollectorAddrSlice) - 1
+			manager.GroupCollectorAddrSlice[lastCollectorIdx].Ch <- []string{"close"}
+			manager.GroupCollectorAddrSlice = manager.GroupCollectorAddrSlice[:lastCollectorIdx]
+		}
+	}
+	return nil
+}
+
+func (manager *CollectManager) createGroupCollector() error {
+	newCollector, err := collector.NewGroupCollector(config.GetInstance().Kafka.EndpointUrl, config.GetInstance().Kafka, types.AVG, len(manager.GroupCollectorAddrSlice))
+	if err != nil {
+		return err
+	}
+	manager.GroupCollectorAddrSlice = append(manager.GroupCollectorAddrSlice, &newCollector)
+
+	manager.WaitGroup.Add(1)
+	go func() {
+		monConfig := config.GetInstance().Monitoring
+		if err := newCollector.Collector(manager.Context, manager.WaitGroup, monConfig.CollectorTopicMode, monConfig.MCISCollectorInterval); err != nil {
+			util.GetLogger().Error("failed to create group collector")
+		}
+	}()
+	return nil
+}
+
+// applyGroupDeployment 컨슈머 그룹 방식 콜렉터 deployment 생성 및 replicas 변경
+func (manager *CollectManager) applyGroupDeployment(collectorCount int) error {
+	deploymentsClient := manager.K8sClientSet.AppsV1().Deployments(config.GetInstance().Dragonfly.HelmNamespace)
+	deploymentName := fmt.Sprintf("%s%d-%s", types.GroupDeploymentName, 0, types.MCIS)
+
+	deployment, err := deploymentsClient.Get(context.TODO(), deploymentName, metav1.GetOptions{})
+	if err != nil {
+		env := []apiv1.EnvVar{
+			{Name: "kafka_endpoint_url", Value: config.GetInstance().Kafka.EndpointUrl},
+			{Name: "create_order", Value: "0"},
+			{Name: "namespace", Value: config.GetInstance().Dragonfly.HelmNamespace},
+			{Name: "df_addr", Value: fmt.Sprintf("%s:%d", config.GetInstance().Dragonfly.DragonflyIP, config.GetInstance().Dragonfly.HelmPort)},
+			{Name: "mcis_collector_interval", Value: strconv.Itoa(config.GetInstance().Monitoring.MCISCollectorInterval)},
+			{Name: "allowed_lateness", Value: strconv.Itoa(config.GetInstance().Monitoring.AllowedLateness)},
+			{Name: "collect_uuid", Value: types.MCIS},
+			{Name: "collector_mode", Value: types.ConsumerGroupCollectorMode},
+			{Name: "collector_topic_mode", Value: config.GetInstance().Monitoring.CollectorTopicMode},
+		}
+		env = append(env, kafkasec.GetSecurityEnv(config.GetInstance().Kafka)...)
+		deploymentTemplate := util.DeploymentTemplate(types.GroupDeploymentName, 0, types.MCIS, env, types.MCISCollectorImage)
+		deploymentTemplate.Spec.Replicas = util.Int32Ptr(int32(collectorCount))
+		fmt.Println("Creating group collector deployment...")
+		result, err := deploymentsClient.Create(context.TODO(), deploymentTemplate, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		fmt.Println("Created group collector deployment: ", result.GetObjectMeta().GetName())
+		return nil
+	}
+
+	if deployment.Spec.Replicas != nil && int(*deployment.Spec.Replicas) == collectorCount {
+		return nil
+	}
+	deployment.Spec.Replicas = util.Int32Ptr(int32(collectorCount))
+	if _, err = deploymentsClient.Update(context.TODO(), deployment, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	fmt.Println(fmt.Sprintf("Scaled group collector deployment: %s, replicas=%d", deploymentName, collectorCount))
+	return nil
+}
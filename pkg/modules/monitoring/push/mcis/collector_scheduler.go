@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"time"
@@ -46,11 +47,11 @@ func StartScheduler(wg *sync.WaitGroup, manager *CollectManager) error {
 	}
 
 	// Start Scheduler(Go-routine)
+	// WaitGroup End => 스케줄러 종료 시 (ctx 취소)
 	go func() {
-		scheduler.Scheduler()
+		defer wg.Done()
+		scheduler.Scheduler(manager.Context)
 	}()
-	// WaitGroup End
-	defer wg.Done()
 	return nil
 }
 
@@ -131,40 +132,33 @@ func NewCollectorScheduler(wg *sync.WaitGroup, manager *CollectManager) (*Collec
 	return &cScheduler, nil
 }
 
-func (cScheduler CollectorScheduler) Scheduler() {
+// Scheduler
+//   - Aggregate 인터벌을 주기로 topic 추가, 삭제 요청을 처리하여 collector 에게 topic 을 분배합니다.
+//   - ctx 가 취소되면 처리되지 않은 topic 요청을 topicMap 에 반영하여 저장한 후 종료합니다.
+func (cScheduler CollectorScheduler) Scheduler(ctx context.Context) {
 
 	interval, _ := cbstore.GetInstance().StoreGet(types.MonConfig + "/" + "mcis_collector_interval")
 	aggreTime, _ := strconv.Atoi(*interval)
-	topicQue := cScheduler.topicQue
-	cPolicy := cScheduler.cm.CollectorPolicy
+	strategy := cScheduler.getPlacementStrategy()
 
 	for {
 		// Aggregate 인터벌을 주기로 계속 수행됩니다.
-		time.Sleep(time.Duration(aggreTime) * time.Second)
-		// cScheduler.topicQue 에 담겨 있는 Topic 추가, 삭제 처리 요청들을 각각 addTopicList 와 delTopicList 에 담습니다.
-		var addTopicList []string
-		var delTopicList []string
-		if topicQue.Len() != 0 {
-			topicBytesList, err := topicQue.Get(topicQue.Len())
-			if err != nil {
-				util.GetLogger().Error("Failed to get topics from kafka to schedule")
-				continue
-			}
-			for _, topicBytes := range topicBytesList {
-				topicStructure := util.TopicStructure{}
-				if err = json.Unmarshal(topicBytes.([]byte), &topicStructure); err != nil {
-					util.GetLogger().Error("Failed to convert topic messages from kafka")
-					continue
-				}
-				if topicStructure.Policy == types.TopicAdd {
-					addTopicList = append(addTopicList, topicStructure.Topic)
-				} else if topicStructure.Policy == types.TopicDel {
-					delTopicList = append(delTopicList, topicStructure.Topic)
+		select {
+		case <-ctx.Done():
+			cScheduler.PersistTopicMap()
+			// 배치 전략 자원 정리 (메시지 유입량 측정 kafka consumer 등)
+			if closer, ok := strategy.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					util.GetLogger().Error(fmt.Sprintf("failed to close placement strategy, error=%s", err))
 				}
 			}
-
-			addTopicList = util.GetAllTopicBySort(util.Unique(addTopicList, true))
-			delTopicList = util.GetAllTopicBySort(util.Unique(util.ReturnDiffTopicList(delTopicList, addTopicList), true))
+			return
+		case <-time.After(time.Duration(aggreTime) * time.Second):
+		}
+		// cScheduler.topicQue 에 담겨 있는 Topic 추가, 삭제 처리 요청들을 각각 addTopicList 와 delTopicList 에 담습니다.
+		addTopicList, delTopicList, err := cScheduler.getQueuedTopics()
+		if err != nil {
+			continue
 		}
 
 		curTime := time.Now().Format(time.RFC3339)
@@ -172,16 +166,84 @@ func (cScheduler CollectorScheduler) Scheduler() {
 		fmt.Printf("[%s] <MCIS> Add Topics Queue ## : %s\n", curTime, addTopicList)
 		fmt.Printf("[%s] <MCIS> Del Topics Queue ## : %s\n", curTime, delTopicList)
 
-		// collector 운용 정책에 따라 addTopicList 와 delTopicList 를 아래 메소드를 통해 수행합니다.
-		switch cPolicy {
-		case types.AgentCntCollectorPolicy:
-			cScheduler.SchedulePolicyBasedCollector(addTopicList, delTopicList)
-			break
-		case types.CSPCollectorPolicy:
-			cScheduler.ScheduleCSPBasedCollector(addTopicList, delTopicList)
-			break
+		// collector 운용 정책(배치 전략)에 따라 addTopicList 와 delTopicList 를 처리합니다.
+		strategy.Schedule(cScheduler, addTopicList, delTopicList)
+	}
+}
+
+// getPlacementStrategy 콜렉터 정책에 해당하는 배치 전략 조회 (지원하지 않는 정책일 경우 AGENTCOUNT 정책 사용)
+func (cScheduler CollectorScheduler) getPlacementStrategy() PlacementStrategy {
+	strategy, err := GetPlacementStrategy(cScheduler.cm.CollectorPolicy)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("%s, use %s policy instead", err, types.AgentCntCollectorPolicy))
+		strategy, _ = GetPlacementStrategy(types.AgentCntCollectorPolicy)
+	}
+	return strategy
+}
+
+// getQueuedTopics cScheduler.topicQue 에 담겨 있는 Topic 추가, 삭제 처리 요청 조회
+func (cScheduler CollectorScheduler) getQueuedTopics() ([]string, []string, error) {
+	return getQueuedTopics(cScheduler.topicQue)
+}
+
+// getQueuedTopics topicQue 에 담겨 있는 Topic 추가, 삭제 처리 요청 조회 (추가 요청이 함께 있는 토픽은 삭제 대상에서 제외)
+func getQueuedTopics(topicQue *que.Queue) ([]string, []string, error) {
+	var addTopicList []string
+	var delTopicList []string
+	if topicQue.Len() == 0 {
+		return addTopicList, delTopicList, nil
+	}
+	topicBytesList, err := topicQue.Get(topicQue.Len())
+	if err != nil {
+		util.GetLogger().Error("Failed to get topics from kafka to schedule")
+		return nil, nil, err
+	}
+	for _, topicBytes := range topicBytesList {
+		topicStructure := util.TopicStructure{}
+		if err = json.Unmarshal(topicBytes.([]byte), &topicStructure); err != nil {
+			util.GetLogger().Error("Failed to convert topic messages from kafka")
+			continue
+		}
+		if topicStructure.Policy == types.TopicAdd {
+			addTopicList = append(addTopicList, topicStructure.Topic)
+		} else if topicStructure.Policy == types.TopicDel {
+			delTopicList = append(delTopicList, topicStructure.Topic)
 		}
 	}
+
+	addTopicList = util.GetAllTopicBySort(util.Unique(addTopicList, true))
+	delTopicList = util.GetAllTopicBySort(util.Unique(util.ReturnDiffTopicList(delTopicList, addTopicList), true))
+	return addTopicList, delTopicList, nil
+}
+
+// PersistTopicMap
+//   - 종료 시 처리되지 않은 topic 추가, 삭제 요청을 topicMap 에 반영 (in-memory 연산만 수행, collector 생성 및 분배 X)
+//   - 최종 topicMap 을 cb-store 에 저장하여 재시작(또는 리더 전환) 시 이어서 스케줄링합니다.
+func (cScheduler CollectorScheduler) PersistTopicMap() {
+	addTopicList, delTopicList, err := cScheduler.getQueuedTopics()
+	if err == nil && (len(addTopicList) != 0 || len(delTopicList) != 0) {
+		cScheduler.getPlacementStrategy().Assign(cScheduler, addTopicList, delTopicList)
+	}
+	cScheduler.WriteCollectorMapToInMemoryDB()
+	// helm 의 경우 재시작 시 configmap 의 topicMap 을 로드하므로 configmap 에도 저장
+	if config.GetInstance().Monitoring.DeployType == types.Helm {
+		configMapsClient := cScheduler.cm.K8sClientSet.CoreV1().ConfigMaps(config.GetInstance().Dragonfly.HelmNamespace)
+		configMap, err := configMapsClient.Get(context.TODO(), types.ConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to get collector configmap, error=%s", err))
+			return
+		}
+		topicMapBytes, _ := json.Marshal(cScheduler.inMemoryTopicMap.TopicMap)
+		if configMap.BinaryData == nil {
+			configMap.BinaryData = map[string][]byte{}
+		}
+		configMap.BinaryData["topicMap"] = topicMapBytes
+		if _, err = configMapsClient.Update(context.TODO(), configMap, metav1.UpdateOptions{}); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to update collector configmap, error=%s", err))
+			return
+		}
+	}
+	fmt.Printf("[%s] <MCIS> collector scheduler - topic map persisted\n", time.Now().Format(time.RFC3339))
 }
 
 /** ### AgentCnt Policy Start ### */
@@ -287,21 +349,15 @@ func (cScheduler CollectorScheduler) DeleteTopicsToCollector(delTopicList []stri
 	for i := 0; i < len(delTopicList); i++ {
 		delTopic := delTopicList[i]
 		collectorIdxStr, _ := c.StoreGet(fmt.Sprintf("%s/%s", types.Topic, delTopic))
+		// 콜렉터에 배치되지 않은 토픽일 경우 (PUSH 스케줄링 이전 수집 방식 변경)
+		if collectorIdxStr == nil {
+			continue
+		}
 		collectorIdx, _ := strconv.Atoi(*collectorIdxStr)
 		// cb-store 경로 /push/topic/{토픽} 삭제
 		_ = c.StoreDelete(fmt.Sprintf("%s/%s", types.Topic, delTopic))
 
-		/* 에이전트 상태 unhealthy 업데이트 처리 요청 Start */
-		agentInfo := common.AgentInfo{}
-		agentInfoBytes, _ := c.StoreGet(types.Agent + delTopic)
-		if agentInfoBytes != nil {
-			_ = json.Unmarshal([]byte(*agentInfoBytes), &agentInfo)
-			agentInfo.AgentHealth = string(common.Unhealthy)
-			agentInfo.AgentState = string(common.Disable)
-			recentAgentInfoBytes, _ := json.Marshal(agentInfo)
-			_ = c.StorePut(types.Agent+delTopic, string(recentAgentInfoBytes))
-		}
-		/* 에이전트 상태 unhealthy 업데이트 처리 요청 End */
+		disableDeletedTopicAgent(delTopic)
 		// 토픽 삭제가 필요한 콜렉터 map 현황 생성
 		deleteTopicsMap[collectorIdx] = append(deleteTopicsMap[collectorIdx], delTopic)
 	}
@@ -314,6 +370,13 @@ func (cScheduler CollectorScheduler) DeleteTopicsToCollector(delTopicList []stri
 	*(cScheduler.inMemoryTopicMap) = cMap
 }
 
+// disableDeletedTopicAgent 삭제 토픽 에이전트 상태 unhealthy, disable 업데이트 (PULL 수집 방식으로 변경된 에이전트는 제외)
+func disableDeletedTopicAgent(delTopic string) {
+	if err := common.DisablePushAgent(delTopic); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to disable deleted topic agent %s, error=%s", delTopic, err))
+	}
+}
+
 // BalanceTopicsToCollector
 // 토픽 추가 & 삭제 처리가 완료된 topicMap 을 기준으로 최적화 배치 수행
 func (cScheduler CollectorScheduler) BalanceTopicsToCollector(maxHostCount int) {
@@ -415,7 +478,12 @@ func (cScheduler CollectorScheduler) DistributeTopicsToCollector() {
 		}
 	} else {
 		for idx, topics := range cScheduler.inMemoryTopicMap.TopicMap {
-			(*cScheduler.cm.CollectorAddrSlice[idx]).Ch <- topics
+			// 종료 중일 경우, 이미 종료된 collector 에게 분배하지 않음
+			select {
+			case (*cScheduler.cm.CollectorAddrSlice[idx]).Ch <- topics:
+			case <-cScheduler.cm.Context.Done():
+				return
+			}
 		}
 	}
 }
@@ -517,10 +585,13 @@ func (cScheduler CollectorScheduler) DeleteTopicsToCSPCollector(delTopicList []s
 			return
 		}
 		_ = json.Unmarshal([]byte(*agentInfoBytes), &agentInfo)
-		agentInfo.AgentHealth = string(common.Unhealthy)
-		agentInfo.AgentState = string(common.Disable)
-		recentAgentInfoBytes, _ := json.Marshal(agentInfo)
-		_ = c.StorePut(types.Agent+delTopic, string(recentAgentInfoBytes))
+		// PULL 수집 방식으로 변경된 에이전트는 상태 업데이트 제외
+		if agentInfo.AgentType != types.PullPolicy {
+			agentInfo.AgentHealth = string(common.Unhealthy)
+			agentInfo.AgentState = string(common.Disable)
+			recentAgentInfoBytes, _ := json.Marshal(agentInfo)
+			_ = c.StorePut(types.Agent+delTopic, string(recentAgentInfoBytes))
+		}
 
 		deleteTopicsMap[collectorIdx] = append(deleteTopicsMap[collectorIdx], delTopic)
 	}
@@ -0,0 +1,160 @@
+package mcis
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/placement"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/cbstore"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+// PlacementStrategy 콜렉터 배치 전략
+//   - monitoring_policy 설정 값(대문자 변환)으로 배치 전략을 선택합니다.
+//   - Assign: topic 추가, 삭제 요청을 topicMap 에 반영합니다. (in-memory 연산, 종료 시 topicMap 저장에 사용)
+//   - Schedule: Assign 후 collector scale in/out 및 topic 분배를 수행합니다.
+type PlacementStrategy interface {
+	Assign(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string)
+	Schedule(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string)
+}
+
+var placementMutex sync.RWMutex
+var placementStrategies = map[string]PlacementStrategy{
+	types.AgentCntCollectorPolicy:       agentCntPlacement{},
+	types.CSPCollectorPolicy:            cspPlacement{},
+	types.NamespaceCollectorPolicy:      NewPlacement(placement.GroupPlacer{GroupKey: getNamespaceGroupKey}),
+	types.RegionCollectorPolicy:         NewPlacement(placement.GroupPlacer{GroupKey: getRegionGroupKey}),
+	types.ConsistentHashCollectorPolicy: NewPlacement(placement.HashPlacer{VirtualNodeCnt: placement.DefaultVirtualNodeCnt}),
+	types.MessageRateCollectorPolicy:    NewPlacement(placement.NewRatePlacer(newKafkaRateObserver())),
+}
+
+// RegisterPlacementStrategy 콜렉터 배치 전략 등록 (monitoring_policy 값으로 선택)
+func RegisterPlacementStrategy(collectorPolicy string, strategy PlacementStrategy) {
+	placementMutex.Lock()
+	defer placementMutex.Unlock()
+	placementStrategies[strings.ToUpper(collectorPolicy)] = strategy
+}
+
+// GetPlacementStrategy 콜렉터 정책에 해당하는 배치 전략 조회
+func GetPlacementStrategy(collectorPolicy string) (PlacementStrategy, error) {
+	placementMutex.RLock()
+	defer placementMutex.RUnlock()
+	strategy, ok := placementStrategies[strings.ToUpper(collectorPolicy)]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("unsupported monitoring policy: %s", collectorPolicy))
+	}
+	return strategy, nil
+}
+
+/** ### AgentCnt, CSP Policy Start ### */
+
+// agentCntPlacement 콜렉터 당 최대 토픽 수(max_host_count) 기준 배치
+type agentCntPlacement struct{}
+
+func (p agentCntPlacement) Assign(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string) {
+	maxHostCount := config.GetInstance().GetMonConfig().MaxHostCount
+	if len(addTopicList) != 0 {
+		cScheduler.AddTopicsToCollector(addTopicList, maxHostCount)
+	}
+	if len(delTopicList) != 0 {
+		cScheduler.DeleteTopicsToCollector(delTopicList)
+	}
+}
+
+func (p agentCntPlacement) Schedule(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string) {
+	cScheduler.SchedulePolicyBasedCollector(addTopicList, delTopicList)
+}
+
+// cspPlacement CSP 별 콜렉터 배치
+type cspPlacement struct{}
+
+func (p cspPlacement) Assign(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string) {
+	if len(addTopicList) != 0 {
+		cScheduler.AddTopicsToCSPCollector(addTopicList)
+	}
+	if len(delTopicList) != 0 {
+		cScheduler.DeleteTopicsToCSPCollector(delTopicList)
+	}
+}
+
+func (p cspPlacement) Schedule(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string) {
+	cScheduler.ScheduleCSPBasedCollector(addTopicList, delTopicList)
+}
+
+/** ### AgentCnt, CSP Policy End ### */
+
+/** ### TopicPlacer 기반 Policy Start ### */
+
+// Placement TopicPlacer 기반 배치 전략
+//   - 삭제 topic 은 기존과 동일하게 DeleteTopicsToCollector 로 처리합니다. (cb-store 삭제, 에이전트 상태 unhealthy 업데이트)
+//   - 남은 topic 과 추가 topic 을 TopicPlacer 로 재배치한 후 topicMap, cb-store 의 topic 별 콜렉터 idx 를 최신화합니다.
+type Placement struct {
+	placer placement.TopicPlacer
+}
+
+func NewPlacement(placer placement.TopicPlacer) Placement {
+	return Placement{placer: placer}
+}
+
+func (p Placement) Assign(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string) {
+	if len(delTopicList) != 0 {
+		cScheduler.DeleteTopicsToCollector(delTopicList)
+	}
+
+	cMap := *(cScheduler.inMemoryTopicMap)
+	var allTopics []string
+	for _, topics := range cMap.TopicMap {
+		allTopics = append(allTopics, topics...)
+	}
+	allTopics = util.Unique(append(allTopics, addTopicList...), true)
+
+	maxHostCount := config.GetInstance().GetMonConfig().MaxHostCount
+	if maxHostCount <= 0 {
+		maxHostCount = 1
+	}
+	placedTopicMap := p.placer.Place(cMap.TopicMap, allTopics, maxHostCount)
+	cScheduler.applyTopicMap(placedTopicMap)
+}
+
+// Close 배치 전략 자원 정리 (메시지 유입량 측정 kafka consumer 등, 스케줄러 종료 시 호출)
+func (p Placement) Close() error {
+	if closer, ok := p.placer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (p Placement) Schedule(cScheduler CollectorScheduler, addTopicList []string, delTopicList []string) {
+	p.Assign(cScheduler, addTopicList, delTopicList)
+	cScheduler.ScaleInOutCollector()
+	cScheduler.DistributeTopicsToCollector()
+	cScheduler.WriteCollectorMapToInMemoryDB()
+}
+
+// applyTopicMap 배치 결과를 topicMap 에 반영하고, 배치가 변경된 topic 의 콜렉터 idx 를 cb-store 에 저장
+func (cScheduler CollectorScheduler) applyTopicMap(placedTopicMap map[int][]string) {
+	prevCollectorIdx := placement.GetPrevCollectorIdx(cScheduler.inMemoryTopicMap.TopicMap)
+	topicMap, collectorPerAgentCnt := placement.NormalizeTopicMap(placedTopicMap)
+
+	c := cbstore.GetInstance()
+	for collectorIdx, topics := range topicMap {
+		for _, topic := range topics {
+			if prevIdx, ok := prevCollectorIdx[topic]; ok && prevIdx == collectorIdx {
+				continue
+			}
+			if err := c.StorePut(fmt.Sprintf("%s/%s", types.Topic, topic), strconv.Itoa(collectorIdx)); err != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to put topic, error=%s", err))
+			}
+		}
+	}
+	cScheduler.inMemoryTopicMap.TopicMap = topicMap
+	cScheduler.inMemoryTopicMap.CollectorPerAgentCnt = collectorPerAgentCnt
+}
+
+/** ### TopicPlacer 기반 Policy End ### */
@@ -0,0 +1,106 @@
+package placement
+
+import (
+	"sort"
+)
+
+// GroupPlacer 그룹(namespace, region) 단위 배치
+//   - 서로 다른 그룹의 topic 은 같은 콜렉터에 배치하지 않습니다. (테넌트 격리)
+//   - 그룹 별 콜렉터 수는 max_host_count 기준으로 계산합니다.
+//   - 직전 배치에서 그룹이 단독으로 사용하던 콜렉터 idx 와 topic 의 콜렉터 idx 를 최대한 유지합니다.
+type GroupPlacer struct {
+	GroupKey func(topic string) string
+}
+
+func (p GroupPlacer) Place(prevTopicMap map[int][]string, topics []string, maxHostCount int) map[int][]string {
+	groupKeyMap := map[string]string{}
+	groupTopics := map[string][]string{}
+	for _, topic := range topics {
+		key := p.GroupKey(topic)
+		groupKeyMap[topic] = key
+		groupTopics[key] = append(groupTopics[key], topic)
+	}
+	var groupList []string
+	for key := range groupTopics {
+		groupList = append(groupList, key)
+	}
+	sort.Strings(groupList)
+
+	// 직전 배치에서 그룹이 단독으로 사용하던 콜렉터 idx 조회
+	ownedIdx := map[string][]int{}
+	for _, collectorIdx := range getSortedCollectorIdx(prevTopicMap) {
+		prevTopics := prevTopicMap[collectorIdx]
+		if len(prevTopics) == 0 {
+			continue
+		}
+		key, ok := groupKeyMap[prevTopics[0]]
+		if !ok {
+			continue
+		}
+		isolated := true
+		for _, topic := range prevTopics {
+			if groupKeyMap[topic] != key {
+				isolated = false
+				break
+			}
+		}
+		if isolated {
+			ownedIdx[key] = append(ownedIdx[key], collectorIdx)
+		}
+	}
+
+	// 그룹 별 콜렉터 idx 할당 (기존 idx 유지, 부족한 idx 는 미사용 idx 중 작은 값부터 할당)
+	usedIdx := map[int]bool{}
+	groupIdx := map[string][]int{}
+	for _, key := range groupList {
+		needCnt := GetCollectorCnt(len(groupTopics[key]), maxHostCount)
+		owned := ownedIdx[key]
+		if len(owned) > needCnt {
+			owned = owned[:needCnt]
+		}
+		for _, collectorIdx := range owned {
+			usedIdx[collectorIdx] = true
+		}
+		groupIdx[key] = owned
+	}
+	nextIdx := 0
+	for _, key := range groupList {
+		needCnt := GetCollectorCnt(len(groupTopics[key]), maxHostCount)
+		for len(groupIdx[key]) < needCnt {
+			for usedIdx[nextIdx] {
+				nextIdx++
+			}
+			usedIdx[nextIdx] = true
+			groupIdx[key] = append(groupIdx[key], nextIdx)
+		}
+	}
+
+	// 그룹 내 topic 배치 (직전 콜렉터 idx 유지, 나머지는 topic 수가 가장 적은 콜렉터에 배치)
+	prevCollectorIdx := GetPrevCollectorIdx(prevTopicMap)
+	placedTopicMap := map[int][]string{}
+	for _, key := range groupList {
+		assignedIdx := map[int]bool{}
+		for _, collectorIdx := range groupIdx[key] {
+			assignedIdx[collectorIdx] = true
+		}
+		var pendingTopics []string
+		for _, topic := range groupTopics[key] {
+			prevIdx, ok := prevCollectorIdx[topic]
+			if ok && assignedIdx[prevIdx] && len(placedTopicMap[prevIdx]) < maxHostCount {
+				placedTopicMap[prevIdx] = append(placedTopicMap[prevIdx], topic)
+				continue
+			}
+			pendingTopics = append(pendingTopics, topic)
+		}
+		for _, topic := range pendingTopics {
+			targetIdx := groupIdx[key][0]
+			for _, collectorIdx := range groupIdx[key] {
+				if len(placedTopicMap[collectorIdx]) < len(placedTopicMap[targetIdx]) {
+					targetIdx = collectorIdx
+				}
+			}
+			placedTopicMap[targetIdx] = append(placedTopicMap[targetIdx], topic)
+		}
+	}
+	return placedTopicMap
+}
@@ -0,0 +1,72 @@
+package placement
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+const DefaultVirtualNodeCnt = 100
+
+// HashPlacer 일관된 해싱(consistent hashing) 기반 배치
+//   - 콜렉터 수는 max_host_count 기준으로 계산하며, 각 콜렉터는 VirtualNodeCnt 개의 가상 노드로 해시 링에 배치됩니다.
+//   - 콜렉터 scale in/out 시 추가, 삭제되는 콜렉터의 가상 노드 구간에 해당하는 topic 만 이동합니다.
+//   - 해시 링 위치의 콜렉터가 max_host_count 만큼 topic 을 보유한 경우 링의 다음 콜렉터에 배치합니다. (bounded load)
+type HashPlacer struct {
+	VirtualNodeCnt int
+}
+
+type hashRingNode struct {
+	hash         uint32
+	collectorIdx int
+}
+
+func (p HashPlacer) Place(prevTopicMap map[int][]string, topics []string, maxHostCount int) map[int][]string {
+	placedTopicMap := map[int][]string{}
+	collectorCnt := GetCollectorCnt(len(topics), maxHostCount)
+	if collectorCnt == 0 {
+		return placedTopicMap
+	}
+	virtualNodeCnt := p.VirtualNodeCnt
+	if virtualNodeCnt <= 0 {
+		virtualNodeCnt = DefaultVirtualNodeCnt
+	}
+
+	var hashRing []hashRingNode
+	for collectorIdx := 0; collectorIdx < collectorCnt; collectorIdx++ {
+		for vNode := 0; vNode < virtualNodeCnt; vNode++ {
+			hashRing = append(hashRing, hashRingNode{
+				hash:         getHash(fmt.Sprintf("collector-%d#%d", collectorIdx, vNode)),
+				collectorIdx: collectorIdx,
+			})
+		}
+	}
+	sort.Slice(hashRing, func(i, j int) bool {
+		return hashRing[i].hash < hashRing[j].hash
+	})
+
+	// 초과 topic 이동 결과가 입력 순서에 관계없이 동일하도록 topic 정렬 후 배치
+	sortedTopics := append([]string{}, topics...)
+	sort.Strings(sortedTopics)
+	for _, topic := range sortedTopics {
+		topicHash := getHash(topic)
+		ringIdx := sort.Search(len(hashRing), func(i int) bool {
+			return hashRing[i].hash >= topicHash
+		})
+		for i := 0; i < len(hashRing); i++ {
+			collectorIdx := hashRing[(ringIdx+i)%len(hashRing)].collectorIdx
+			if len(placedTopicMap[collectorIdx]) < maxHostCount {
+				placedTopicMap[collectorIdx] = append(placedTopicMap[collectorIdx], topic)
+				break
+			}
+		}
+	}
+	return placedTopicMap
+}
+
+// getHash 해시 링 위치 계산 (유사한 문자열의 topic 도 고르게 분산되도록 md5 사용)
+func getHash(key string) uint32 {
+	sum := md5.Sum([]byte(key))
+	return binary.BigEndian.Uint32(sum[:4])
+}
@@ -0,0 +1,66 @@
+package placement
+
+import (
+	"sort"
+)
+
+// TopicPlacer 전체 topic 목록을 콜렉터 idx 별로 배치
+//   - prevTopicMap: 직전 배치 결과 (배치 변경 최소화에 활용)
+//   - 반환 값: 콜렉터 idx 별 topic 배치 결과 (콜렉터 당 topic 수는 maxHostCount 이내)
+type TopicPlacer interface {
+	Place(prevTopicMap map[int][]string, topics []string, maxHostCount int) map[int][]string
+}
+
+// GetCollectorCnt topic 수, 콜렉터 당 최대 topic 수 기준 필요 콜렉터 수
+func GetCollectorCnt(topicCnt int, maxHostCount int) int {
+	if maxHostCount <= 0 {
+		maxHostCount = 1
+	}
+	collectorCnt := topicCnt / maxHostCount
+	if topicCnt%maxHostCount != 0 {
+		collectorCnt += 1
+	}
+	return collectorCnt
+}
+
+// NormalizeTopicMap 배치 결과를 콜렉터 idx 0 ~ 마지막 배치 idx 까지의 topicMap 으로 변환
+//   - topic 이 배치되지 않은 콜렉터 idx 에도 빈 topic 목록을 기록하여, 해당 콜렉터가 이전 topic 을 계속 수집하지 않도록 합니다.
+//   - 반환 값: topicMap, 콜렉터 별 topic 수
+func NormalizeTopicMap(placedTopicMap map[int][]string) (map[int][]string, []int) {
+	collectorCnt := 0
+	for collectorIdx, topics := range placedTopicMap {
+		if len(topics) != 0 && collectorIdx+1 > collectorCnt {
+			collectorCnt = collectorIdx + 1
+		}
+	}
+	topicMap := map[int][]string{}
+	collectorPerAgentCnt := make([]int, collectorCnt)
+	for collectorIdx := 0; collectorIdx < collectorCnt; collectorIdx++ {
+		topics := append([]string{}, placedTopicMap[collectorIdx]...)
+		sort.Strings(topics)
+		topicMap[collectorIdx] = topics
+		collectorPerAgentCnt[collectorIdx] = len(topics)
+	}
+	return topicMap, collectorPerAgentCnt
+}
+
+// GetPrevCollectorIdx 직전 배치 결과 기준 topic 별 콜렉터 idx
+func GetPrevCollectorIdx(prevTopicMap map[int][]string) map[string]int {
+	prevCollectorIdx := map[string]int{}
+	for collectorIdx, topics := range prevTopicMap {
+		for _, topic := range topics {
+			prevCollectorIdx[topic] = collectorIdx
+		}
+	}
+	return prevCollectorIdx
+}
+
+// getSortedCollectorIdx topicMap 의 콜렉터 idx 오름차순 목록
+func getSortedCollectorIdx(topicMap map[int][]string) []int {
+	var collectorIdxList []int
+	for collectorIdx := range topicMap {
+		collectorIdxList = append(collectorIdxList, collectorIdx)
+	}
+	sort.Ints(collectorIdxList)
+	return collectorIdxList
+}
@@ -0,0 +1,123 @@
+package placement
+
+import (
+	"io"
+	"sort"
+	"sync"
+)
+
+const rateRebalanceThreshold = 1.2
+
+// RateObserver topic 별 초당 메시지 수 측정
+//   - 측정 이력이 없거나 측정에 실패한 topic 은 결과에서 제외합니다.
+type RateObserver interface {
+	ObserveRate(topics []string) map[string]float64
+}
+
+// RatePlacer 메시지 유입량(topic 별 초당 메시지 수) 기반 배치
+//   - 콜렉터 수는 max_host_count 기준으로 계산하며, 콜렉터 별 메시지 유입량 합이 고르게 분산되도록 배치합니다.
+//   - 직전 배치의 콜렉터 간 부하 편차가 임계치(최대 부하 / 평균 부하 = 1.2) 이내일 경우 기존 배치를 유지하고 신규 topic 만 배치합니다.
+type RatePlacer struct {
+	mutex    sync.Mutex
+	observer RateObserver
+}
+
+func NewRatePlacer(observer RateObserver) *RatePlacer {
+	return &RatePlacer{observer: observer}
+}
+
+func (p *RatePlacer) Place(prevTopicMap map[int][]string, topics []string, maxHostCount int) map[int][]string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	placedTopicMap := map[int][]string{}
+	collectorCnt := GetCollectorCnt(len(topics), maxHostCount)
+	if collectorCnt == 0 {
+		return placedTopicMap
+	}
+	topicRate := p.observer.ObserveRate(topics)
+
+	// 유입량 측정 이력이 없는 신규 topic 은 평균 유입량으로 간주
+	var avgRate float64
+	if len(topicRate) != 0 {
+		for _, rate := range topicRate {
+			avgRate += rate
+		}
+		avgRate /= float64(len(topicRate))
+	}
+	getRate := func(topic string) float64 {
+		if rate, ok := topicRate[topic]; ok {
+			return rate
+		}
+		return avgRate
+	}
+
+	// 직전 배치 유지
+	prevCollectorIdx := GetPrevCollectorIdx(prevTopicMap)
+	collectorLoad := make([]float64, collectorCnt)
+	var pendingTopics []string
+	for _, topic := range topics {
+		prevIdx, ok := prevCollectorIdx[topic]
+		if ok && prevIdx < collectorCnt {
+			placedTopicMap[prevIdx] = append(placedTopicMap[prevIdx], topic)
+			collectorLoad[prevIdx] += getRate(topic)
+			continue
+		}
+		pendingTopics = append(pendingTopics, topic)
+	}
+	// 부하 편차가 임계치를 초과할 경우 전체 재배치
+	if !isBalancedLoad(placedTopicMap, collectorLoad, maxHostCount) {
+		placedTopicMap = map[int][]string{}
+		collectorLoad = make([]float64, collectorCnt)
+		pendingTopics = append([]string{}, topics...)
+	}
+
+	// 유입량이 큰 topic 부터 부하가 가장 적은 콜렉터에 배치 (콜렉터 당 max_host_count 이내)
+	sort.SliceStable(pendingTopics, func(i, j int) bool {
+		return getRate(pendingTopics[i]) > getRate(pendingTopics[j])
+	})
+	for _, topic := range pendingTopics {
+		targetIdx := -1
+		for collectorIdx := 0; collectorIdx < collectorCnt; collectorIdx++ {
+			if len(placedTopicMap[collectorIdx]) >= maxHostCount {
+				continue
+			}
+			if targetIdx == -1 || collectorLoad[collectorIdx] < collectorLoad[targetIdx] {
+				targetIdx = collectorIdx
+			}
+		}
+		placedTopicMap[targetIdx] = append(placedTopicMap[targetIdx], topic)
+		collectorLoad[targetIdx] += getRate(topic)
+	}
+	return placedTopicMap
+}
+
+// Close 유입량 측정에 사용한 연결 종료 (스케줄러 종료 시 호출)
+func (p *RatePlacer) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if closer, ok := p.observer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// isBalancedLoad 콜렉터 별 topic 수가 max_host_count 이내이며, 최대 부하가 평균 부하의 임계치 이내인지 확인
+func isBalancedLoad(placedTopicMap map[int][]string, collectorLoad []float64, maxHostCount int) bool {
+	for _, topics := range placedTopicMap {
+		if len(topics) > maxHostCount {
+			return false
+		}
+	}
+	var totalLoad, maxLoad float64
+	for _, load := range collectorLoad {
+		totalLoad += load
+		if load > maxLoad {
+			maxLoad = load
+		}
+	}
+	if totalLoad == 0 {
+		return true
+	}
+	return maxLoad <= totalLoad/float64(len(collectorLoad))*rateRebalanceThreshold
+}
@@ -0,0 +1,226 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mcis/placement"
+)
+
+// makeTopics nsId 별 cnt 개의 에이전트 topic 생성
+func makeTopics(nsId string, cnt int) []string {
+	var topics []string
+	for i := 0; i < cnt; i++ {
+		topics = append(topics, fmt.Sprintf("%s_mcis_mcis01_vm%02d_aws", nsId, i))
+	}
+	return topics
+}
+
+func getNamespace(topic string) string {
+	return strings.Split(topic, "_")[0]
+}
+
+// assertPlaced 모든 topic 이 한 번씩 배치되었고, 콜렉터 당 topic 수가 maxHostCount 이내인지 확인
+func assertPlaced(t *testing.T, placedTopicMap map[int][]string, topics []string, maxHostCount int) {
+	t.Helper()
+	placedCnt := map[string]int{}
+	for collectorIdx, placedTopics := range placedTopicMap {
+		if len(placedTopics) > maxHostCount {
+			t.Errorf("collector %d has %d topics, max_host_count=%d", collectorIdx, len(placedTopics), maxHostCount)
+		}
+		for _, topic := range placedTopics {
+			placedCnt[topic]++
+		}
+	}
+	for _, topic := range topics {
+		if placedCnt[topic] != 1 {
+			t.Errorf("topic %s placed %d times", topic, placedCnt[topic])
+		}
+	}
+	if len(placedCnt) != len(topics) {
+		t.Errorf("placed %d topics, expected %d", len(placedCnt), len(topics))
+	}
+}
+
+// staticRateObserver 고정 topic 별 초당 메시지 수
+type staticRateObserver map[string]float64
+
+func (o staticRateObserver) ObserveRate(topics []string) map[string]float64 {
+	return o
+}
+
+func getCollectorLoad(placedTopicMap map[int][]string, topicRate map[string]float64) map[int]float64 {
+	collectorLoad := map[int]float64{}
+	for collectorIdx, topics := range placedTopicMap {
+		for _, topic := range topics {
+			collectorLoad[collectorIdx] += topicRate[topic]
+		}
+	}
+	return collectorLoad
+}
+
+func TestPlacerMaxHostCount(t *testing.T) {
+	topics := append(makeTopics("ns01", 23), makeTopics("ns02", 7)...)
+	rateObserver := staticRateObserver{}
+	for i, topic := range topics {
+		rateObserver[topic] = float64(i % 5)
+	}
+
+	testCases := []struct {
+		name   string
+		placer placement.TopicPlacer
+	}{
+		{name: "hash", placer: placement.HashPlacer{VirtualNodeCnt: placement.DefaultVirtualNodeCnt}},
+		{name: "hash with few virtual nodes", placer: placement.HashPlacer{VirtualNodeCnt: 1}},
+		{name: "group", placer: placement.GroupPlacer{GroupKey: getNamespace}},
+		{name: "rate", placer: placement.NewRatePlacer(rateObserver)},
+	}
+	for _, tc := range testCases {
+		for _, maxHostCount := range []int{1, 3, 4, 10, 50} {
+			t.Run(fmt.Sprintf("%s/max_host_count=%d", tc.name, maxHostCount), func(t *testing.T) {
+				placedTopicMap := tc.placer.Place(map[int][]string{}, topics, maxHostCount)
+				assertPlaced(t, placedTopicMap, topics, maxHostCount)
+			})
+		}
+	}
+}
+
+func TestHashPlacerStable(t *testing.T) {
+	topics := makeTopics("ns01", 30)
+	placer := placement.HashPlacer{VirtualNodeCnt: placement.DefaultVirtualNodeCnt}
+
+	placedTopicMap := placer.Place(map[int][]string{}, topics, 10)
+	reversedTopics := append([]string{}, topics...)
+	sort.Sort(sort.Reverse(sort.StringSlice(reversedTopics)))
+	replacedTopicMap := placer.Place(placedTopicMap, reversedTopics, 10)
+
+	expected, _ := placement.NormalizeTopicMap(placedTopicMap)
+	actual, _ := placement.NormalizeTopicMap(replacedTopicMap)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("placement changed with same topics, expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestGroupPlacerGapIndex(t *testing.T) {
+	ns01, ns02, ns03 := makeTopics("ns01", 2), makeTopics("ns02", 2), makeTopics("ns03", 2)
+	prevTopicMap := map[int][]string{0: ns01, 1: ns02, 2: ns03}
+	placer := placement.GroupPlacer{GroupKey: getNamespace}
+
+	// ns02 에이전트 삭제 시 ns01, ns03 콜렉터 idx 유지 (idx 1 미사용)
+	placedTopicMap := placer.Place(prevTopicMap, append(append([]string{}, ns01...), ns03...), 2)
+	if !reflect.DeepEqual(placedTopicMap[0], ns01) || !reflect.DeepEqual(placedTopicMap[2], ns03) || len(placedTopicMap[1]) != 0 {
+		t.Fatalf("group collector idx not kept, placed=%v", placedTopicMap)
+	}
+
+	// 미사용 콜렉터 idx 에 빈 topic 목록 기록
+	topicMap, collectorPerAgentCnt := placement.NormalizeTopicMap(placedTopicMap)
+	expectedTopicMap := map[int][]string{0: ns01, 1: {}, 2: ns03}
+	if !reflect.DeepEqual(topicMap, expectedTopicMap) {
+		t.Errorf("normalized topic map, expected=%v, actual=%v", expectedTopicMap, topicMap)
+	}
+	if !reflect.DeepEqual(collectorPerAgentCnt, []int{2, 0, 2}) {
+		t.Errorf("collector per agent count, expected=%v, actual=%v", []int{2, 0, 2}, collectorPerAgentCnt)
+	}
+
+	// 신규 그룹은 미사용 콜렉터 idx 부터 할당
+	ns04 := makeTopics("ns04", 1)
+	placedTopicMap = placer.Place(placedTopicMap, append(append(append([]string{}, ns01...), ns03...), ns04...), 2)
+	if !reflect.DeepEqual(placedTopicMap[1], ns04) {
+		t.Errorf("new group not placed to unused collector idx, placed=%v", placedTopicMap)
+	}
+}
+
+func TestNormalizeTopicMap(t *testing.T) {
+	testCases := []struct {
+		name             string
+		placedTopicMap   map[int][]string
+		expectedTopicMap map[int][]string
+		expectedCnt      []int
+	}{
+		{name: "empty", placedTopicMap: map[int][]string{}, expectedTopicMap: map[int][]string{}, expectedCnt: []int{}},
+		{
+			name:             "sorted topics",
+			placedTopicMap:   map[int][]string{0: {"b", "a"}, 1: {"c"}},
+			expectedTopicMap: map[int][]string{0: {"a", "b"}, 1: {"c"}},
+			expectedCnt:      []int{2, 1},
+		},
+		{
+			name:             "leading gap",
+			placedTopicMap:   map[int][]string{2: {"a"}},
+			expectedTopicMap: map[int][]string{0: {}, 1: {}, 2: {"a"}},
+			expectedCnt:      []int{0, 0, 1},
+		},
+		{
+			name:             "trailing empty collector",
+			placedTopicMap:   map[int][]string{0: {"a"}, 1: {}},
+			expectedTopicMap: map[int][]string{0: {"a"}},
+			expectedCnt:      []int{1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			topicMap, collectorPerAgentCnt := placement.NormalizeTopicMap(tc.placedTopicMap)
+			if !reflect.DeepEqual(topicMap, tc.expectedTopicMap) {
+				t.Errorf("topic map, expected=%v, actual=%v", tc.expectedTopicMap, topicMap)
+			}
+			if !reflect.DeepEqual(collectorPerAgentCnt, tc.expectedCnt) {
+				t.Errorf("collector per agent count, expected=%v, actual=%v", tc.expectedCnt, collectorPerAgentCnt)
+			}
+		})
+	}
+}
+
+func TestRatePlacerRebalance(t *testing.T) {
+	topics := []string{"t1", "t2", "t3", "t4"}
+
+	testCases := []struct {
+		name         string
+		prevTopicMap map[int][]string
+		topicRate    staticRateObserver
+		topics       []string
+		expected     map[int][]string
+	}{
+		{
+			name:         "keep balanced placement",
+			prevTopicMap: map[int][]string{0: {"t1", "t2"}, 1: {"t3", "t4"}},
+			topicRate:    staticRateObserver{"t1": 10, "t2": 10, "t3": 10, "t4": 10},
+			topics:       topics,
+			expected:     map[int][]string{0: {"t1", "t2"}, 1: {"t3", "t4"}},
+		},
+		{
+			name:         "rebalance skewed placement",
+			prevTopicMap: map[int][]string{0: {"t1", "t2"}, 1: {"t3", "t4"}},
+			topicRate:    staticRateObserver{"t1": 100, "t2": 90, "t3": 10, "t4": 5},
+			topics:       topics,
+			expected:     map[int][]string{0: {"t1", "t4"}, 1: {"t2", "t3"}},
+		},
+		{
+			name:         "new topic to least loaded collector",
+			prevTopicMap: map[int][]string{0: {"t1", "t2"}, 1: {"t3"}},
+			topicRate:    staticRateObserver{"t1": 10, "t2": 5, "t3": 12},
+			topics:       topics,
+			expected:     map[int][]string{0: {"t1", "t2"}, 1: {"t3", "t4"}},
+		},
+		{
+			name:         "rebalance over max_host_count",
+			prevTopicMap: map[int][]string{0: {"t1", "t2", "t3"}, 1: {"t4"}},
+			topicRate:    staticRateObserver{"t1": 10, "t2": 10, "t3": 10, "t4": 10},
+			topics:       topics,
+			expected:     map[int][]string{0: {"t1", "t3"}, 1: {"t2", "t4"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			placer := placement.NewRatePlacer(tc.topicRate)
+			placedTopicMap := placer.Place(tc.prevTopicMap, tc.topics, 2)
+			assertPlaced(t, placedTopicMap, tc.topics, 2)
+			actual, _ := placement.NormalizeTopicMap(placedTopicMap)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected=%v, actual=%v, load=%v", tc.expected, actual, getCollectorLoad(placedTopicMap, tc.topicRate))
+			}
+		})
+	}
+}
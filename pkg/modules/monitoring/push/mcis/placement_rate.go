@@ -0,0 +1,164 @@
+package mcis
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+const (
+	kafkaQueryTimeoutMs = 5000
+	// kafkaWatermarkConcurrency 파티션 watermark offset 동시 조회 수
+	kafkaWatermarkConcurrency = 16
+	// kafkaRateObserveTimeout 배치 1회 유입량 측정 제한 시간 (초과 시 조회하지 못한 topic 은 직전 측정 결과 사용)
+	kafkaRateObserveTimeout = 10 * time.Second
+)
+
+// kafkaRateObserver kafka topic 파티션의 high watermark offset 변화량 기반 topic 별 초당 메시지 수 측정
+//   - 측정에 실패한 경우 직전 측정 결과를 반환합니다.
+type kafkaRateObserver struct {
+	consumer   *kafka.Consumer
+	lastOffset map[string]int64
+	lastTime   time.Time
+	topicRate  map[string]float64
+}
+
+func newKafkaRateObserver() *kafkaRateObserver {
+	return &kafkaRateObserver{
+		lastOffset: map[string]int64{},
+		topicRate:  map[string]float64{},
+	}
+}
+
+// ObserveRate topic 별 초당 메시지 수 갱신
+func (o *kafkaRateObserver) ObserveRate(topics []string) map[string]float64 {
+	if o.consumer == nil {
+		kafkaConfig := &kafka.ConfigMap{
+			"bootstrap.servers": config.GetInstance().Kafka.EndpointUrl,
+			"group.id":          "cb-dragonfly-placement",
+		}
+		if err := kafkasec.ApplySecurityConfig(kafkaConfig, config.GetInstance().Kafka); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to set kafka security config for message rate, error=%s", err))
+			return o.topicRate
+		}
+		consumer, err := kafka.NewConsumer(kafkaConfig)
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to create kafka consumer for message rate, error=%s", err))
+			return o.topicRate
+		}
+		o.consumer = consumer
+	}
+
+	metadata, err := o.consumer.GetMetadata(nil, true, kafkaQueryTimeoutMs)
+	if err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to get kafka metadata, error=%s", err))
+		return o.topicRate
+	}
+
+	var partitionArr []kafka.TopicPartition
+	for _, topic := range topics {
+		topicMetadata, ok := metadata.Topics[topic]
+		if !ok {
+			continue
+		}
+		for _, partition := range topicMetadata.Partitions {
+			topicName := topic
+			partitionArr = append(partitionArr, kafka.TopicPartition{Topic: &topicName, Partition: partition.ID})
+		}
+	}
+	highOffsetMap, failedTopicMap := o.queryHighWatermarks(partitionArr)
+
+	now := time.Now()
+	elapsed := now.Sub(o.lastTime).Seconds()
+	topicOffset := map[string]int64{}
+	topicRate := map[string]float64{}
+	for _, topic := range topics {
+		highOffset, ok := highOffsetMap[topic]
+		// 일부 파티션 조회에 실패한 topic 은 측정 실패로 간주
+		if !ok || failedTopicMap[topic] {
+			if rate, ok := o.topicRate[topic]; ok {
+				topicRate[topic] = rate
+			}
+			continue
+		}
+		topicOffset[topic] = highOffset
+		if lastOffset, ok := o.lastOffset[topic]; ok && elapsed > 0 && highOffset >= lastOffset {
+			topicRate[topic] = float64(highOffset-lastOffset) / elapsed
+		} else if rate, ok := o.topicRate[topic]; ok {
+			topicRate[topic] = rate
+		}
+	}
+	o.lastOffset = topicOffset
+	o.topicRate = topicRate
+	o.lastTime = now
+	return topicRate
+}
+
+// queryHighWatermarks 파티션 high watermark offset 병렬 조회 후 topic 별 합계, 조회 실패 topic 반환
+//   - 전체 조회 시간이 kafkaRateObserveTimeout 을 넘지 않도록 남은 시간 내에서만 조회합니다.
+func (o *kafkaRateObserver) queryHighWatermarks(partitionArr []kafka.TopicPartition) (map[string]int64, map[string]bool) {
+	type watermarkResult struct {
+		topic string
+		high  int64
+		err   error
+	}
+	deadline := time.Now().Add(kafkaRateObserveTimeout)
+	partitionCh := make(chan kafka.TopicPartition)
+	resultCh := make(chan watermarkResult, len(partitionArr))
+
+	var wg sync.WaitGroup
+	for i := 0; i < kafkaWatermarkConcurrency && i < len(partitionArr); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partition := range partitionCh {
+				timeoutMs := int(time.Until(deadline).Milliseconds())
+				if timeoutMs <= 0 {
+					resultCh <- watermarkResult{topic: *partition.Topic, err: errors.New("rate observe timeout exceeded")}
+					continue
+				}
+				if timeoutMs > kafkaQueryTimeoutMs {
+					timeoutMs = kafkaQueryTimeoutMs
+				}
+				_, high, err := o.consumer.QueryWatermarkOffsets(*partition.Topic, partition.Partition, timeoutMs)
+				resultCh <- watermarkResult{topic: *partition.Topic, high: high, err: err}
+			}
+		}()
+	}
+	for _, partition := range partitionArr {
+		partitionCh <- partition
+	}
+	close(partitionCh)
+	wg.Wait()
+	close(resultCh)
+
+	highOffsetMap := map[string]int64{}
+	failedTopicMap := map[string]bool{}
+	for result := range resultCh {
+		if result.err != nil {
+			failedTopicMap[result.topic] = true
+			continue
+		}
+		highOffsetMap[result.topic] += result.high
+	}
+	if len(failedTopicMap) != 0 {
+		util.GetLogger().Warn(fmt.Sprintf("failed to query kafka watermark offsets of %d topics", len(failedTopicMap)))
+	}
+	return highOffsetMap, failedTopicMap
+}
+
+// Close 유입량 측정 kafka consumer 종료 (재사용 시 다시 생성)
+func (o *kafkaRateObserver) Close() error {
+	if o.consumer == nil {
+		return nil
+	}
+	err := o.consumer.Close()
+	o.consumer = nil
+	return err
+}
@@ -0,0 +1,26 @@
+package mcis
+
+import (
+	"strings"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent/common"
+)
+
+/** ### Namespace, Region Policy Start ### */
+
+// getNamespaceGroupKey topic(ns_serviceType_mcisId_vmId_csp) 의 namespace
+func getNamespaceGroupKey(topic string) string {
+	return strings.Split(topic, "_")[0]
+}
+
+// getRegionGroupKey 에이전트 메타데이터의 region (region 정보가 없는 에이전트는 CSP 단위로 그룹화)
+func getRegionGroupKey(topic string) string {
+	agentInfo, err := common.GetAgentByUUID(topic)
+	if err == nil && agentInfo != nil && agentInfo.Region != "" {
+		return strings.ToLower(agentInfo.Region)
+	}
+	topicSplit := strings.Split(topic, "_")
+	return strings.ToUpper(topicSplit[len(topicSplit)-1])
+}
+
+/** ### Namespace, Region Policy End ### */
@@ -122,6 +122,13 @@ func (a *Aggregator) AggregateMetric(kafkaAdminClient *kafka.AdminClient, kafkaC
 		return
 	}
 
+	// 메세지가 수신된 토픽(에이전트) 하트비트 갱신
+	if agentInfo != nil {
+		if err := agentmetadata.UpdateAgentLastSeen(topic, time.Now()); err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to update agent last seen with UUID %s, error=%s", topic, err))
+		}
+	}
+
 	// 토픽 데이터 처리 시 에이전트 메타데이터 헬스상태 변경
 	if agentInfo != nil {
 		if agentmetadata.AgentHealth(agentInfo.AgentHealth) == agentmetadata.Unhealthy {
@@ -199,7 +206,7 @@ func (a *Aggregator) aggregateNodeMetric(metrics []TelegrafMetric, metricName st
 			return metric.Tags["node_name"] == nodeName
 		})
 		nodeMetric := aggregateMetric(metricName, currentNodeMetricArr.([]TelegrafMetric), string(a.AggregateType))
-		err := v1.GetInstance().WriteOnDemandMetric(v1.DefaultDatabase, nodeMetric.Name, nodeMetric.Tags, nodeMetric.Fields)
+		err := v1.GetWritePipeline().WriteOnDemandMetric(v1.DefaultDatabase, nodeMetric.Name, nodeMetric.Tags, nodeMetric.Fields)
 		if err != nil {
 			util.GetLogger().Error(fmt.Sprintf("failed to write metric, error=%s", err.Error()))
 			continue
@@ -233,7 +240,7 @@ func (a *Aggregator) aggregatePodMetric(metrics []TelegrafMetric, metricName str
 			return metric.Tags["pod_name"] == podName
 		})
 		podMetric := aggregateMetric(metricName, currentPodMetricArr.([]TelegrafMetric), string(a.AggregateType))
-		err := v1.GetInstance().WriteOnDemandMetric(v1.DefaultDatabase, podMetric.Name, podMetric.Tags, podMetric.Fields)
+		err := v1.GetWritePipeline().WriteOnDemandMetric(v1.DefaultDatabase, podMetric.Name, podMetric.Tags, podMetric.Fields)
 		if err != nil {
 			util.GetLogger().Error(fmt.Sprintf("failed to write metric, error=%s", err.Error()))
 			continue
@@ -254,7 +261,7 @@ func (a *Aggregator) aggregateClusterMetric(metrics []TelegrafMetric, metricName
 		return
 	}
 	clusterMetric := aggregateMetric(metricName, clusterMetricArr, string(a.AggregateType))
-	err := v1.GetInstance().WriteOnDemandMetric(v1.DefaultDatabase, clusterMetric.Name, clusterMetric.Tags, clusterMetric.Fields)
+	err := v1.GetWritePipeline().WriteOnDemandMetric(v1.DefaultDatabase, clusterMetric.Name, clusterMetric.Tags, clusterMetric.Fields)
 	if err != nil {
 		util.GetLogger().Error(fmt.Sprintf("failed to write metric, error=%s", err.Error()))
 	}
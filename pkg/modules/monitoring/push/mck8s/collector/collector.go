@@ -1,12 +1,14 @@
 package collector
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
@@ -20,10 +22,19 @@ type MetricCollector struct {
 	Ch                chan string
 }
 
-func (mc *MetricCollector) DoCollect(wg *sync.WaitGroup) error {
+// DoCollect 스케줄러로부터 전달받은 토픽 데이터 수집 (ctx 취소 시 토픽 구독 취소 후 종료)
+func (mc *MetricCollector) DoCollect(ctx context.Context, wg *sync.WaitGroup) error {
 	defer wg.Done()
 	for {
 		select {
+		case <-ctx.Done():
+			mc.KafkaAdminClient.Close()
+			_ = mc.KafkaConsumerConn.Unsubscribe()
+			if err := mc.KafkaConsumerConn.Close(); err != nil {
+				util.GetLogger().Error(fmt.Sprintf("failed to close mck8s collector kafka connection, error=%s", err))
+			}
+			fmt.Printf("[%s] <MCK8S> STOP Group_%d collector\n", time.Now().Format(time.RFC3339), mc.CreateOrder)
+			return nil
 		case chanData := <-mc.Ch:
 			if len(chanData) != 0 {
 
@@ -71,6 +82,11 @@ func NewMetricCollector(aggregateType types.AggregateType, createOrder int) (Met
 		"enable.auto.commit": true,
 		"auto.offset.reset":  "earliest",
 	}
+	if err := kafkasec.ApplySecurityConfig(kafkaConfig, config.GetInstance().Kafka); err != nil {
+		errMsg := fmt.Sprintf("fail to create mck8s metic collector, kafka security config failed with error=%s", err)
+		util.GetLogger().Error(errMsg)
+		return MetricCollector{}, errors.New(errMsg)
+	}
 
 	// kafka 관리자 커넥션 설정
 	kafkaAdminClient, err := kafka.NewAdminClient(kafkaConfig)
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	collector2 "github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mck8s/collector"
 	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 
+	v1 "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
@@ -81,6 +85,7 @@ func main() {
 		"enable.auto.commit": true,
 		"auto.offset.reset":  "earliest",
 	}
+	PrintPanicError(kafkasec.ApplySecurityConfig(KafkaConfig, kafkasec.GetSecurityConfigFromEnv()))
 	consumerKafkaConn, err := kafka.NewConsumer(KafkaConfig)
 	PrintPanicError(err)
 	config, errK8s := rest.InClusterConfig()
@@ -101,9 +106,25 @@ func main() {
 	}
 	fmt.Println(fmt.Sprintf("#### Group_%d collector Create ####", createOrder))
 
+	// SIGTERM 수신 시 처리 중인 집계를 마친 후 종료
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	configMapFailCnt := 0
 	for {
-		time.Sleep(time.Duration(collectInterval) * time.Second)
+		select {
+		case <-ctx.Done():
+			// 종료 전 kafka 연결 종료 및 메트릭 쓰기 버퍼 flush
+			_ = mc.KafkaConsumerConn.Unsubscribe()
+			_ = mc.KafkaConsumerConn.Close()
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			if err := v1.GetWritePipeline().Flush(flushCtx); err != nil {
+				fmt.Println(err)
+			}
+			cancel()
+			return
+		case <-time.After(time.Duration(collectInterval) * time.Second):
+		}
 		fmt.Println(fmt.Sprintf("#### Group_%d collector ####", createOrder))
 		fmt.Println("Get ConfigMap")
 		/** Get ConfigMap<Data: Collector UUID Map, BinaryData: Collector Topics> Start */
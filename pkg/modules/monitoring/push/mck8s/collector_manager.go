@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/kafkasec"
 	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/mck8s/collector"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
@@ -24,9 +25,10 @@ type CollectManager struct {
 	CollectorPolicy  string
 	K8sClientSet     *kubernetes.Clientset
 	WaitGroup        *sync.WaitGroup
+	Context          context.Context
 }
 
-func NewCollectorManager(wg *sync.WaitGroup) (*CollectManager, error) {
+func NewCollectorManager(ctx context.Context, wg *sync.WaitGroup) (*CollectManager, error) {
 	manager := CollectManager{}
 	if config.GetInstance().Monitoring.DeployType == types.Helm {
 		if err := manager.InitDFK8sEnv(); err != nil {
@@ -37,6 +39,7 @@ func NewCollectorManager(wg *sync.WaitGroup) (*CollectManager, error) {
 	manager.CollectorAddrMap = map[string]*collector.MetricCollector{}
 	manager.CollectorPolicy = strings.ToUpper(config.GetInstance().Monitoring.MonitoringPolicy)
 	manager.WaitGroup = wg
+	manager.Context = ctx
 	return &manager, nil
 }
 
@@ -65,12 +68,18 @@ func (manager *CollectManager) InitDFK8sEnv() (err error) {
 		}
 		fmt.Println("Created ConfigMap: ", result.GetObjectMeta().GetName())
 	}
+
+	// collector 에 전달할 kafka 비밀번호는 Secret 으로 배포합니다.
+	if kafkasec.IsSecurityEnabled(config.GetInstance().Kafka) {
+		if err = kafkasec.ApplySecuritySecret(manager.K8sClientSet, config.GetInstance().Dragonfly.HelmNamespace, config.GetInstance().Kafka); err != nil {
+			return err
+		}
+	}
 	return
 }
 
 // CreateCollector 콜렉터 생성
 func (manager *CollectManager) CreateCollector(topic string) error {
-	manager.WaitGroup.Add(1)
 	collectorCreateOrder := len(manager.CollectorAddrMap)
 	newCollector, err := collector.NewMetricCollector(
 		types.AggregateType(config.GetInstance().Monitoring.AggregateType),
@@ -94,6 +103,7 @@ func (manager *CollectManager) CreateCollector(topic string) error {
 			{Name: "mck8s_collector_interval", Value: strconv.Itoa(config.GetInstance().Monitoring.MCK8SCollectorInterval)},
 			{Name: "collect_uuid", Value: collectorUUID},
 		}
+		env = append(env, kafkasec.GetSecurityEnv(config.GetInstance().Kafka)...)
 		deploymentTemplate := util.DeploymentTemplate(types.MCK8SDeploymentName, collectorCreateOrder, collectorUUID, env, types.MCK8SCollectorImage)
 		fmt.Println("Creating deployment...")
 		result, err := manager.K8sClientSet.AppsV1().Deployments(config.GetInstance().Dragonfly.HelmNamespace).Create(context.TODO(), deploymentTemplate, metav1.CreateOptions{})
@@ -103,8 +113,9 @@ func (manager *CollectManager) CreateCollector(topic string) error {
 		fmt.Println("Created deployment: ", result.GetObjectMeta().GetName())
 		return nil
 	case types.Dev, types.Compose:
+		manager.WaitGroup.Add(1)
 		go func() {
-			err := newCollector.DoCollect(manager.WaitGroup)
+			err := newCollector.DoCollect(manager.Context, manager.WaitGroup)
 			if err != nil {
 				errMsg := fmt.Sprintf("failed to create collector, error=%s", err.Error())
 				util.GetLogger().Error(errMsg)
@@ -130,7 +141,10 @@ func (manager *CollectManager) DeleteCollector(topic string) error {
 	switch config.GetInstance().Monitoring.DeployType {
 	case types.Dev, types.Compose:
 		// 콜렉터 채널에 종료 요청
-		targetCollector.Ch <- "close"
+		select {
+		case targetCollector.Ch <- "close":
+		case <-manager.Context.Done():
+		}
 	}
 
 	defer func(topicData string) {
@@ -42,7 +42,8 @@ func StartScheduler(collectManager *CollectManager) error {
 
 	// 콜렉터 스케줄러 구동
 	go func() {
-		err = scheduler.DoSchedule()
+		defer collectManager.WaitGroup.Done()
+		err = scheduler.DoSchedule(collectManager.Context)
 		if err != nil {
 			errMsg := fmt.Sprintf("failed to run goroutine, error=%s", err.Error())
 			util.GetLogger().Error(errMsg)
@@ -112,8 +113,8 @@ func NewCollectorScheduler(manager *CollectManager) (*CollectorScheduler, error)
 	return collectorScheduler, nil
 }
 
-// DoSchedule 콜렉터 스케줄러 구동
-func (cScheduler CollectorScheduler) DoSchedule() error {
+// DoSchedule 콜렉터 스케줄러 구동 (ctx 취소 시 토픽 맵 저장 후 종료)
+func (cScheduler CollectorScheduler) DoSchedule(ctx context.Context) error {
 	interval, _ := cbstore.GetInstance().StoreGet(types.MonConfig + "/" + "mck8s_collector_interval")
 	if interval == nil {
 		errMsg := "failed to schedule collectors, err: no collector interval configuration data"
@@ -132,7 +133,13 @@ func (cScheduler CollectorScheduler) DoSchedule() error {
 	for {
 
 		// 설정된 스케줄러 주기 기준 동작
-		time.Sleep(time.Duration(aggregateInterval) * time.Second)
+		select {
+		case <-ctx.Done():
+			cScheduler.WriteCollectorMapToInMemoryDB()
+			fmt.Printf("[%s] <MCK8S> collector scheduler - Stopped ###\n", time.Now().Format(time.RFC3339))
+			return nil
+		case <-time.After(time.Duration(aggregateInterval) * time.Second):
+		}
 
 		// cScheduler.topicQueue 에 담겨 있는 Topic 추가, 삭제 처리 요청들을 각각 addTopicList 와 delTopicList 에 담습니다.
 		var addTopicList []string
@@ -232,7 +239,11 @@ func (cScheduler CollectorScheduler) TriggerCollector() {
 		}
 	case types.Dev, types.Compose:
 		for key, _ := range cScheduler.inMemoryTopicMap.TopicMap {
-			cScheduler.cm.CollectorAddrMap[key].Ch <- key
+			select {
+			case cScheduler.cm.CollectorAddrMap[key].Ch <- key:
+			case <-cScheduler.cm.Context.Done():
+				return
+			}
 		}
 	}
 }
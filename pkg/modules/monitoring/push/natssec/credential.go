@@ -0,0 +1,61 @@
+package natssec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+// GetAgentSubject 에이전트 메트릭 발행 subject ({subject prefix}.{에이전트 UUID})
+func GetAgentSubject(subjectPrefix string, agentUUID string) string {
+	return fmt.Sprintf("%s.%s", subjectPrefix, agentUUID)
+}
+
+// IssueAgentCredential 에이전트 NATS 사용자 자격증명(.creds) 발급
+//   - NATS 계정 nkey seed 로 서명한 사용자 JWT 를 발급하며, NATS 서버는 해당 계정을 신뢰하도록(JWT 인증) 설정되어 있어야 합니다.
+//   - 에이전트는 자신의 subject 에만 publish 할 수 있으며, 다른 에이전트 메트릭을 구독할 수 없도록 subscribe 권한은 부여하지 않습니다.
+//   - 반환 값: .creds 파일 내용 (사용자 JWT, 사용자 nkey seed)
+func IssueAgentCredential(accountSeed []byte, agentUUID string, subject string) (string, error) {
+	if agentUUID == "" || subject == "" || strings.ContainsAny(subject, "*> ") {
+		return "", errors.New(fmt.Sprintf("invalid agent nats subject %s", subject))
+	}
+	accountKey, err := nkeys.FromSeed(bytes.TrimSpace(accountSeed))
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to load nats account seed, error=%s", err))
+	}
+	accountPublicKey, err := accountKey.PublicKey()
+	if err != nil || !nkeys.IsValidPublicAccountKey(accountPublicKey) {
+		return "", errors.New("nats account seed is not an account nkey")
+	}
+
+	userKey, err := nkeys.CreateUser()
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to create nats user nkey, error=%s", err))
+	}
+	userPublicKey, err := userKey.PublicKey()
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to get nats user public key, error=%s", err))
+	}
+	userSeed, err := userKey.Seed()
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to get nats user seed, error=%s", err))
+	}
+
+	claims := jwt.NewUserClaims(userPublicKey)
+	claims.Name = agentUUID
+	claims.Pub.Allow.Add(subject)
+	claims.Sub.Deny.Add(">")
+	userJWT, err := claims.Encode(accountKey)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to encode nats user jwt, error=%s", err))
+	}
+	creds, err := jwt.FormatUserConfig(userJWT, userSeed)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("failed to format nats user credentials, error=%s", err))
+	}
+	return string(creds), nil
+}
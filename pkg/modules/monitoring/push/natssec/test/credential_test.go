@@ -0,0 +1,109 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/natssec"
+)
+
+const agentUUID = "ns01_mcis_mcis01_vm01_aws"
+
+func createSeed(t *testing.T, createFn func() (nkeys.KeyPair, error)) ([]byte, string) {
+	t.Helper()
+	keyPair, err := createFn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed, err := keyPair.Seed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := keyPair.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return seed, publicKey
+}
+
+func TestGetAgentSubject(t *testing.T) {
+	if subject := natssec.GetAgentSubject("cbmetrics", agentUUID); subject != "cbmetrics."+agentUUID {
+		t.Errorf("agent subject, actual=%s", subject)
+	}
+}
+
+func TestIssueAgentCredential(t *testing.T) {
+	accountSeed, accountPublicKey := createSeed(t, nkeys.CreateAccount)
+	subject := natssec.GetAgentSubject("cbmetrics", agentUUID)
+
+	// seed 파일 끝의 개행 문자는 무시
+	creds, err := natssec.IssueAgentCredential(append(accountSeed, '\n'), agentUUID, subject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userJWT, err := jwt.ParseDecoratedJWT([]byte(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := jwt.DecodeUserClaims(userJWT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Issuer != accountPublicKey {
+		t.Errorf("jwt issuer, expected=%s, actual=%s", accountPublicKey, claims.Issuer)
+	}
+	if claims.Name != agentUUID {
+		t.Errorf("jwt name, expected=%s, actual=%s", agentUUID, claims.Name)
+	}
+	if !reflect.DeepEqual([]string(claims.Pub.Allow), []string{subject}) {
+		t.Errorf("publish allow, expected=[%s], actual=%v", subject, claims.Pub.Allow)
+	}
+	if len(claims.Pub.Deny) != 0 || len(claims.Sub.Allow) != 0 {
+		t.Errorf("unexpected permission, pub deny=%v, sub allow=%v", claims.Pub.Deny, claims.Sub.Allow)
+	}
+	if !reflect.DeepEqual([]string(claims.Sub.Deny), []string{">"}) {
+		t.Errorf("subscribe deny, expected=[>], actual=%v", claims.Sub.Deny)
+	}
+	userKey, err := jwt.ParseDecoratedNKey([]byte(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userPublicKey, _ := userKey.PublicKey(); userPublicKey != claims.Subject {
+		t.Errorf("creds nkey does not match jwt subject, nkey=%s, subject=%s", userPublicKey, claims.Subject)
+	}
+
+	// 발급할 때마다 새로운 사용자 nkey 사용
+	otherCreds, err := natssec.IssueAgentCredential(accountSeed, agentUUID, subject)
+	if err != nil || otherCreds == creds {
+		t.Errorf("reissued creds, equal=%v, error=%v", otherCreds == creds, err)
+	}
+}
+
+func TestIssueAgentCredentialInvalid(t *testing.T) {
+	accountSeed, _ := createSeed(t, nkeys.CreateAccount)
+	userSeed, _ := createSeed(t, nkeys.CreateUser)
+
+	testCases := []struct {
+		name      string
+		seed      []byte
+		agentUUID string
+		subject   string
+	}{
+		{name: "empty agent uuid", seed: accountSeed, agentUUID: "", subject: "cbmetrics." + agentUUID},
+		{name: "empty subject", seed: accountSeed, agentUUID: agentUUID, subject: ""},
+		{name: "wildcard subject", seed: accountSeed, agentUUID: agentUUID, subject: "cbmetrics.*"},
+		{name: "full wildcard subject", seed: accountSeed, agentUUID: agentUUID, subject: "cbmetrics.>"},
+		{name: "invalid seed", seed: []byte("invalid"), agentUUID: agentUUID, subject: "cbmetrics." + agentUUID},
+		{name: "user seed", seed: userSeed, agentUUID: agentUUID, subject: "cbmetrics." + agentUUID},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := natssec.IssueAgentCredential(tc.seed, tc.agentUUID, tc.subject); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
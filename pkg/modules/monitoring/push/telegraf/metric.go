@@ -0,0 +1,80 @@
+package telegraf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+// Metric telegraf 메트릭 (kafka, HTTP, NATS 수신 공통 형식)
+type Metric struct {
+	Name      string                 `json:"name"`
+	Tags      map[string]interface{} `json:"tags"`
+	Fields    map[string]interface{} `json:"fields"`
+	Timestamp int64                  `json:"timestamp"`
+	TagInfo   map[string]interface{} `json:"tagInfo"`
+}
+
+// ParseLineProtocol influx line protocol 메트릭 파싱
+//   - precision 은 influx write API 와 동일하게 n, u, ms, s 를 지원하며, 기본값은 n 입니다.
+//   - 필드 값은 kafka(telegraf json) 수신 메트릭과 동일하게 숫자는 float64, 문자열은 그대로 변환합니다.
+func ParseLineProtocol(body []byte, precision string) ([]Metric, error) {
+	if precision == "" {
+		precision = "n"
+	}
+	points, err := models.ParsePointsWithPrecision(body, time.Now().UTC(), precision)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to parse line protocol, error=%s", err))
+	}
+
+	metrics := make([]Metric, 0, len(points))
+	for _, point := range points {
+		fields, err := point.Fields()
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to parse line protocol fields, error=%s", err))
+		}
+		metric := Metric{
+			Name:      string(point.Name()),
+			Tags:      map[string]interface{}{},
+			Fields:    map[string]interface{}{},
+			Timestamp: point.Time().Unix(),
+		}
+		for _, tag := range point.Tags() {
+			metric.Tags[string(tag.Key)] = string(tag.Value)
+		}
+		for key, val := range fields {
+			switch fieldVal := val.(type) {
+			case int64:
+				metric.Fields[key] = float64(fieldVal)
+			case uint64:
+				metric.Fields[key] = float64(fieldVal)
+			default:
+				metric.Fields[key] = fieldVal
+			}
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
+
+// ParseJSON telegraf json 메트릭 파싱 (단일 메트릭, {"metrics": [...]} 배치 형식 지원)
+func ParseJSON(body []byte) ([]Metric, error) {
+	batch := struct {
+		Metrics []Metric `json:"metrics"`
+	}{}
+	if err := json.Unmarshal(body, &batch); err == nil && len(batch.Metrics) != 0 {
+		return batch.Metrics, nil
+	}
+
+	metric := Metric{}
+	if err := json.Unmarshal(body, &metric); err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to parse telegraf json, error=%s", err))
+	}
+	if metric.Name == "" {
+		return nil, errors.New("failed to parse telegraf json, metric name is empty")
+	}
+	return []Metric{metric}, nil
+}
@@ -0,0 +1,160 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/modules/monitoring/push/telegraf"
+)
+
+func TestParseLineProtocol(t *testing.T) {
+	testCases := []struct {
+		name        string
+		body        string
+		precision   string
+		expectedErr bool
+		expected    []telegraf.Metric
+	}{
+		{
+			name: "default precision",
+			body: "cpu,cpu=cpu-total,host=vm01 usage_user=1.5,usage_system=2i 1704067200000000000",
+			expected: []telegraf.Metric{{
+				Name:      "cpu",
+				Tags:      map[string]interface{}{"cpu": "cpu-total", "host": "vm01"},
+				Fields:    map[string]interface{}{"usage_user": 1.5, "usage_system": float64(2)},
+				Timestamp: 1704067200,
+			}},
+		},
+		{
+			name:      "second precision",
+			body:      "mem used_percent=40 1704067200",
+			precision: "s",
+			expected: []telegraf.Metric{{
+				Name:      "mem",
+				Tags:      map[string]interface{}{},
+				Fields:    map[string]interface{}{"used_percent": float64(40)},
+				Timestamp: 1704067200,
+			}},
+		},
+		{
+			name:      "millisecond precision",
+			body:      "mem used_percent=40 1704067200500",
+			precision: "ms",
+			expected: []telegraf.Metric{{
+				Name:      "mem",
+				Tags:      map[string]interface{}{},
+				Fields:    map[string]interface{}{"used_percent": float64(40)},
+				Timestamp: 1704067200,
+			}},
+		},
+		{
+			name: "integer, string, bool fields",
+			body: "system,host=vm01 uptime=100i,uptime_format=\"0:01\",healthy=true 1704067200000000000\n",
+			expected: []telegraf.Metric{{
+				Name:      "system",
+				Tags:      map[string]interface{}{"host": "vm01"},
+				Fields:    map[string]interface{}{"uptime": float64(100), "uptime_format": "0:01", "healthy": true},
+				Timestamp: 1704067200,
+			}},
+		},
+		{
+			name: "multiple lines",
+			body: "cpu usage_user=1 1704067200000000000\nmem used_percent=2 1704067210000000000",
+			expected: []telegraf.Metric{
+				{Name: "cpu", Tags: map[string]interface{}{}, Fields: map[string]interface{}{"usage_user": float64(1)}, Timestamp: 1704067200},
+				{Name: "mem", Tags: map[string]interface{}{}, Fields: map[string]interface{}{"used_percent": float64(2)}, Timestamp: 1704067210},
+			},
+		},
+		{
+			name:        "missing field",
+			body:        "cpu,host=vm01 1704067200000000000",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid field value",
+			body:        "cpu usage_user=abc 1704067200000000000",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid timestamp",
+			body:        "cpu usage_user=1 now",
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metrics, err := telegraf.ParseLineProtocol([]byte(tc.body), tc.precision)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("error, expected=%v, actual=%v", tc.expectedErr, err)
+			}
+			if !tc.expectedErr && !reflect.DeepEqual(metrics, tc.expected) {
+				t.Errorf("metrics, expected=%+v, actual=%+v", tc.expected, metrics)
+			}
+		})
+	}
+}
+
+func TestParseLineProtocolWithoutTimestamp(t *testing.T) {
+	metrics, err := telegraf.ParseLineProtocol([]byte("cpu usage_user=1"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// timestamp 가 없을 경우 수신 시각 사용
+	if len(metrics) != 1 || metrics[0].Timestamp == 0 {
+		t.Errorf("metrics without timestamp, actual=%+v", metrics)
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	testCases := []struct {
+		name        string
+		body        string
+		expectedErr bool
+		expected    []telegraf.Metric
+	}{
+		{
+			name: "single metric",
+			body: `{"name":"cpu","tags":{"host":"vm01"},"fields":{"usage_user":1.5},"timestamp":1704067200}`,
+			expected: []telegraf.Metric{{
+				Name:      "cpu",
+				Tags:      map[string]interface{}{"host": "vm01"},
+				Fields:    map[string]interface{}{"usage_user": 1.5},
+				Timestamp: 1704067200,
+			}},
+		},
+		{
+			name: "batch metrics",
+			body: `{"metrics":[{"name":"cpu","fields":{"usage_user":1},"timestamp":1704067200},{"name":"mem","fields":{"used_percent":2},"timestamp":1704067210}]}`,
+			expected: []telegraf.Metric{
+				{Name: "cpu", Fields: map[string]interface{}{"usage_user": float64(1)}, Timestamp: 1704067200},
+				{Name: "mem", Fields: map[string]interface{}{"used_percent": float64(2)}, Timestamp: 1704067210},
+			},
+		},
+		{
+			name:        "empty batch",
+			body:        `{"metrics":[]}`,
+			expectedErr: true,
+		},
+		{
+			name:        "empty metric name",
+			body:        `{"fields":{"usage_user":1},"timestamp":1704067200}`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid json",
+			body:        `cpu usage_user=1`,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metrics, err := telegraf.ParseJSON([]byte(tc.body))
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("error, expected=%v, actual=%v", tc.expectedErr, err)
+			}
+			if !tc.expectedErr && !reflect.DeepEqual(metrics, tc.expected) {
+				t.Errorf("metrics, expected=%+v, actual=%+v", tc.expected, metrics)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/api/core/agent"
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+)
+
+const (
+	defaultReconcileInterval = 300
+)
+
+// Reconciler Tumblebug 인벤토리 기반 에이전트 메타데이터 정합성 주기 점검
+type Reconciler struct {
+	WaitGroup *sync.WaitGroup
+}
+
+func NewReconciler(wg *sync.WaitGroup) (*Reconciler, error) {
+	return &Reconciler{WaitGroup: wg}, nil
+}
+
+// StartReconcile 점검 주기마다 인벤토리와 에이전트 메타데이터 비교 후 조치 (ctx 취소 시 종료)
+func (r *Reconciler) StartReconcile(ctx context.Context) {
+	defer r.WaitGroup.Done()
+	for {
+		interval := config.GetInstance().Reconcile.Interval
+		if interval <= 0 {
+			interval = defaultReconcileInterval
+		}
+
+		result, _, err := agent.ReconcileAgent(false)
+		if err != nil {
+			util.GetLogger().Error(fmt.Sprintf("failed to reconcile agent, error=%s", err))
+		} else {
+			for _, event := range result.Events {
+				fmt.Printf("[%s] <RECONCILE> %s %s %s\n", time.Now().Format(time.RFC3339), event.AgentUUID, event.Action, event.Detail)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+	}
+}
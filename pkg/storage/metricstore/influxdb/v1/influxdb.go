@@ -10,13 +10,31 @@ import (
 
 	"github.com/cloud-barista/cb-dragonfly/pkg/config"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+	"github.com/influxdata/influxdb1-client/models"
 	influxdbClient "github.com/influxdata/influxdb1-client/v2"
 )
 
 const (
-	DefaultDatabase       = "cbmon"
-	PullDatabase          = "cbmonpull"
-	CBRetentionPolicyName = "df_rp"
+	DefaultDatabase          = "cbmon"
+	PullDatabase             = "cbmonpull"
+	RawDatabase              = "cbmonraw"
+	BenchmarkDatabase        = "cbmonbench"
+	FlowDatabase             = "cbmonflow"
+	ProcessDatabase          = "cbmonproc"
+	CBRetentionPolicyName    = "df_rp"
+	DefaultRawRPDuration     = "1d"
+	DefaultBenchRPDuration   = "52w"
+	DefaultFlowRPDuration    = "7d"
+	DefaultProcessRPDuration = "7d"
+
+	// BenchmarkMeasurement 벤치마크 결과 저장 measurement
+	BenchmarkMeasurement = "benchmark"
+	// LatencyMeasurement MCIS VM 간 지연 시간 저장 measurement (알람 태스크 대상이 되도록 기본 데이터베이스에 저장)
+	LatencyMeasurement = "latency"
+	// ProcessMeasurement VM 상위 프로세스 사용량 저장 measurement
+	ProcessMeasurement = "process"
+	// FlowMeasurement VM 간 패킷 흐름 저장 measurement
+	FlowMeasurement = "packet_flow"
 )
 
 type Config struct {
@@ -84,6 +102,30 @@ func (s Storage) Initialize() error {
 	// ignore the error of existing database
 	client.Query(q2)
 
+	q3 := influxdbClient.Query{
+		Command: fmt.Sprintf("create database %s", RawDatabase),
+	}
+	// ignore the error of existing database
+	client.Query(q3)
+
+	q4 := influxdbClient.Query{
+		Command: fmt.Sprintf("create database %s", BenchmarkDatabase),
+	}
+	// ignore the error of existing database
+	client.Query(q4)
+
+	q5 := influxdbClient.Query{
+		Command: fmt.Sprintf("create database %s", FlowDatabase),
+	}
+	// ignore the error of existing database
+	client.Query(q5)
+
+	q6 := influxdbClient.Query{
+		Command: fmt.Sprintf("create database %s", ProcessDatabase),
+	}
+	// ignore the error of existing database
+	client.Query(q6)
+
 	// cbmon rp 조회 후 없을 시 rp 생성
 	if isRPonCBMonExist := s.checkDBRetionPolicy(client, DefaultDatabase); !isRPonCBMonExist {
 		createRPq1 := influxdbClient.Query{
@@ -109,6 +151,66 @@ func (s Storage) Initialize() error {
 		}
 	}
 
+	// cbmonraw rp 조회 후 없을 시 rp 생성 (raw 모드 원본 샘플은 짧은 보관 기간 적용)
+	if isRPonCBMonRawExist := s.checkDBRetionPolicy(client, RawDatabase); !isRPonCBMonRawExist {
+		rawRPDuration := config.GetInstance().InfluxDB.RawRetentionPolicyDuration
+		if rawRPDuration == "" {
+			rawRPDuration = DefaultRawRPDuration
+		}
+		createRPq3 := influxdbClient.Query{
+			Command: fmt.Sprintf("create retention policy %s on %s duration %s replication 1 default", CBRetentionPolicyName, RawDatabase, rawRPDuration),
+		}
+		_, err := client.Query(createRPq3)
+		if err != nil {
+			return err
+		}
+	}
+
+	// cbmonbench rp 조회 후 없을 시 rp 생성 (벤치마크 결과는 성능 추이 비교를 위해 긴 보관 기간 적용)
+	if isRPonCBMonBenchExist := s.checkDBRetionPolicy(client, BenchmarkDatabase); !isRPonCBMonBenchExist {
+		benchRPDuration := config.GetInstance().InfluxDB.BenchmarkRetentionPolicyDuration
+		if benchRPDuration == "" {
+			benchRPDuration = DefaultBenchRPDuration
+		}
+		createRPq4 := influxdbClient.Query{
+			Command: fmt.Sprintf("create retention policy %s on %s duration %s replication 1 default", CBRetentionPolicyName, BenchmarkDatabase, benchRPDuration),
+		}
+		_, err := client.Query(createRPq4)
+		if err != nil {
+			return err
+		}
+	}
+
+	// cbmonflow rp 조회 후 없을 시 rp 생성 (패킷 흐름 요약은 짧은 보관 기간 적용)
+	if isRPonCBMonFlowExist := s.checkDBRetionPolicy(client, FlowDatabase); !isRPonCBMonFlowExist {
+		flowRPDuration := config.GetInstance().InfluxDB.FlowRetentionPolicyDuration
+		if flowRPDuration == "" {
+			flowRPDuration = DefaultFlowRPDuration
+		}
+		createRPq5 := influxdbClient.Query{
+			Command: fmt.Sprintf("create retention policy %s on %s duration %s replication 1 default", CBRetentionPolicyName, FlowDatabase, flowRPDuration),
+		}
+		_, err := client.Query(createRPq5)
+		if err != nil {
+			return err
+		}
+	}
+
+	// cbmonproc rp 조회 후 없을 시 rp 생성 (상위 프로세스 사용량은 짧은 보관 기간 적용)
+	if isRPonCBMonProcExist := s.checkDBRetionPolicy(client, ProcessDatabase); !isRPonCBMonProcExist {
+		procRPDuration := config.GetInstance().InfluxDB.ProcessRetentionPolicyDuration
+		if procRPDuration == "" {
+			procRPDuration = DefaultProcessRPDuration
+		}
+		createRPq6 := influxdbClient.Query{
+			Command: fmt.Sprintf("create retention policy %s on %s duration %s replication 1 default", CBRetentionPolicyName, ProcessDatabase, procRPDuration),
+		}
+		_, err := client.Query(createRPq6)
+		if err != nil {
+			return err
+		}
+	}
+
 	s.Client = client
 	storage = s
 	return nil
@@ -171,18 +273,32 @@ func (s Storage) WriteMetric(dbName string, metrics map[string]interface{}) erro
 	if err != nil {
 		return err
 	}
+	bp.AddPoints(MakeMetricPoints(metrics))
 
-	var tagInfo map[string]string
-	tagInfo = nil
-	now := time.Now().UTC()
+	if err := s.Client.Write(bp); err != nil {
+		util.GetLogger().Error("failed to write InfluxDB")
+		return err
+	}
+	return nil
+}
+
+// MakeMetricPoints 집계 메트릭(에이전트 별 tagInfo, 메트릭 필드) 을 현재 시각 기준 InfluxDB point 로 변환
+func MakeMetricPoints(metrics map[string]interface{}) []*influxdbClient.Point {
+	return MakeMetricPointsAt(metrics, time.Now().UTC())
+}
+
+// MakeMetricPointsAt 집계 메트릭을 지정한 시각 기준 InfluxDB point 로 변환
+//   - 같은 시각, 같은 태그의 point 는 InfluxDB 에서 덮어쓰므로 집계 윈도우 갱신 시 활용합니다.
+func MakeMetricPointsAt(metrics map[string]interface{}, timestamp time.Time) []*influxdbClient.Point {
+	var points []*influxdbClient.Point
 
 	for _, metricVal := range metrics {
 		metricValMap := metricVal.(map[string]interface{})
-		if tagInfo == nil {
-			tagInfo = metricValMap["tagInfo"].(map[string]string)
-		}
-		delete(metricValMap, "tagInfo")
+		tagInfo, _ := metricValMap["tagInfo"].(map[string]string)
 		for metricName, metric := range metricValMap {
+			if metricName == "tagInfo" {
+				continue
+			}
 			convertedMetric := metric.(map[string]interface{})
 			if len(convertedMetric) > 0 {
 				for k, metricval := range convertedMetric {
@@ -190,15 +306,40 @@ func (s Storage) WriteMetric(dbName string, metrics map[string]interface{}) erro
 						delete(convertedMetric, k)
 					}
 				}
-				metricPoint, err := influxdbClient.NewPoint(metricName, tagInfo, convertedMetric, now)
+				metricPoint, err := influxdbClient.NewPoint(metricName, tagInfo, convertedMetric, timestamp)
 				if err != nil {
 					util.GetLogger().Error("failed to create InfluxDB metricVal point: ", err)
 					continue
 				}
-				bp.AddPoint(metricPoint)
+				points = append(points, metricPoint)
 			}
 		}
 	}
+	return points
+}
+
+// MakeOnDemandPoint 분 단위 시각 기준 메트릭 point 생성
+func MakeOnDemandPoint(metricName string, tagArr map[string]string, metricVal map[string]interface{}) (*influxdbClient.Point, error) {
+	now := time.Now().UTC()
+	timestamp := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), 0, 0, now.Location())
+	return influxdbClient.NewPoint(metricName, tagArr, metricVal, timestamp)
+}
+
+func (s Storage) WriteOnDemandMetric(dbName string, metricName string, tagArr map[string]string, metricVal map[string]interface{}) error {
+	bp, err := influxdbClient.NewBatchPoints(influxdbClient.BatchPointsConfig{
+		Database: dbName,
+	})
+	if err != nil {
+		util.GetLogger().Error("failed to create InfluxDB metric point: ", err)
+		return err
+	}
+
+	metricPoint, err := MakeOnDemandPoint(metricName, tagArr, metricVal)
+	if err != nil {
+		util.GetLogger().Error("failed to create InfluxDB metric point: ", err)
+		return err
+	}
+	bp.AddPoint(metricPoint)
 
 	if err := s.Client.Write(bp); err != nil {
 		util.GetLogger().Error("failed to write InfluxDB")
@@ -207,23 +348,22 @@ func (s Storage) WriteMetric(dbName string, metrics map[string]interface{}) erro
 	return nil
 }
 
-func (s Storage) WriteOnDemandMetric(dbName string, metricName string, tagArr map[string]string, metricVal map[string]interface{}) error {
+// WritePoint 단일 포인트를 지정한 시각으로 저장 (이벤트성 데이터 저장 시 활용)
+func (s Storage) WritePoint(dbName string, measurement string, tagArr map[string]string, fieldArr map[string]interface{}, timestamp time.Time) error {
 	bp, err := influxdbClient.NewBatchPoints(influxdbClient.BatchPointsConfig{
 		Database: dbName,
 	})
 	if err != nil {
-		util.GetLogger().Error("failed to create InfluxDB metric point: ", err)
+		util.GetLogger().Error("failed to create InfluxDB batch points: ", err)
 		return err
 	}
 
-	now := time.Now().UTC()
-	timestamp := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), 0, 0, now.Location())
-	metricPoint, err := influxdbClient.NewPoint(metricName, tagArr, metricVal, timestamp)
+	point, err := influxdbClient.NewPoint(measurement, tagArr, fieldArr, timestamp.UTC())
 	if err != nil {
-		util.GetLogger().Error("failed to create InfluxDB metric point: ", err)
+		util.GetLogger().Error("failed to create InfluxDB point: ", err)
 		return err
 	}
-	bp.AddPoint(metricPoint)
+	bp.AddPoint(point)
 
 	if err := s.Client.Write(bp); err != nil {
 		util.GetLogger().Error("failed to write InfluxDB")
@@ -262,7 +402,13 @@ func (s Storage) ReadMetric(info types.DBMetricRequestInfo) (interface{}, error)
 	var queryString string
 	var err error
 
-	queryString, err = BuildQuery(info)
+	if info.Resolution == types.RawResolution {
+		// raw 모드 원본 샘플 조회
+		database = RawDatabase
+		queryString, err = BuildRawQuery(info)
+	} else {
+		queryString, err = BuildQuery(info)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -279,6 +425,56 @@ func (s Storage) ReadMetric(info types.DBMetricRequestInfo) (interface{}, error)
 	return nil, nil
 }
 
+// ReadMCISLatestMetric MCIS VM 별 최신 메트릭 조회 (vmId 태그 별 시리즈 목록 반환)
+func (s Storage) ReadMCISLatestMetric(database string, measurement string, nsId string, mcisId string, duration string, perSec bool, fieldArr ...string) ([]models.Row, error) {
+	return s.readSeries(database, BuildMCISLatestQuery(measurement, nsId, mcisId, duration, perSec, fieldArr...))
+}
+
+// ReadMCISStatMetric MCIS VM 별 기간 통계 조회 (vmId 태그 별 시리즈 목록 반환)
+func (s Storage) ReadMCISStatMetric(database string, measurement string, nsId string, mcisId string, duration string, selectArr ...string) ([]models.Row, error) {
+	return s.readSeries(database, BuildMCISStatQuery(measurement, nsId, mcisId, duration, selectArr...))
+}
+
+// ReadBenchmark 벤치마크 결과 조회 (groupByArr 태그 별 시리즈 목록 반환)
+func (s Storage) ReadBenchmark(duration string, tagFilter map[string]string, groupByArr []string, selectArr ...string) ([]models.Row, error) {
+	return s.readSeries(BenchmarkDatabase, BuildBenchmarkQuery(duration, tagFilter, groupByArr, selectArr...))
+}
+
+// ReadLatency MCIS VM 간 지연 시간 조회
+func (s Storage) ReadLatency(duration string, tagFilter map[string]string, groupByArr []string, selectArr ...string) ([]models.Row, error) {
+	return s.readSeries(DefaultDatabase, BuildLatencyQuery(duration, tagFilter, groupByArr, selectArr...))
+}
+
+// ReadProcess VM 프로세스 사용량 조회
+func (s Storage) ReadProcess(start time.Time, end time.Time, tagFilter map[string]string, groupByArr []string, selectArr ...string) ([]models.Row, error) {
+	return s.readSeries(ProcessDatabase, BuildProcessQuery(start, end, tagFilter, groupByArr, selectArr...))
+}
+
+// ReadFlow MCIS 패킷 흐름 조회
+func (s Storage) ReadFlow(duration string, tagFilter map[string]string, groupByArr []string, selectArr ...string) ([]models.Row, error) {
+	return s.readSeries(FlowDatabase, BuildFlowQuery(duration, tagFilter, groupByArr, selectArr...))
+}
+
+// ReadPullWindow PULL 수집 원본 메트릭 집계 구간 조회 (태그 조합 별 시리즈 목록 반환)
+func (s Storage) ReadPullWindow(measurement string, start time.Time, end time.Time) ([]models.Row, error) {
+	return s.readSeries(PullDatabase, BuildPullWindowQuery(measurement, start, end))
+}
+
+func (s Storage) readSeries(database string, queryString string) ([]models.Row, error) {
+	query := influxdbClient.NewQuery(queryString, database, "")
+	res, err := s.Client.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	if res.Err != "" {
+		return nil, errors.New(res.Err)
+	}
+	if len(res.Results) > 0 {
+		return res.Results[0].Series, nil
+	}
+	return nil, nil
+}
+
 func (s Storage) DeleteMetric(database string, metric, duration string) error {
 	whereQuery := "DELETE FROM \"%s\" WHERE time < now() + 1m - %s"
 	query := influxdbClient.NewQuery(fmt.Sprintf(whereQuery, metric, duration), database, "")
@@ -288,3 +484,16 @@ func (s Storage) DeleteMetric(database string, metric, duration string) error {
 	}
 	return nil
 }
+
+// DeleteMetricBefore 지정 시각 이전 메트릭 삭제
+func (s Storage) DeleteMetricBefore(database string, metric string, before time.Time) error {
+	queryString := fmt.Sprintf("DELETE FROM \"%s\" WHERE time < '%s'", metric, before.UTC().Format(time.RFC3339Nano))
+	res, err := s.Client.Query(influxdbClient.NewQuery(queryString, database, ""))
+	if err != nil {
+		return err
+	}
+	if res.Err != "" {
+		return errors.New(res.Err)
+	}
+	return nil
+}
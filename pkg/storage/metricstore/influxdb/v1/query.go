@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+	"sort"
 	"strings"
 	"time"
 
+	influxdbmetric "github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/metric"
+
 	influxBuilder "github.com/Scalingo/go-utils/influx"
 )
 
@@ -291,3 +294,123 @@ func getPerSecMetric(info types.DBMetricRequestInfo, fieldArr ...string) string
 
 	return query
 }
+
+// BuildRawQuery raw 모드 원본 샘플 조회 쿼리 생성
+//   - 집계 함수, 시간 그룹 없이 수집 시각 기준으로 조회합니다.
+//   - diskio, network 메트릭은 초당 변화량이 아닌 누적 카운터 값을 조회합니다.
+//   - 조회 필드 순서는 메트릭 매핑(MappingMonMetric) 필드 순서와 동일합니다.
+func BuildRawQuery(info types.DBMetricRequestInfo) (string, error) {
+	if !util.CheckMCISType(info.ServiceType) {
+		return "", errors.New(fmt.Sprintf("raw resolution is not supported for %s", info.ServiceType))
+	}
+
+	var measurement string
+	var fieldArr []string
+	switch info.MetricName {
+	case string(types.Cpu):
+		measurement = info.MetricName
+		fieldArr = influxdbmetric.Cpu{}.GetField()
+	case string(types.CpuFrequency):
+		measurement = info.MetricName
+		fieldArr = influxdbmetric.Cpufreq{}.GetField()
+	case string(types.Memory):
+		measurement = "mem"
+		fieldArr = influxdbmetric.Memory{}.GetField()
+	case string(types.Disk):
+		measurement = info.MetricName
+		fieldArr = influxdbmetric.Disk{}.GetField()
+	case string(types.DiskIO):
+		measurement = info.MetricName
+		fieldArr = influxdbmetric.DiskIO{}.GetField()
+	case string(types.Network):
+		measurement = "net"
+		fieldArr = influxdbmetric.Network{}.GetField()
+	default:
+		return "", errors.New("not found metric")
+	}
+
+	fieldQueryArr := make([]string, len(fieldArr))
+	for idx, field := range fieldArr {
+		fieldQueryArr[idx] = fmt.Sprintf("\"%s\"", field)
+	}
+	queryForm := "SELECT %s FROM \"%s\" WHERE time > (now()+1m) - %s AND \"vmId\"='%s' GROUP BY \"vmId\" ORDER BY time ASC"
+	return fmt.Sprintf(queryForm, strings.Join(fieldQueryArr, ", "), measurement, info.Duration, info.VMID), nil
+}
+
+// BuildMCISLatestQuery MCIS VM 별 최신 메트릭 조회 쿼리 생성 (vmId 태그 기준 그룹)
+//   - perSec 가 true 일 경우 누적 카운터 필드(diskio, network)의 1분 단위 초당 변화량을 조회합니다.
+func BuildMCISLatestQuery(measurement string, nsId string, mcisId string, duration string, perSec bool, fieldArr ...string) string {
+	selectArr := make([]string, len(fieldArr))
+	for idx, field := range fieldArr {
+		if perSec {
+			selectArr[idx] = fmt.Sprintf("non_negative_derivative(last(\"%s\"), 1s) AS \"%s\"", field, field)
+		} else {
+			selectArr[idx] = fmt.Sprintf("last(\"%s\") AS \"%s\"", field, field)
+		}
+	}
+	if !perSec {
+		return BuildMCISStatQuery(measurement, nsId, mcisId, duration, selectArr...)
+	}
+	query := fmt.Sprintf("SELECT %s FROM \"%s\" WHERE time > now() - %s AND \"nsId\"='%s' AND \"mcisId\"='%s'", strings.Join(selectArr, ", "), measurement, duration, nsId, mcisId)
+	return query + " GROUP BY time(1m), \"vmId\" fill(none)"
+}
+
+// BuildMCISStatQuery MCIS VM 별 기간 통계 조회 쿼리 생성 (vmId 태그 기준 그룹)
+//   - selectArr 는 집계 함수를 포함한 조회 필드 (예: percentile("cpu_utilization", 95) AS "cpu_p95") 입니다.
+func BuildMCISStatQuery(measurement string, nsId string, mcisId string, duration string, selectArr ...string) string {
+	return fmt.Sprintf("SELECT %s FROM \"%s\" WHERE time > now() - %s AND \"nsId\"='%s' AND \"mcisId\"='%s' GROUP BY \"vmId\"", strings.Join(selectArr, ", "), measurement, duration, nsId, mcisId)
+}
+
+// BuildBenchmarkQuery 벤치마크 결과 조회 쿼리 생성
+//   - tagFilter 는 태그 일치 조건, groupByArr 는 시리즈 구분 태그 목록입니다.
+func BuildBenchmarkQuery(duration string, tagFilter map[string]string, groupByArr []string, selectArr ...string) string {
+	return buildTagFilterQuery(BenchmarkMeasurement, fmt.Sprintf("time > now() - %s", duration), tagFilter, groupByArr, selectArr...)
+}
+
+// BuildLatencyQuery MCIS VM 간 지연 시간 조회 쿼리 생성
+//   - groupByArr 에 time(10m) 형식의 시간 구간을 포함할 수 있습니다.
+func BuildLatencyQuery(duration string, tagFilter map[string]string, groupByArr []string, selectArr ...string) string {
+	return buildTagFilterQuery(LatencyMeasurement, fmt.Sprintf("time > now() - %s", duration), tagFilter, groupByArr, selectArr...)
+}
+
+// BuildFlowQuery MCIS 패킷 흐름 조회 쿼리 생성
+func BuildFlowQuery(duration string, tagFilter map[string]string, groupByArr []string, selectArr ...string) string {
+	return buildTagFilterQuery(FlowMeasurement, fmt.Sprintf("time > now() - %s", duration), tagFilter, groupByArr, selectArr...)
+}
+
+// BuildProcessQuery VM 프로세스 사용량 조회 쿼리 생성 (start, end 시각 범위)
+func BuildProcessQuery(start time.Time, end time.Time, tagFilter map[string]string, groupByArr []string, selectArr ...string) string {
+	timeCondition := fmt.Sprintf("time >= '%s' AND time <= '%s'", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+	return buildTagFilterQuery(ProcessMeasurement, timeCondition, tagFilter, groupByArr, selectArr...)
+}
+
+// BuildPullWindowQuery PULL 수집 원본 메트릭 집계 구간 조회 쿼리 생성 ([start, end) 범위, 태그 조합 별 시리즈 구분)
+func BuildPullWindowQuery(measurement string, start time.Time, end time.Time) string {
+	timeCondition := fmt.Sprintf("time >= '%s' AND time < '%s'", start.UTC().Format(time.RFC3339Nano), end.UTC().Format(time.RFC3339Nano))
+	return buildTagFilterQuery(measurement, timeCondition, nil, nil, "*") + " GROUP BY *"
+}
+
+func buildTagFilterQuery(measurement string, timeCondition string, tagFilter map[string]string, groupByArr []string, selectArr ...string) string {
+	tagKeyArr := make([]string, 0, len(tagFilter))
+	for tagKey := range tagFilter {
+		tagKeyArr = append(tagKeyArr, tagKey)
+	}
+	sort.Strings(tagKeyArr)
+
+	query := fmt.Sprintf("SELECT %s FROM \"%s\" WHERE %s", strings.Join(selectArr, ", "), measurement, timeCondition)
+	for _, tagKey := range tagKeyArr {
+		query += fmt.Sprintf(" AND \"%s\"='%s'", tagKey, strings.ReplaceAll(tagFilter[tagKey], "'", "\\'"))
+	}
+	if len(groupByArr) > 0 {
+		groupByQueryArr := make([]string, len(groupByArr))
+		for idx, tagKey := range groupByArr {
+			if strings.HasPrefix(tagKey, "time(") {
+				groupByQueryArr[idx] = tagKey
+				continue
+			}
+			groupByQueryArr[idx] = fmt.Sprintf("\"%s\"", tagKey)
+		}
+		query += " GROUP BY " + strings.Join(groupByQueryArr, ", ")
+	}
+	return query
+}
@@ -0,0 +1,36 @@
+package spill
+
+import (
+	"time"
+)
+
+// Retrier 저장 실패 시 지수 백오프 재시도
+type Retrier struct {
+	MaxRetry       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Do write 실행 후 실패 시 최대 MaxRetry 회 재시도 (abortCh 가 닫히면 재시도 중단)
+//   - 재시도 시 onRetry 를 호출합니다.
+//   - 마지막 write 결과를 반환합니다.
+func (r Retrier) Do(write func() error, abortCh <-chan struct{}, onRetry func()) error {
+	err := write()
+	backoff := r.InitialBackoff
+	for retry := 0; err != nil && retry < r.MaxRetry; retry++ {
+		if onRetry != nil {
+			onRetry()
+		}
+		select {
+		case <-abortCh:
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > r.MaxBackoff {
+			backoff = r.MaxBackoff
+		}
+		err = write()
+	}
+	return err
+}
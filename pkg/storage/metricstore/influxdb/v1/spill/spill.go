@@ -0,0 +1,194 @@
+package spill
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb1-client/models"
+	influxdbClient "github.com/influxdata/influxdb1-client/v2"
+)
+
+const (
+	FileExt = ".lp"
+	// CorruptFileExt 파싱할 수 없는 디스크 저장 파일 확장자 (재전송 대상에서 제외, 수동 확인용으로 보관)
+	CorruptFileExt = ".corrupt"
+	tmpFileExt     = ".tmp"
+)
+
+// ErrSpillFull 디스크 저장 최대 크기 초과
+var ErrSpillFull = errors.New("spill size exceeds max bytes")
+
+type spillFile struct {
+	name     string
+	database string
+	size     int64
+}
+
+// ReplayResult 디스크 저장 데이터 재전송 결과
+type ReplayResult struct {
+	ReplayedFiles  int
+	ReplayedPoints int
+	CorruptFiles   int
+}
+
+// Store 저장하지 못한 point 를 line protocol 파일로 보관하는 디스크 저장소
+// file: {path}/{저장 시각(ns)}_{database}.lp
+type Store struct {
+	path     string
+	maxBytes int64
+
+	mutex sync.Mutex
+	files int
+	bytes int64
+}
+
+// NewStore 디스크 저장소 생성 (이전 구동 시 저장하지 못한 디스크 데이터 현황 로드)
+func NewStore(path string, maxBytes int64) *Store {
+	store := &Store{path: path, maxBytes: maxBytes}
+	spillFiles, _ := store.listFiles()
+	for _, file := range spillFiles {
+		store.files++
+		store.bytes += file.size
+	}
+	return store
+}
+
+// Stats 디스크 저장 파일 수, 크기
+func (s *Store) Stats() (int, int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.files, s.bytes
+}
+
+// Spill point 를 디스크에 line protocol 로 저장 (최대 크기 초과 시 ErrSpillFull)
+func (s *Store) Spill(database string, points []*influxdbClient.Point) error {
+	var lines strings.Builder
+	for _, point := range points {
+		lines.WriteString(point.String())
+		lines.WriteString("\n")
+	}
+	data := []byte(lines.String())
+
+	s.mutex.Lock()
+	if s.bytes+int64(len(data)) > s.maxBytes {
+		s.mutex.Unlock()
+		return ErrSpillFull
+	}
+	s.bytes += int64(len(data))
+	s.files++
+	s.mutex.Unlock()
+
+	if err := s.writeFile(database, data); err != nil {
+		s.mutex.Lock()
+		s.bytes -= int64(len(data))
+		s.files--
+		s.mutex.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (s *Store) writeFile(database string, data []byte) error {
+	if err := os.MkdirAll(s.path, 0755); err != nil {
+		return err
+	}
+	fileName := fmt.Sprintf("%d_%s", time.Now().UnixNano(), database)
+	tmpPath := filepath.Join(s.path, fileName+tmpFileExt)
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	// 재전송 중 작성 중인 파일을 읽지 않도록 작성 완료 후 이름 변경
+	return os.Rename(tmpPath, filepath.Join(s.path, fileName+FileExt))
+}
+
+func (s *Store) listFiles() ([]spillFile, error) {
+	fileInfoList, err := ioutil.ReadDir(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var spillFiles []spillFile
+	for _, fileInfo := range fileInfoList {
+		if fileInfo.IsDir() || !strings.HasSuffix(fileInfo.Name(), FileExt) {
+			continue
+		}
+		nameSplit := strings.SplitN(strings.TrimSuffix(fileInfo.Name(), FileExt), "_", 2)
+		if len(nameSplit) != 2 {
+			continue
+		}
+		spillFiles = append(spillFiles, spillFile{
+			name:     fileInfo.Name(),
+			database: nameSplit[1],
+			size:     fileInfo.Size(),
+		})
+	}
+	sort.Slice(spillFiles, func(i, j int) bool {
+		return spillFiles[i].name < spillFiles[j].name
+	})
+	return spillFiles, nil
+}
+
+// Replay 디스크에 저장된 데이터를 저장 순서대로 재전송
+//   - 재전송에 성공한 파일은 삭제하며, 재전송에 실패하면 남은 파일을 유지한 채 중단합니다.
+//   - 파싱할 수 없는 파일은 일부 point 만 저장되지 않도록 재전송하지 않고 .corrupt 확장자로 이동합니다.
+//   - stopCh 가 닫히면 남은 파일 재전송을 중단합니다.
+func (s *Store) Replay(write func(database string, points []*influxdbClient.Point) error, stopCh <-chan struct{}) (ReplayResult, error) {
+	result := ReplayResult{}
+	spillFiles, err := s.listFiles()
+	if err != nil {
+		return result, errors.New(fmt.Sprintf("failed to list spill files, error=%s", err))
+	}
+
+	for _, file := range spillFiles {
+		select {
+		case <-stopCh:
+			return result, nil
+		default:
+		}
+		filePath := filepath.Join(s.path, file.name)
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return result, errors.New(fmt.Sprintf("failed to read spill file %s, error=%s", file.name, err))
+		}
+		parsedPoints, err := models.ParsePoints(data)
+		if err != nil {
+			corruptPath := strings.TrimSuffix(filePath, FileExt) + CorruptFileExt
+			if renameErr := os.Rename(filePath, corruptPath); renameErr != nil {
+				return result, errors.New(fmt.Sprintf("failed to move corrupt spill file %s, error=%s", file.name, renameErr))
+			}
+			s.release(file)
+			result.CorruptFiles++
+			continue
+		}
+		points := make([]*influxdbClient.Point, len(parsedPoints))
+		for i, parsedPoint := range parsedPoints {
+			points[i] = influxdbClient.NewPointFrom(parsedPoint)
+		}
+		if err = write(file.database, points); err != nil {
+			return result, errors.New(fmt.Sprintf("failed to replay spill file %s, error=%s", file.name, err))
+		}
+		if err = os.Remove(filePath); err != nil {
+			return result, errors.New(fmt.Sprintf("failed to remove spill file %s, error=%s", file.name, err))
+		}
+		s.release(file)
+		result.ReplayedFiles++
+		result.ReplayedPoints += len(points)
+	}
+	return result, nil
+}
+
+func (s *Store) release(file spillFile) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.files--
+	s.bytes -= file.size
+}
@@ -0,0 +1,239 @@
+package test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	influxdbClient "github.com/influxdata/influxdb1-client/v2"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1/spill"
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// makePoints cnt 개의 cpu point 생성
+func makePoints(t *testing.T, cnt int) []*influxdbClient.Point {
+	t.Helper()
+	var points []*influxdbClient.Point
+	for i := 0; i < cnt; i++ {
+		point, err := influxdbClient.NewPoint("cpu", map[string]string{"vmId": "vm01"}, map[string]interface{}{"cpu_utilization": float64(i)}, baseTime.Add(time.Duration(i)*time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+func listFiles(t *testing.T, path string, ext string) []string {
+	t.Helper()
+	fileInfoList, err := ioutil.ReadDir(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, fileInfo := range fileInfoList {
+		if strings.HasSuffix(fileInfo.Name(), ext) {
+			names = append(names, fileInfo.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// replayedBatch 재전송 요청 기록
+type replayedBatch struct {
+	database string
+	points   int
+}
+
+func TestSpillAndReplay(t *testing.T) {
+	spillPath := t.TempDir()
+	store := spill.NewStore(spillPath, 1024*1024)
+
+	if err := store.Spill("cbmon", makePoints(t, 3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Spill("cbmonraw", makePoints(t, 2)); err != nil {
+		t.Fatal(err)
+	}
+	files, bytes := store.Stats()
+	if files != 2 || bytes == 0 {
+		t.Fatalf("spill stats, files=%d, bytes=%d", files, bytes)
+	}
+
+	// 재시작 시 디스크 저장 현황 로드
+	reloadedStore := spill.NewStore(spillPath, 1024*1024)
+	if reloadedFiles, reloadedBytes := reloadedStore.Stats(); reloadedFiles != files || reloadedBytes != bytes {
+		t.Errorf("reloaded spill stats, expected=%d/%d, actual=%d/%d", files, bytes, reloadedFiles, reloadedBytes)
+	}
+
+	var replayed []replayedBatch
+	result, err := reloadedStore.Replay(func(database string, points []*influxdbClient.Point) error {
+		replayed = append(replayed, replayedBatch{database: database, points: len(points)})
+		return nil
+	}, make(chan struct{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []replayedBatch{{database: "cbmon", points: 3}, {database: "cbmonraw", points: 2}}
+	if len(replayed) != len(expected) || replayed[0] != expected[0] || replayed[1] != expected[1] {
+		t.Errorf("replay order, expected=%v, actual=%v", expected, replayed)
+	}
+	if result.ReplayedFiles != 2 || result.ReplayedPoints != 5 || result.CorruptFiles != 0 {
+		t.Errorf("replay result, actual=%+v", result)
+	}
+	if remainFiles, remainBytes := reloadedStore.Stats(); remainFiles != 0 || remainBytes != 0 {
+		t.Errorf("spill stats after replay, files=%d, bytes=%d", remainFiles, remainBytes)
+	}
+	if names := listFiles(t, spillPath, spill.FileExt); len(names) != 0 {
+		t.Errorf("spill files not removed after replay, files=%v", names)
+	}
+}
+
+func TestSpillMaxBytes(t *testing.T) {
+	store := spill.NewStore(t.TempDir(), 100)
+	if err := store.Spill("cbmon", makePoints(t, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Spill("cbmon", makePoints(t, 10)); !errors.Is(err, spill.ErrSpillFull) {
+		t.Errorf("expected ErrSpillFull, actual=%v", err)
+	}
+	if files, _ := store.Stats(); files != 1 {
+		t.Errorf("spill files, expected=1, actual=%d", files)
+	}
+}
+
+func TestReplayWriteFailure(t *testing.T) {
+	spillPath := t.TempDir()
+	store := spill.NewStore(spillPath, 1024*1024)
+	for i := 0; i < 3; i++ {
+		if err := store.Spill("cbmon", makePoints(t, 1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 두 번째 파일 재전송 실패 시 남은 파일 유지
+	writeCnt := 0
+	result, err := store.Replay(func(database string, points []*influxdbClient.Point) error {
+		writeCnt++
+		if writeCnt == 2 {
+			return errors.New("influxDB unavailable")
+		}
+		return nil
+	}, make(chan struct{}))
+	if err == nil {
+		t.Fatal("expected replay error")
+	}
+	if result.ReplayedFiles != 1 {
+		t.Errorf("replayed files, expected=1, actual=%d", result.ReplayedFiles)
+	}
+	if names := listFiles(t, spillPath, spill.FileExt); len(names) != 2 {
+		t.Errorf("remain spill files, expected=2, actual=%v", names)
+	}
+	if files, _ := store.Stats(); files != 2 {
+		t.Errorf("spill stats, expected=2, actual=%d", files)
+	}
+
+	// 재전송 중단 요청 시 남은 파일 유지
+	stopCh := make(chan struct{})
+	close(stopCh)
+	if result, err = store.Replay(func(database string, points []*influxdbClient.Point) error {
+		return nil
+	}, stopCh); err != nil || result.ReplayedFiles != 0 {
+		t.Errorf("replay after stop, result=%+v, error=%v", result, err)
+	}
+}
+
+func TestReplayCorruptFile(t *testing.T) {
+	spillPath := t.TempDir()
+	// 일부 line 만 파싱 가능한 파일
+	corruptData := "cpu,vmId=vm01 cpu_utilization=1 1704067200000000000\ncpu,vmId=vm01 cpu_utilization=\n"
+	if err := ioutil.WriteFile(filepath.Join(spillPath, "1_cbmon"+spill.FileExt), []byte(corruptData), 0644); err != nil {
+		t.Fatal(err)
+	}
+	store := spill.NewStore(spillPath, 1024*1024)
+	if err := store.Spill("cbmon", makePoints(t, 2)); err != nil {
+		t.Fatal(err)
+	}
+
+	var replayedPoints int
+	result, err := store.Replay(func(database string, points []*influxdbClient.Point) error {
+		replayedPoints += len(points)
+		return nil
+	}, make(chan struct{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 파싱할 수 없는 파일의 point 는 저장하지 않고, 파일은 삭제하지 않고 보관
+	if replayedPoints != 2 || result.CorruptFiles != 1 || result.ReplayedFiles != 1 {
+		t.Errorf("replay result, points=%d, result=%+v", replayedPoints, result)
+	}
+	if names := listFiles(t, spillPath, spill.CorruptFileExt); len(names) != 1 || names[0] != "1_cbmon"+spill.CorruptFileExt {
+		t.Errorf("corrupt spill file not moved aside, files=%v", names)
+	}
+	if _, err = os.Stat(filepath.Join(spillPath, "1_cbmon"+spill.CorruptFileExt)); err != nil {
+		t.Errorf("corrupt spill file removed, error=%v", err)
+	}
+	if files, bytes := store.Stats(); files != 0 || bytes != 0 {
+		t.Errorf("spill stats, files=%d, bytes=%d", files, bytes)
+	}
+}
+
+func TestRetrier(t *testing.T) {
+	retrier := spill.Retrier{MaxRetry: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+	testCases := []struct {
+		name          string
+		failCnt       int
+		expectedErr   bool
+		expectedCalls int
+		expectedRetry int
+	}{
+		{name: "success", failCnt: 0, expectedErr: false, expectedCalls: 1, expectedRetry: 0},
+		{name: "success after retry", failCnt: 2, expectedErr: false, expectedCalls: 3, expectedRetry: 2},
+		{name: "success at last retry", failCnt: 3, expectedErr: false, expectedCalls: 4, expectedRetry: 3},
+		{name: "fail after max retry", failCnt: 10, expectedErr: true, expectedCalls: 4, expectedRetry: 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls, retries := 0, 0
+			err := retrier.Do(func() error {
+				calls++
+				if calls <= tc.failCnt {
+					return errors.New("write failed")
+				}
+				return nil
+			}, make(chan struct{}), func() {
+				retries++
+			})
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("error, expected=%v, actual=%v", tc.expectedErr, err)
+			}
+			if calls != tc.expectedCalls || retries != tc.expectedRetry {
+				t.Errorf("calls=%d (expected %d), retries=%d (expected %d)", calls, tc.expectedCalls, retries, tc.expectedRetry)
+			}
+		})
+	}
+}
+
+func TestRetrierAbort(t *testing.T) {
+	retrier := spill.Retrier{MaxRetry: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	abortCh := make(chan struct{})
+	close(abortCh)
+
+	calls := 0
+	err := retrier.Do(func() error {
+		calls++
+		return errors.New("write failed")
+	}, abortCh, nil)
+	if err == nil || calls != 1 {
+		t.Errorf("abort retry, calls=%d, error=%v", calls, err)
+	}
+}
@@ -0,0 +1,393 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/storage/metricstore/influxdb/v1/spill"
+	"github.com/cloud-barista/cb-dragonfly/pkg/util"
+	influxdbClient "github.com/influxdata/influxdb1-client/v2"
+)
+
+const (
+	defaultWriteBufferSize    = 10000
+	defaultWriteBatchSize     = 1000
+	defaultWriteFlushInterval = 1000
+	defaultWriteMaxRetry      = 3
+	defaultSpillMaxSize       = 512
+
+	initialRetryBackoff = 500 * time.Millisecond
+	maxRetryBackoff     = 10 * time.Second
+	spillReplayInterval = 10 * time.Second
+	storePingTimeout    = 5 * time.Second
+	abortWaitTimeout    = 5 * time.Second
+)
+
+type bufferedPoint struct {
+	database string
+	point    *influxdbClient.Point
+}
+
+// WriteStatus 비동기 쓰기 파이프라인 상태
+type WriteStatus struct {
+	StoreAvailable bool   `json:"store_available"`
+	BufferSize     int    `json:"buffer_size"`
+	BufferedPoints int    `json:"buffered_points"`
+	WrittenPoints  int64  `json:"written_points"`
+	WrittenBatches int64  `json:"written_batches"`
+	RetriedBatches int64  `json:"retried_batches"`
+	FailedBatches  int64  `json:"failed_batches"`
+	SpilledPoints  int64  `json:"spilled_points"`
+	ReplayedPoints int64  `json:"replayed_points"`
+	DroppedPoints  int64  `json:"dropped_points"`
+	SpillFiles     int    `json:"spill_files"`
+	SpillBytes     int64  `json:"spill_bytes"`
+	LastWriteTime  string `json:"last_write_time"`
+	LastError      string `json:"last_error"`
+}
+
+// WritePipeline InfluxDB 비동기 쓰기 파이프라인
+//   - 집계 모듈(aggregator)은 point 를 버퍼(bounded)에 등록만 하고 즉시 반환하여, InfluxDB 지연이 수집 지연으로 이어지지 않도록 합니다.
+//   - 버퍼의 point 는 배치 크기(write_batch_size) 또는 배치 주기(write_flush_interval) 기준으로 DB 별로 모아 저장합니다.
+//   - 저장 실패 시 지수 백오프로 재시도(write_max_retry)하며, 최종 실패하거나 버퍼가 가득 찬 경우 디스크(spill_path)에 line protocol 로 저장합니다.
+//   - 디스크에 저장된 데이터는 InfluxDB 가 복구되면 저장 순서대로 재전송합니다.
+type WritePipeline struct {
+	buffer        chan bufferedPoint
+	batchSize     int
+	flushInterval time.Duration
+	retrier       spill.Retrier
+	spillStore    *spill.Store
+
+	mutex  sync.Mutex
+	status WriteStatus
+
+	closed    int32
+	stopOnce  sync.Once
+	abortOnce sync.Once
+	stopCh    chan struct{}
+	abortCh   chan struct{}
+	done      chan struct{}
+}
+
+var pipelineOnce sync.Once
+var pipeline *WritePipeline
+
+func GetWritePipeline() *WritePipeline {
+	pipelineOnce.Do(func() {
+		pipeline = newWritePipeline()
+		go pipeline.run()
+	})
+	return pipeline
+}
+
+func newWritePipeline() *WritePipeline {
+	influxDBConfig := config.GetInstance().InfluxDB
+	bufferSize := influxDBConfig.WriteBufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultWriteBufferSize
+	}
+	batchSize := influxDBConfig.WriteBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultWriteBatchSize
+	}
+	flushInterval := influxDBConfig.WriteFlushInterval
+	if flushInterval <= 0 {
+		flushInterval = defaultWriteFlushInterval
+	}
+	maxRetry := influxDBConfig.WriteMaxRetry
+	if maxRetry <= 0 {
+		maxRetry = defaultWriteMaxRetry
+	}
+	spillPath := influxDBConfig.SpillPath
+	if spillPath == "" {
+		spillPath = filepath.Join(os.Getenv("CBMON_ROOT"), "spill")
+	}
+	spillMaxSize := influxDBConfig.SpillMaxSize
+	if spillMaxSize <= 0 {
+		spillMaxSize = defaultSpillMaxSize
+	}
+
+	wp := &WritePipeline{
+		buffer:        make(chan bufferedPoint, bufferSize),
+		batchSize:     batchSize,
+		flushInterval: time.Duration(flushInterval) * time.Millisecond,
+		retrier: spill.Retrier{
+			MaxRetry:       maxRetry,
+			InitialBackoff: initialRetryBackoff,
+			MaxBackoff:     maxRetryBackoff,
+		},
+		// 이전 구동 시 저장하지 못한 디스크 데이터 현황 로드
+		spillStore: spill.NewStore(spillPath, int64(spillMaxSize)*1024*1024),
+		status: WriteStatus{
+			StoreAvailable: true,
+			BufferSize:     bufferSize,
+		},
+		stopCh:  make(chan struct{}),
+		abortCh: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	return wp
+}
+
+// WriteMetric 집계 메트릭 비동기 저장 요청
+func (wp *WritePipeline) WriteMetric(database string, metrics map[string]interface{}) {
+	wp.WritePoints(database, MakeMetricPoints(metrics))
+}
+
+// WriteMetricAt 지정한 시각 기준 집계 메트릭 비동기 저장 요청
+func (wp *WritePipeline) WriteMetricAt(database string, metrics map[string]interface{}, timestamp time.Time) {
+	wp.WritePoints(database, MakeMetricPointsAt(metrics, timestamp))
+}
+
+// WritePoint 지정한 시각 기준 단일 point 비동기 저장 요청
+func (wp *WritePipeline) WritePoint(database string, measurement string, tagArr map[string]string, fieldArr map[string]interface{}, timestamp time.Time) error {
+	point, err := influxdbClient.NewPoint(measurement, tagArr, fieldArr, timestamp)
+	if err != nil {
+		util.GetLogger().Error("failed to create InfluxDB metric point: ", err)
+		return err
+	}
+	wp.WritePoints(database, []*influxdbClient.Point{point})
+	return nil
+}
+
+// WriteOnDemandMetric 분 단위 시각 기준 메트릭 비동기 저장 요청
+func (wp *WritePipeline) WriteOnDemandMetric(database string, metricName string, tagArr map[string]string, metricVal map[string]interface{}) error {
+	point, err := MakeOnDemandPoint(metricName, tagArr, metricVal)
+	if err != nil {
+		util.GetLogger().Error("failed to create InfluxDB metric point: ", err)
+		return err
+	}
+	wp.WritePoints(database, []*influxdbClient.Point{point})
+	return nil
+}
+
+// WritePoints point 비동기 저장 요청 (버퍼가 가득 찬 경우 대기하지 않고 디스크에 저장)
+func (wp *WritePipeline) WritePoints(database string, points []*influxdbClient.Point) {
+	if len(points) == 0 {
+		return
+	}
+	if atomic.LoadInt32(&wp.closed) == 1 {
+		wp.spill(database, points)
+		return
+	}
+	var overflowPoints []*influxdbClient.Point
+	for _, point := range points {
+		select {
+		case wp.buffer <- bufferedPoint{database: database, point: point}:
+		default:
+			overflowPoints = append(overflowPoints, point)
+		}
+	}
+	if len(overflowPoints) != 0 {
+		wp.spill(database, overflowPoints)
+	}
+}
+
+// GetStatus 비동기 쓰기 파이프라인 상태 조회
+func (wp *WritePipeline) GetStatus() WriteStatus {
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+	status := wp.status
+	status.BufferedPoints = len(wp.buffer)
+	status.SpillFiles, status.SpillBytes = wp.spillStore.Stats()
+	return status
+}
+
+// Flush 버퍼에 남은 point 저장 후 파이프라인 종료 (프로세스 종료 시 호출)
+//   - ctx 만료 시 재시도를 중단하고 남은 point 를 디스크에 저장합니다.
+func (wp *WritePipeline) Flush(ctx context.Context) error {
+	atomic.StoreInt32(&wp.closed, 1)
+	wp.stopOnce.Do(func() {
+		close(wp.stopCh)
+	})
+	select {
+	case <-wp.done:
+		return nil
+	case <-ctx.Done():
+	}
+	wp.abortOnce.Do(func() {
+		close(wp.abortCh)
+	})
+	select {
+	case <-wp.done:
+	case <-time.After(abortWaitTimeout):
+	}
+	return errors.New(fmt.Sprintf("failed to flush influxDB write buffer in time, error=%s", ctx.Err()))
+}
+
+func (wp *WritePipeline) run() {
+	defer close(wp.done)
+
+	flushTicker := time.NewTicker(wp.flushInterval)
+	defer flushTicker.Stop()
+	replayTicker := time.NewTicker(spillReplayInterval)
+	defer replayTicker.Stop()
+
+	batch := map[string][]*influxdbClient.Point{}
+	batchCnt := 0
+	flush := func() {
+		if batchCnt == 0 {
+			return
+		}
+		wp.writeBatch(batch)
+		batch = map[string][]*influxdbClient.Point{}
+		batchCnt = 0
+	}
+
+	for {
+		select {
+		case bufferedPoint := <-wp.buffer:
+			batch[bufferedPoint.database] = append(batch[bufferedPoint.database], bufferedPoint.point)
+			batchCnt++
+			if batchCnt >= wp.batchSize {
+				flush()
+			}
+		case <-flushTicker.C:
+			flush()
+		case <-replayTicker.C:
+			wp.replaySpill()
+		case <-wp.stopCh:
+			// 버퍼에 남은 point 저장 후 종료
+			for {
+				select {
+				case bufferedPoint := <-wp.buffer:
+					batch[bufferedPoint.database] = append(batch[bufferedPoint.database], bufferedPoint.point)
+					batchCnt++
+					if batchCnt >= wp.batchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// writeBatch DB 별 배치 저장 (실패 시 지수 백오프 재시도, 최종 실패 시 디스크 저장)
+func (wp *WritePipeline) writeBatch(batch map[string][]*influxdbClient.Point) {
+	for database, points := range batch {
+		// InfluxDB 장애 상태일 경우 재시도 없이 디스크에 저장 (복구 여부는 재전송 주기에 확인)
+		if !wp.isStoreAvailable() {
+			wp.spill(database, points)
+			continue
+		}
+
+		err := wp.retrier.Do(func() error {
+			return wp.write(database, points)
+		}, wp.abortCh, func() {
+			wp.updateStatus(func(status *WriteStatus) {
+				status.RetriedBatches++
+			})
+		})
+
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to write influxDB batch, database=%s, points=%d, error=%s", database, len(points), err)
+			util.GetLogger().Error(errMsg)
+			wp.updateStatus(func(status *WriteStatus) {
+				status.StoreAvailable = false
+				status.FailedBatches++
+				status.LastError = errMsg
+			})
+			wp.spill(database, points)
+			continue
+		}
+		wp.updateStatus(func(status *WriteStatus) {
+			status.StoreAvailable = true
+			status.WrittenPoints += int64(len(points))
+			status.WrittenBatches++
+			status.LastWriteTime = time.Now().Format(time.RFC3339)
+		})
+	}
+}
+
+func (wp *WritePipeline) write(database string, points []*influxdbClient.Point) error {
+	client := GetInstance().Client
+	if client == nil {
+		return errors.New("influxDB client is not initialized")
+	}
+	bp, err := influxdbClient.NewBatchPoints(influxdbClient.BatchPointsConfig{
+		Database: database,
+	})
+	if err != nil {
+		return err
+	}
+	bp.AddPoints(points)
+	return client.Write(bp)
+}
+
+/** ### Spill Start ### */
+
+// spill 저장하지 못한 point 를 디스크에 line protocol 로 저장
+func (wp *WritePipeline) spill(database string, points []*influxdbClient.Point) {
+	if err := wp.spillStore.Spill(database, points); err != nil {
+		util.GetLogger().Error(fmt.Sprintf("failed to spill influxDB points, dropped=%d, error=%s", len(points), err))
+		wp.updateStatus(func(status *WriteStatus) {
+			status.DroppedPoints += int64(len(points))
+		})
+		return
+	}
+	wp.updateStatus(func(status *WriteStatus) {
+		status.SpilledPoints += int64(len(points))
+	})
+}
+
+// replaySpill InfluxDB 복구 시 디스크에 저장된 데이터를 저장 순서대로 재전송
+//   - 파싱할 수 없는 파일은 재전송하지 않고 .corrupt 확장자로 이동하여 보관합니다.
+func (wp *WritePipeline) replaySpill() {
+	if spillFiles, _ := wp.spillStore.Stats(); spillFiles == 0 {
+		return
+	}
+	if !wp.isStoreAvailable() {
+		client := GetInstance().Client
+		if client == nil {
+			return
+		}
+		if _, _, err := client.Ping(storePingTimeout); err != nil {
+			return
+		}
+		wp.updateStatus(func(status *WriteStatus) {
+			status.StoreAvailable = true
+		})
+	}
+
+	result, err := wp.spillStore.Replay(wp.write, wp.stopCh)
+	if result.CorruptFiles > 0 {
+		util.GetLogger().Error(fmt.Sprintf("failed to parse spill files, moved %d files to %s", result.CorruptFiles, spill.CorruptFileExt))
+	}
+	wp.updateStatus(func(status *WriteStatus) {
+		status.ReplayedPoints += int64(result.ReplayedPoints)
+		if result.ReplayedFiles > 0 {
+			status.LastWriteTime = time.Now().Format(time.RFC3339)
+		}
+		if err != nil {
+			status.StoreAvailable = false
+			status.LastError = err.Error()
+		}
+	})
+	if err != nil {
+		util.GetLogger().Error(err)
+	}
+}
+
+/** ### Spill End ### */
+
+func (wp *WritePipeline) isStoreAvailable() bool {
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+	return wp.status.StoreAvailable
+}
+
+func (wp *WritePipeline) updateStatus(update func(status *WriteStatus)) {
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+	update(&wp.status)
+}
@@ -0,0 +1,314 @@
+package tumblebug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/config"
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
+)
+
+const (
+	defaultTimeout    = 10
+	retryBackoff      = 500 * time.Millisecond
+	maxErrorBodyBytes = 512
+)
+
+// Config Tumblebug 클라이언트 설정
+type Config struct {
+	EndpointUrl string
+	Username    string
+	Password    string
+	Timeout     time.Duration // 요청 제한 시간
+	MaxRetry    int           // 접속 실패, 5xx, 429 응답 시 재시도 횟수
+	CacheTTL    time.Duration // 조회 결과 캐시 유지 시간 (0일 경우 캐시 미사용)
+}
+
+// Client Tumblebug REST API 클라이언트
+//   - 조회 결과는 요청 주소, 인증 정보 기준으로 CacheTTL 동안 캐시합니다.
+type Client struct {
+	config     Config
+	auth       string
+	httpClient *http.Client
+	cache      *responseCache
+}
+
+// StatusError Tumblebug 오류 응답
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("tumblebug responded with status %d, message=%s", e.StatusCode, e.Message)
+}
+
+// IsNotFound Tumblebug 리소스 없음(404) 응답 여부
+func IsNotFound(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
+
+var once sync.Once
+var client *Client
+
+// GetInstance 설정 파일(tumblebug) 기준 Tumblebug 클라이언트 조회
+func GetInstance() *Client {
+	once.Do(func() {
+		tbConfig := config.GetInstance().Tumblebug
+		timeout := tbConfig.Timeout
+		if timeout <= 0 {
+			timeout = defaultTimeout
+		}
+		client = NewClient(Config{
+			EndpointUrl: tbConfig.EndpointUrl,
+			Username:    tbConfig.Username,
+			Password:    tbConfig.Password,
+			Timeout:     time.Duration(timeout) * time.Second,
+			MaxRetry:    tbConfig.MaxRetry,
+			CacheTTL:    time.Duration(tbConfig.CacheTTL) * time.Second,
+		})
+	})
+	return client
+}
+
+// NewClient Tumblebug 클라이언트 생성 (EndpointUrl 미설정 시 types.TBRestAPIURL 사용)
+func NewClient(clientConfig Config) *Client {
+	if clientConfig.EndpointUrl == "" {
+		clientConfig.EndpointUrl = types.TBRestAPIURL
+	}
+	clientConfig.EndpointUrl = strings.TrimSuffix(clientConfig.EndpointUrl, "/")
+	if clientConfig.Timeout <= 0 {
+		clientConfig.Timeout = defaultTimeout * time.Second
+	}
+	if clientConfig.MaxRetry < 0 {
+		clientConfig.MaxRetry = 0
+	}
+	return &Client{
+		config:     clientConfig,
+		httpClient: &http.Client{Timeout: clientConfig.Timeout},
+		cache:      &responseCache{entries: map[string]cacheEntry{}},
+	}
+}
+
+// WithAuth 요청 Authorization 헤더 지정 (API 호출자 인증 정보 전달, 빈 값일 경우 설정 계정 사용)
+//   - 반환된 클라이언트는 원본 클라이언트와 캐시를 공유합니다.
+func (c *Client) WithAuth(auth string) *Client {
+	authClient := *c
+	authClient.auth = auth
+	return &authClient
+}
+
+// InvalidateCache 조회 결과 캐시 초기화
+func (c *Client) InvalidateCache() {
+	c.cache.clear()
+}
+
+// ListNamespace 네임스페이스 목록 조회
+func (c *Client) ListNamespace() ([]Namespace, error) {
+	result := struct {
+		Ns []Namespace `json:"ns"`
+	}{}
+	if err := c.get("/ns", &result); err != nil {
+		return nil, err
+	}
+	return result.Ns, nil
+}
+
+// ListMCIS 네임스페이스 MCIS 목록 조회 (VM 목록 포함)
+func (c *Client) ListMCIS(nsId string) ([]MCIS, error) {
+	result := struct {
+		Mcis []MCIS `json:"mcis"`
+	}{}
+	if err := c.get(fmt.Sprintf("/ns/%s/mcis", url.PathEscape(nsId)), &result); err != nil {
+		return nil, err
+	}
+	return result.Mcis, nil
+}
+
+// GetMCIS MCIS 조회
+func (c *Client) GetMCIS(nsId string, mcisId string) (*MCIS, error) {
+	var mcis MCIS
+	if err := c.get(fmt.Sprintf("/ns/%s/mcis/%s", url.PathEscape(nsId), url.PathEscape(mcisId)), &mcis); err != nil {
+		return nil, err
+	}
+	return &mcis, nil
+}
+
+// ListVM MCIS VM 목록 조회
+func (c *Client) ListVM(nsId string, mcisId string) ([]VM, error) {
+	mcis, err := c.GetMCIS(nsId, mcisId)
+	if err != nil {
+		return nil, err
+	}
+	return mcis.Vm, nil
+}
+
+// ListSpec 네임스페이스 스펙 목록 조회
+func (c *Client) ListSpec(nsId string) ([]Spec, error) {
+	result := struct {
+		Spec []Spec `json:"spec"`
+	}{}
+	if err := c.get(fmt.Sprintf("/ns/%s/resources/spec", url.PathEscape(nsId)), &result); err != nil {
+		return nil, err
+	}
+	return result.Spec, nil
+}
+
+// GetSpec 스펙 조회
+func (c *Client) GetSpec(nsId string, specId string) (*Spec, error) {
+	var spec Spec
+	if err := c.get(fmt.Sprintf("/ns/%s/resources/spec/%s", url.PathEscape(nsId), url.PathEscape(specId)), &spec); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// ListK8sCluster 네임스페이스 쿠버네티스 클러스터 목록 조회
+func (c *Client) ListK8sCluster(nsId string) ([]K8sCluster, error) {
+	result := struct {
+		Cluster []K8sCluster `json:"cluster"`
+	}{}
+	if err := c.get(fmt.Sprintf("/ns/%s/k8scluster", url.PathEscape(nsId)), &result); err != nil {
+		return nil, err
+	}
+	return result.Cluster, nil
+}
+
+// get GET 요청 결과 조회 (캐시 조회, 재시도 포함)
+func (c *Client) get(path string, result interface{}) error {
+	requestUrl := c.config.EndpointUrl + path
+	cacheKey := c.authorization() + " " + requestUrl
+	if c.config.CacheTTL > 0 {
+		if body, ok := c.cache.get(cacheKey); ok {
+			return decode(requestUrl, body, result)
+		}
+	}
+
+	var body []byte
+	var err error
+	for attempt := 0; attempt <= c.config.MaxRetry; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * retryBackoff)
+		}
+		var retryable bool
+		body, retryable, err = c.do(requestUrl)
+		if err == nil || !retryable {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.config.CacheTTL > 0 {
+		c.cache.put(cacheKey, body, c.config.CacheTTL)
+	}
+	return decode(requestUrl, body, result)
+}
+
+// do 단일 요청 실행 (재시도 대상 여부 반환)
+func (c *Client) do(requestUrl string) ([]byte, bool, error) {
+	req, err := http.NewRequest(http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if auth := c.authorization(); auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, true, errors.New(fmt.Sprintf("failed to request tumblebug, url=%s, error=%s", requestUrl, err))
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, errors.New(fmt.Sprintf("failed to read tumblebug response, url=%s, error=%s", requestUrl, err))
+	}
+	if resp.StatusCode != http.StatusOK {
+		retryable := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		return nil, retryable, &StatusError{StatusCode: resp.StatusCode, Message: errorMessage(body)}
+	}
+	return body, false, nil
+}
+
+func (c *Client) authorization() string {
+	if c.auth != "" {
+		return c.auth
+	}
+	if c.config.Username == "" {
+		return ""
+	}
+	req := http.Request{Header: http.Header{}}
+	req.SetBasicAuth(c.config.Username, c.config.Password)
+	return req.Header.Get("Authorization")
+}
+
+func decode(requestUrl string, body []byte, result interface{}) error {
+	if err := json.Unmarshal(body, result); err != nil {
+		return errors.New(fmt.Sprintf("failed to convert tumblebug response, url=%s, error=%s", requestUrl, err))
+	}
+	return nil
+}
+
+// errorMessage Tumblebug 오류 응답 메세지 조회 ({"message": ...} 형식이 아닐 경우 응답 본문 일부)
+func errorMessage(body []byte) string {
+	errMsg := struct {
+		Message string `json:"message"`
+	}{}
+	if err := json.Unmarshal(body, &errMsg); err == nil && errMsg.Message != "" {
+		return errMsg.Message
+	}
+	if len(body) > maxErrorBodyBytes {
+		body = body[:maxErrorBodyBytes]
+	}
+	return strings.TrimSpace(string(body))
+}
+
+type cacheEntry struct {
+	body      []byte
+	expiredAt time.Time
+}
+
+// responseCache 조회 결과 캐시
+type responseCache struct {
+	lock    sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+func (rc *responseCache) get(key string) ([]byte, bool) {
+	rc.lock.RLock()
+	defer rc.lock.RUnlock()
+	entry, ok := rc.entries[key]
+	if !ok || time.Now().After(entry.expiredAt) {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (rc *responseCache) put(key string, body []byte, ttl time.Duration) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	now := time.Now()
+	for cacheKey, entry := range rc.entries {
+		if now.After(entry.expiredAt) {
+			delete(rc.entries, cacheKey)
+		}
+	}
+	rc.entries[key] = cacheEntry{body: body, expiredAt: now.Add(ttl)}
+}
+
+func (rc *responseCache) clear() {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	rc.entries = map[string]cacheEntry{}
+}
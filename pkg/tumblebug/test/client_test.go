@@ -0,0 +1,236 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/tumblebug"
+)
+
+// newTumblebugServer Tumblebug API 테스트 서버 (요청 수, 마지막 Authorization 헤더 기록)
+func newTumblebugServer(requestCnt *int32, lastAuth *atomic.Value) *httptest.Server {
+	mux := http.NewServeMux()
+	writeJSON := func(w http.ResponseWriter, status int, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+	mux.HandleFunc("/tumblebug/ns", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"ns": []map[string]string{{"id": "ns01", "name": "ns01"}, {"id": "ns02", "name": "ns02"}},
+		})
+	})
+	mux.HandleFunc("/tumblebug/ns/ns01/mcis", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"mcis": []map[string]interface{}{{"id": "mcis01", "status": "Running"}},
+		})
+	})
+	mux.HandleFunc("/tumblebug/ns/ns01/mcis/mcis01", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "mcis01",
+			"vm": []map[string]interface{}{
+				{"id": "vm01", "specId": "spec-small", "publicIP": "10.0.0.1", "connectionName": "aws-conn", "region": map[string]string{"Region": "ap-northeast-2"}},
+				{"id": "vm02", "specId": "spec-large", "publicIP": "10.0.0.2", "connectionName": "aws-conn"},
+			},
+		})
+	})
+	mux.HandleFunc("/tumblebug/ns/ns01/mcis/deleted", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "mcis deleted does not exist"})
+	})
+	mux.HandleFunc("/tumblebug/ns/ns01/resources/spec", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"spec": []map[string]interface{}{
+				{"id": "spec-small", "connectionName": "aws-conn", "numvCPU": 2, "memGiB": 4, "costPerHour": 0.05},
+				{"id": "spec-large", "connectionName": "aws-conn", "numvCPU": 8, "memGiB": 0.5, "costPerHour": 0.2},
+			},
+		})
+	})
+	mux.HandleFunc("/tumblebug/ns/ns01/resources/spec/spec-small", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": "spec-small", "numvCPU": 2, "memGiB": 4, "costPerHour": 0.05})
+	})
+	mux.HandleFunc("/tumblebug/ns/ns01/k8scluster", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"cluster": []map[string]string{{"id": "k8s01", "connectionName": "aws-conn", "version": "1.27"}},
+		})
+	})
+
+	var unstableCnt int32
+	mux.HandleFunc("/tumblebug/ns/unstable/mcis", func(w http.ResponseWriter, r *http.Request) {
+		// 처음 두 번은 503 응답
+		if atomic.AddInt32(&unstableCnt, 1) <= 2 {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"message": "temporarily unavailable"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"mcis": []map[string]string{{"id": "mcis01"}}})
+	})
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requestCnt, 1)
+		lastAuth.Store(r.Header.Get("Authorization"))
+		mux.ServeHTTP(w, r)
+	}))
+}
+
+func newTestClient(server *httptest.Server, maxRetry int, cacheTTL time.Duration) *tumblebug.Client {
+	return tumblebug.NewClient(tumblebug.Config{
+		EndpointUrl: server.URL + "/tumblebug/",
+		Username:    "default",
+		Password:    "default",
+		Timeout:     time.Second,
+		MaxRetry:    maxRetry,
+		CacheTTL:    cacheTTL,
+	})
+}
+
+func TestInventory(t *testing.T) {
+	var requestCnt int32
+	var lastAuth atomic.Value
+	server := newTumblebugServer(&requestCnt, &lastAuth)
+	defer server.Close()
+	client := newTestClient(server, 0, 0)
+
+	nsList, err := client.ListNamespace()
+	if err != nil {
+		t.Fatalf("failed to list namespace, error=%s", err)
+	}
+	if len(nsList) != 2 || nsList[0].Id != "ns01" {
+		t.Errorf("unexpected namespace list %+v", nsList)
+	}
+	if auth := lastAuth.Load().(string); auth != "Basic ZGVmYXVsdDpkZWZhdWx0" {
+		t.Errorf("unexpected basic auth header %s", auth)
+	}
+
+	mcisList, err := client.ListMCIS("ns01")
+	if err != nil || len(mcisList) != 1 || mcisList[0].Status != "Running" {
+		t.Errorf("unexpected mcis list %+v, error=%v", mcisList, err)
+	}
+
+	vmList, err := client.ListVM("ns01", "mcis01")
+	if err != nil {
+		t.Fatalf("failed to list vm, error=%s", err)
+	}
+	if len(vmList) != 2 || vmList[0].SpecId != "spec-small" || vmList[0].PublicIP != "10.0.0.1" || vmList[0].Region.Region != "ap-northeast-2" {
+		t.Errorf("unexpected vm list %+v", vmList)
+	}
+
+	specList, err := client.ListSpec("ns01")
+	if err != nil || len(specList) != 2 || specList[1].MemGiB != 0.5 || specList[1].NumvCPU != 8 {
+		t.Errorf("unexpected spec list %+v, error=%v", specList, err)
+	}
+	spec, err := client.GetSpec("ns01", "spec-small")
+	if err != nil || spec.CostPerHour != 0.05 {
+		t.Errorf("unexpected spec %+v, error=%v", spec, err)
+	}
+
+	clusterList, err := client.ListK8sCluster("ns01")
+	if err != nil || len(clusterList) != 1 || clusterList[0].Version != "1.27" {
+		t.Errorf("unexpected k8s cluster list %+v, error=%v", clusterList, err)
+	}
+}
+
+func TestAuthOverride(t *testing.T) {
+	var requestCnt int32
+	var lastAuth atomic.Value
+	server := newTumblebugServer(&requestCnt, &lastAuth)
+	defer server.Close()
+	client := newTestClient(server, 0, time.Minute)
+
+	if _, err := client.WithAuth("Bearer user-token").ListNamespace(); err != nil {
+		t.Fatalf("failed to list namespace, error=%s", err)
+	}
+	if auth := lastAuth.Load().(string); auth != "Bearer user-token" {
+		t.Errorf("expected caller authorization header, got %s", auth)
+	}
+	// 인증 정보가 다를 경우 캐시를 공유하지 않음
+	if _, err := client.ListNamespace(); err != nil {
+		t.Fatalf("failed to list namespace, error=%s", err)
+	}
+	if requestCnt != 2 {
+		t.Errorf("expected 2 requests for different credentials, got %d", requestCnt)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	var requestCnt int32
+	var lastAuth atomic.Value
+	server := newTumblebugServer(&requestCnt, &lastAuth)
+	defer server.Close()
+	client := newTestClient(server, 3, 0)
+
+	_, err := client.GetMCIS("ns01", "deleted")
+	if !tumblebug.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	statusErr, ok := err.(*tumblebug.StatusError)
+	if !ok || statusErr.Message != "mcis deleted does not exist" {
+		t.Errorf("unexpected error message %v", err)
+	}
+	// 4xx 응답은 재시도하지 않음
+	if requestCnt != 1 {
+		t.Errorf("expected 1 request without retry, got %d", requestCnt)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var requestCnt int32
+	var lastAuth atomic.Value
+	server := newTumblebugServer(&requestCnt, &lastAuth)
+	defer server.Close()
+
+	if _, err := newTestClient(server, 1, 0).ListMCIS("unstable"); err == nil {
+		t.Fatal("expected error after retry exhausted")
+	}
+	mcisList, err := newTestClient(server, 2, 0).ListMCIS("unstable")
+	if err != nil || len(mcisList) != 1 {
+		t.Fatalf("expected success on third attempt, mcis=%+v, error=%v", mcisList, err)
+	}
+	if requestCnt != 3 {
+		t.Errorf("expected 3 requests, got %d", requestCnt)
+	}
+}
+
+func TestCache(t *testing.T) {
+	var requestCnt int32
+	var lastAuth atomic.Value
+	server := newTumblebugServer(&requestCnt, &lastAuth)
+	defer server.Close()
+
+	client := newTestClient(server, 0, time.Minute)
+	for i := 0; i < 3; i++ {
+		if _, err := client.ListSpec("ns01"); err != nil {
+			t.Fatalf("failed to list spec, error=%s", err)
+		}
+	}
+	if requestCnt != 1 {
+		t.Errorf("expected 1 request with cache, got %d", requestCnt)
+	}
+	client.InvalidateCache()
+	if _, err := client.ListSpec("ns01"); err != nil {
+		t.Fatalf("failed to list spec, error=%s", err)
+	}
+	if requestCnt != 2 {
+		t.Errorf("expected 2 requests after cache invalidation, got %d", requestCnt)
+	}
+
+	shortTTLClient := newTestClient(server, 0, 50*time.Millisecond)
+	_, _ = shortTTLClient.ListSpec("ns01")
+	time.Sleep(100 * time.Millisecond)
+	_, _ = shortTTLClient.ListSpec("ns01")
+	if requestCnt != 4 {
+		t.Errorf("expected 4 requests after cache expiration, got %d", requestCnt)
+	}
+}
+
+func TestUnavailableServer(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := tumblebug.NewClient(tumblebug.Config{EndpointUrl: server.URL, Timeout: 200 * time.Millisecond})
+	if _, err := client.ListNamespace(); err == nil || tumblebug.IsNotFound(err) {
+		t.Errorf("expected connection error, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package tumblebug
+
+// Namespace Tumblebug 네임스페이스
+type Namespace struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// MCIS Tumblebug MCIS
+type MCIS struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Description string `json:"description"`
+	Vm          []VM   `json:"vm"`
+}
+
+// VM Tumblebug MCIS VM
+type VM struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	Status         string `json:"status"`
+	ConnectionName string `json:"connectionName"`
+	SpecId         string `json:"specId"`
+	ImageId        string `json:"imageId"`
+	PublicIP       string `json:"publicIP"`
+	PrivateIP      string `json:"privateIP"`
+	SSHPort        string `json:"sshPort"`
+	VMUserAccount  string `json:"vmUserAccount"`
+	SshKeyId       string `json:"sshKeyId"`
+	Region         Region `json:"region"`
+
+	ConnectionConfig ConnectionConfig `json:"connectionConfig"`
+}
+
+// ConnectionConfig VM 클라우드 연결 정보
+type ConnectionConfig struct {
+	ConfigName   string `json:"configName"`
+	ProviderName string `json:"providerName"`
+	RegionName   string `json:"regionName"`
+}
+
+// Region VM 리전, 존
+type Region struct {
+	Region string `json:"Region"`
+	Zone   string `json:"Zone"`
+}
+
+// Spec Tumblebug VM 스펙
+type Spec struct {
+	Namespace      string  `json:"namespace"`
+	Id             string  `json:"id"`
+	Name           string  `json:"name"`
+	ConnectionName string  `json:"connectionName"`
+	CspSpecName    string  `json:"cspSpecName"`
+	NumvCPU        int     `json:"numvCPU"`
+	MemGiB         float64 `json:"memGiB"`
+	CostPerHour    float64 `json:"costPerHour"`
+}
+
+// K8sCluster Tumblebug 쿠버네티스 클러스터
+type K8sCluster struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	ConnectionName string `json:"connectionName"`
+	Version        string `json:"version"`
+}
@@ -15,6 +15,19 @@ const (
 	CollectorTopicMap      = "/push/collectorTopicMap"
 	MCK8STopic             = "/mck8s/push/topic"
 	MCK8SCollectorTopicMap = "/mck8s/push/collectorTopicMap"
+	CollectionProfile      = "/monitoring/profiles/"
+	MCISCollectionProfile  = "/monitoring/mcisProfiles/"
+	RawMode                = "/monitoring/rawModes/"
+	KafkaCredential        = "/monitoring/kafkaCredentials/"
+	IngestToken            = "/monitoring/ingestTokens/"
+	NatsCredential         = "/monitoring/natsCredentials/"
+	DeadLetter             = "/monitoring/deadLetters/"
+	Credential             = "/monitoring/credentials/"
+	Leader                 = "/monitoring/leader"
+	MCISTopicQueue         = "/monitoring/topicQueue/mcis"
+	MCK8STopicQueue        = "/monitoring/topicQueue/mck8s"
+	ReconcileResult        = "/monitoring/reconcileResult"
+	BenchmarkCampaign      = "/monitoring/benchmarkCampaigns/"
 )
 
 const (
@@ -26,8 +39,12 @@ const (
 )
 
 const (
-	AgentCntCollectorPolicy = "AGENTCOUNT"
-	CSPCollectorPolicy      = "CSP"
+	AgentCntCollectorPolicy       = "AGENTCOUNT"
+	CSPCollectorPolicy            = "CSP"
+	NamespaceCollectorPolicy      = "NAMESPACE"
+	MessageRateCollectorPolicy    = "MESSAGERATE"
+	ConsistentHashCollectorPolicy = "CONSISTENTHASH"
+	RegionCollectorPolicy         = "REGION"
 )
 
 const (
@@ -60,11 +77,50 @@ const (
 	TopicDel = "TopicDel"
 )
 
+// MCIS 콜렉터 동작 방식
+// SchedulerCollectorMode : 콜렉터 스케줄러가 토픽을 직접 분배 (기본값)
+// ConsumerGroupCollectorMode : 모든 콜렉터가 하나의 컨슈머 그룹에 참여하고, 파티션 분배는 kafka 에 위임
+const (
+	SchedulerCollectorMode     = "scheduler"
+	ConsumerGroupCollectorMode = "consumer_group"
+)
+
+// 컨슈머 그룹 방식 토픽 구성
+// AgentTopicMode : 에이전트 별 토픽 (토픽 패턴 구독)
+// SharedTopicMode : 공용 토픽 (메세지 키 = 에이전트 UUID)
+const (
+	AgentTopicMode  = "agent"
+	SharedTopicMode = "shared"
+)
+
+// 에이전트 메트릭 전송 방식 (PUSH)
+// KafkaTransport : kafka 토픽 전송 (기본값)
+// HTTPTransport : Dragonfly API 직접 전송 (influx line protocol, telegraf json)
+// NatsTransport : NATS JetStream 전송
+const (
+	KafkaTransport = "kafka"
+	HTTPTransport  = "http"
+	NatsTransport  = "nats"
+
+	// TransportHeader kafka 로 전달된 HTTP 수신 메트릭의 수신 방식 헤더
+	TransportHeader = "transport"
+)
+
+const (
+	MCISConsumerGroupId    = "cb-dragonfly-mcis"
+	MCISSharedTopic        = "cb-dragonfly-mcis-metric"
+	MCISAgentTopicPattern  = "^.+_(mcis|vm)_.+$"
+	TopicMetadataRefreshMs = 10000
+)
+
 const (
 	ConfigMapName       = "cb-dragonfly-collector-configmap"
 	DeploymentName      = "cb-dragonfly-collector-"
 	MCK8SConfigMapName  = "cb-dragonfly-mck8s-collector-configmap"
 	MCK8SDeploymentName = "cb-dragonfly-mck8s-collector-"
+	GroupDeploymentName = "cb-dragonfly-group-collector-"
+	KafkaSecretName     = "cb-dragonfly-collector-kafka-secret"
+	LeaderLeaseName     = "cb-dragonfly-leader"
 )
 
 const (
@@ -27,6 +27,12 @@ const (
 	ALL        string = "all"
 )
 
+// 메트릭 조회 해상도
+// RawResolution : 집계 이전 에이전트 원본 샘플 조회 (raw 모드 설정 대상만 저장)
+const (
+	RawResolution = "raw"
+)
+
 type MCK8SReqInfo struct {
 	GroupBy   string // Node: Cluster or Node  // Pod: Node or Namespace or Pod
 	Node      string
@@ -45,6 +51,7 @@ type DBMetricRequestInfo struct {
 	Period       string
 	AggegateType string
 	Duration     string
+	Resolution   string // raw: 원본 샘플 조회
 }
 
 func (m Metric) ToString() string {
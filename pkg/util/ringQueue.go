@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	"github.com/Workiva/go-datastructures/queue"
+
+	"github.com/cloud-barista/cb-dragonfly/pkg/types"
 )
 
 type TopicStructure struct {
@@ -13,6 +15,15 @@ type TopicStructure struct {
 	Topic  string
 }
 
+// 리더 선출(HA) 활성화 시, 토픽 추가/삭제 요청을 리더 인스턴스로 전달하기 위한 공유 큐(cb-store) 등록 함수
+// 미설정(nil) 시 in-memory 큐에 직접 등록합니다.
+var sharedQueuePut func(queueKey string, topicBytes []byte) error
+
+// SetSharedQueue 공유 큐 등록 함수 설정
+func SetSharedQueue(putFunc func(queueKey string, topicBytes []byte) error) {
+	sharedQueuePut = putFunc
+}
+
 // MCIS 큐
 var ringQueueOnce sync.Once
 var ringQueue *queue.Queue
@@ -47,6 +58,9 @@ func RingQueuePut(topicManagePolicy string, topic string) error {
 		fmt.Println("error?")
 		return err
 	}
+	if sharedQueuePut != nil {
+		return sharedQueuePut(types.MCISTopicQueue, topicBytes)
+	}
 	if err = GetRingQueue().Put(topicBytes); err != nil {
 		return err
 	}
@@ -64,6 +78,9 @@ func PutMCK8SRingQueue(topicManagePolicy string, topic string) error {
 		fmt.Println("error?")
 		return err
 	}
+	if sharedQueuePut != nil {
+		return sharedQueuePut(types.MCK8STopicQueue, topicBytes)
+	}
 	if err = GetMCK8SRingQueue().Put(topicBytes); err != nil {
 		return err
 	}